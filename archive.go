@@ -0,0 +1,385 @@
+package github
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+)
+
+var (
+	// ErrArchiveTooLarge occurs when the extracted files of an archive exceed the maximum size.
+	ErrArchiveTooLarge = errors.New("archive exceeds the maximum size")
+
+	// ErrUnsafeArchivePath occurs when an archive entry would be extracted outside of the destination directory.
+	ErrUnsafeArchivePath = errors.New("unsafe path in archive")
+)
+
+// ExtractOptions are used for extracting a repository archive or an artifact.
+type ExtractOptions struct {
+	// Include is a list of glob patterns (see path.Match) for the files to extract.
+	// A pattern matches a file if it matches the file path or any of its parent directories.
+	// If empty, all files are extracted.
+	Include []string
+	// Exclude is a list of glob patterns (see path.Match) for the files to skip.
+	// A pattern matches a file if it matches the file path or any of its parent directories.
+	Exclude []string
+	// MaxSize is the maximum total size of the extracted files in bytes.
+	// If zero, there is no limit.
+	MaxSize int64
+}
+
+// matchPath determines whether or not a path or any of its parent directories match any of the patterns.
+func matchPath(patterns []string, p string) bool {
+	for _, pattern := range patterns {
+		for q := p; q != "." && q != "/"; q = path.Dir(q) {
+			if ok, _ := path.Match(pattern, q); ok {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+func (o ExtractOptions) selected(p string) bool {
+	if len(o.Include) > 0 && !matchPath(o.Include, p) {
+		return false
+	}
+
+	return !matchPath(o.Exclude, p)
+}
+
+// ensureNoSymlinks verifies that none of the parent directories of a path within root is a symbolic link.
+// This prevents an archive entry from being written through a previously extracted symbolic link.
+func ensureNoSymlinks(root, rel string) error {
+	dir := root
+	for _, segment := range strings.Split(path.Dir(rel), "/") {
+		if segment == "." {
+			continue
+		}
+
+		dir = filepath.Join(dir, segment)
+		info, err := os.Lstat(dir)
+		if errors.Is(err, os.ErrNotExist) {
+			return nil
+		} else if err != nil {
+			return err
+		}
+
+		if info.Mode()&os.ModeSymlink != 0 {
+			return fmt.Errorf("%w: %s", ErrUnsafeArchivePath, rel)
+		}
+	}
+
+	return nil
+}
+
+// maxSymlinks is the maximum number of symbolic links followed while resolving a path.
+const maxSymlinks = 40
+
+type extractedLink struct {
+	name, rel, linkname string
+}
+
+// extractor writes the entries of an archive into a destination directory.
+// Files are written through an os.Root, so no entry can be written outside of the directory,
+// and symbolic links are verified against the links already extracted on disk.
+type extractor struct {
+	dir   string
+	root  *os.Root
+	opts  ExtractOptions
+	size  int64
+	links []extractedLink
+}
+
+func newExtractor(destDir string, opts ExtractOptions) (*extractor, error) {
+	if err := os.MkdirAll(destDir, 0755); err != nil {
+		return nil, err
+	}
+
+	root, err := os.OpenRoot(destDir)
+	if err != nil {
+		return nil, err
+	}
+
+	return &extractor{
+		dir:  destDir,
+		root: root,
+		opts: opts,
+	}, nil
+}
+
+// Close verifies that all extracted symbolic links still point inside the destination directory.
+// A symbolic link can be redirected outside of the directory by a symbolic link extracted after it.
+func (e *extractor) Close() error {
+	defer e.root.Close()
+
+	for _, l := range e.links {
+		if !e.resolve(l.rel) {
+			_ = e.root.Remove(l.rel)
+			return fmt.Errorf("%w: %s -> %s", ErrUnsafeArchivePath, l.name, l.linkname)
+		}
+	}
+
+	return nil
+}
+
+// resolve determines whether or not a slash-separated path relative to the destination directory
+// stays inside the directory when following the symbolic links already extracted on disk.
+func (e *extractor) resolve(p string) bool {
+	resolved := []string{}
+	pending := strings.Split(p, "/")
+	links := 0
+
+	for len(pending) > 0 {
+		segment := pending[0]
+		pending = pending[1:]
+
+		switch segment {
+		case "", ".":
+			continue
+		case "..":
+			if len(resolved) == 0 {
+				return false
+			}
+			resolved = resolved[:len(resolved)-1]
+			continue
+		}
+
+		resolved = append(resolved, segment)
+
+		// All resolved segments are directories inside the destination directory.
+		name := filepath.Join(append([]string{e.dir}, resolved...)...)
+		info, err := os.Lstat(name)
+		if err != nil || info.Mode()&os.ModeSymlink == 0 {
+			continue
+		}
+
+		if links++; links > maxSymlinks {
+			return false
+		}
+
+		target, err := os.Readlink(name)
+		if err != nil || filepath.IsAbs(target) {
+			return false
+		}
+
+		resolved = resolved[:len(resolved)-1]
+		pending = append(strings.Split(filepath.ToSlash(target), "/"), pending...)
+	}
+
+	return true
+}
+
+// mkdirAll creates a directory and all of its parents inside the destination directory.
+func (e *extractor) mkdirAll(rel string) error {
+	dir := ""
+	for _, segment := range strings.Split(rel, "/") {
+		if segment == "." {
+			continue
+		}
+
+		dir = path.Join(dir, segment)
+		if err := e.root.Mkdir(dir, 0755); err != nil && !errors.Is(err, os.ErrExist) {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// writeFile writes a regular file inside the destination directory.
+// An existing symbolic link is never followed.
+func (e *extractor) writeFile(rel string, r io.Reader, size int64, perm os.FileMode) error {
+	if e.opts.MaxSize > 0 && e.size+size > e.opts.MaxSize {
+		return fmt.Errorf("%w: %d bytes", ErrArchiveTooLarge, e.opts.MaxSize)
+	}
+	e.size += size
+
+	if err := e.mkdirAll(path.Dir(rel)); err != nil {
+		return err
+	}
+
+	if info, err := e.root.Lstat(rel); err == nil && info.Mode()&os.ModeSymlink != 0 {
+		return fmt.Errorf("%w: %s", ErrUnsafeArchivePath, rel)
+	}
+
+	f, err := e.root.OpenFile(rel, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, perm)
+	if err != nil {
+		return err
+	}
+
+	if _, err := io.Copy(f, r); err != nil {
+		_ = f.Close()
+		return err
+	}
+
+	return f.Close()
+}
+
+// symlink creates a symbolic link inside the destination directory.
+// The link target must resolve inside the destination directory; name is the archive entry name used in errors.
+func (e *extractor) symlink(name, rel, linkname string) error {
+	if path.IsAbs(linkname) || !e.resolve(path.Dir(rel)+"/"+linkname) {
+		return fmt.Errorf("%w: %s -> %s", ErrUnsafeArchivePath, name, linkname)
+	}
+
+	if err := e.mkdirAll(path.Dir(rel)); err != nil {
+		return err
+	}
+
+	if err := os.Symlink(filepath.FromSlash(linkname), filepath.Join(e.dir, filepath.FromSlash(rel))); err != nil {
+		return err
+	}
+
+	e.links = append(e.links, extractedLink{name, rel, linkname})
+
+	return nil
+}
+
+// ExtractArchive downloads a repository archive in tar format and extracts it into a directory.
+// The top-level directory of the archive (owner-repo-sha) is stripped,
+// so the files are extracted relative to the root of the repository.
+// Entries with absolute paths, parent directory references, or symbolic links pointing outside
+// of the destination directory are rejected with ErrUnsafeArchivePath.
+// It returns the SHA of the commit the archive was created from.
+func (s *RepoService) ExtractArchive(ctx context.Context, ref, destDir string, opts ExtractOptions) (string, *Response, error) {
+	url := fmt.Sprintf("/repos/%s/%s/tarball/%s", s.owner, s.repo, escapePath(ref))
+	req, err := s.client.NewRequest(ctx, "GET", url, nil)
+	if err != nil {
+		return "", nil, err
+	}
+
+	type result struct {
+		resp *Response
+		err  error
+	}
+
+	pr, pw := io.Pipe()
+	done := make(chan result, 1)
+
+	go func() {
+		resp, err := s.client.Do(req, pw)
+		_ = pw.CloseWithError(err)
+		done <- result{resp, err}
+	}()
+
+	sha, extractErr := extractTarGz(pr, destDir, opts)
+	if extractErr == nil {
+		// Drain the remaining of the response (i.e. padding), so the download can complete.
+		_, _ = io.Copy(io.Discard, pr)
+	} else {
+		// Unblock the download if the extraction has stopped early.
+		_ = pr.CloseWithError(extractErr)
+	}
+
+	res := <-done
+	if res.err != nil {
+		return "", nil, res.err
+	}
+
+	if extractErr != nil {
+		return "", nil, extractErr
+	}
+
+	return sha, res.resp, nil
+}
+
+// extractTarGz extracts a gzipped repository tarball into a directory and returns the commit SHA of the archive.
+func extractTarGz(r io.Reader, destDir string, opts ExtractOptions) (sha string, err error) {
+	gz, err := gzip.NewReader(r)
+	if err != nil {
+		return "", err
+	}
+	defer gz.Close()
+
+	e, err := newExtractor(destDir, opts)
+	if err != nil {
+		return "", err
+	}
+
+	defer func() {
+		if closeErr := e.Close(); err == nil && closeErr != nil {
+			sha, err = "", closeErr
+		}
+	}()
+
+	var prefix string
+
+	tr := tar.NewReader(gz)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return "", err
+		}
+
+		// git archive stores the commit SHA in the comment of the pax global header.
+		if hdr.Typeflag == tar.TypeXGlobalHeader {
+			sha = hdr.PAXRecords["comment"]
+			continue
+		}
+
+		name := strings.TrimSuffix(hdr.Name, "/")
+		if path.IsAbs(name) {
+			return "", fmt.Errorf("%w: %s", ErrUnsafeArchivePath, hdr.Name)
+		}
+
+		// Strip the top-level directory
+		top, rel, _ := strings.Cut(name, "/")
+		if prefix == "" {
+			prefix = top
+		}
+
+		if rel == "" {
+			continue
+		}
+
+		if !filepath.IsLocal(filepath.FromSlash(rel)) {
+			return "", fmt.Errorf("%w: %s", ErrUnsafeArchivePath, hdr.Name)
+		}
+
+		rel = path.Clean(rel)
+		if !opts.selected(rel) {
+			continue
+		}
+
+		if err := ensureNoSymlinks(destDir, rel); err != nil {
+			return "", err
+		}
+
+		switch hdr.Typeflag {
+		case tar.TypeDir:
+			if err := e.mkdirAll(rel); err != nil {
+				return "", err
+			}
+
+		case tar.TypeReg:
+			if err := e.writeFile(rel, tr, hdr.Size, hdr.FileInfo().Mode().Perm()); err != nil {
+				return "", err
+			}
+
+		case tar.TypeSymlink:
+			if err := e.symlink(hdr.Name, rel, hdr.Linkname); err != nil {
+				return "", err
+			}
+		}
+	}
+
+	// Fall back to the abbreviated SHA in the top-level directory name.
+	if sha == "" {
+		if i := strings.LastIndex(prefix, "-"); i >= 0 {
+			sha = prefix[i+1:]
+		}
+	}
+
+	return sha, nil
+}
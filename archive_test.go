@@ -0,0 +1,418 @@
+package github
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"io/fs"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const archiveSHA = "c3d0be41ecbe669545ee3e94d31ed9a4bc91ee3c"
+
+type tarEntry struct {
+	Name     string
+	Type     byte
+	Content  string
+	Linkname string
+}
+
+// newTarGz creates a gzipped tarball similar to the ones created by git archive.
+func newTarGz(sha string, entries ...tarEntry) string {
+	buf := new(bytes.Buffer)
+	gz := gzip.NewWriter(buf)
+	tw := tar.NewWriter(gz)
+
+	if sha != "" {
+		_ = tw.WriteHeader(&tar.Header{
+			Typeflag:   tar.TypeXGlobalHeader,
+			Name:       "pax_global_header",
+			PAXRecords: map[string]string{"comment": sha},
+			Format:     tar.FormatPAX,
+		})
+	}
+
+	for _, e := range entries {
+		hdr := &tar.Header{
+			Typeflag: e.Type,
+			Name:     e.Name,
+			Linkname: e.Linkname,
+			Mode:     0644,
+			Size:     int64(len(e.Content)),
+		}
+		if e.Type == tar.TypeDir {
+			hdr.Mode = 0755
+		}
+
+		_ = tw.WriteHeader(hdr)
+		if e.Type == tar.TypeReg {
+			_, _ = tw.Write([]byte(e.Content))
+		}
+	}
+
+	_ = tw.Close()
+	_ = gz.Close()
+
+	return buf.String()
+}
+
+// readTree returns all files and symbolic links in a directory.
+// The value for a symbolic link is its target prefixed with ->.
+func readTree(t *testing.T, dir string) map[string]string {
+	files := map[string]string{}
+
+	err := filepath.WalkDir(dir, func(p string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return err
+		}
+
+		rel, _ := filepath.Rel(dir, p)
+		rel = filepath.ToSlash(rel)
+
+		if d.Type()&fs.ModeSymlink != 0 {
+			target, err := os.Readlink(p)
+			if err != nil {
+				return err
+			}
+			files[rel] = "-> " + filepath.ToSlash(target)
+			return nil
+		}
+
+		b, err := os.ReadFile(p)
+		if err != nil {
+			return err
+		}
+		files[rel] = string(b)
+
+		return nil
+	})
+
+	assert.NoError(t, err)
+
+	return files
+}
+
+func TestMatchPath(t *testing.T) {
+	tests := []struct {
+		name          string
+		patterns      []string
+		path          string
+		expectedMatch bool
+	}{
+		{"NoPattern", nil, "README.md", false},
+		{"File", []string{"*.md"}, "README.md", true},
+		{"ParentDirectory", []string{"docs"}, "docs/guide/intro.md", true},
+		{"NestedFile", []string{"*.md"}, "docs/intro.md", false},
+		{"NoMatch", []string{"*.go"}, "README.md", false},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expectedMatch, matchPath(tc.patterns, tc.path))
+		})
+	}
+}
+
+func TestRepoService_ExtractArchive(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	archive := newTarGz(archiveSHA,
+		tarEntry{Name: "octocat-Hello-World-c3d0be4/", Type: tar.TypeDir},
+		tarEntry{Name: "octocat-Hello-World-c3d0be4/README.md", Type: tar.TypeReg, Content: "Hello, World!"},
+		tarEntry{Name: "octocat-Hello-World-c3d0be4/docs/", Type: tar.TypeDir},
+		tarEntry{Name: "octocat-Hello-World-c3d0be4/docs/guide.md", Type: tar.TypeReg, Content: "# Guide"},
+		tarEntry{Name: "octocat-Hello-World-c3d0be4/docs/index.md", Type: tar.TypeSymlink, Linkname: "guide.md"},
+		tarEntry{Name: "octocat-Hello-World-c3d0be4/main.go", Type: tar.TypeReg, Content: "package main"},
+	)
+
+	tests := []struct {
+		name          string
+		mockResponses []MockResponse
+		s             *RepoService
+		ctx           context.Context
+		ref           string
+		opts          ExtractOptions
+		expectedSHA   string
+		expectedFiles map[string]string
+		expectedError string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           nil,
+			ref:           "main",
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/tarball/main", 401, http.Header{}, `{
+					"message": "Bad credentials"
+				}`},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			ref:           "main",
+			expectedError: `GET /repos/octocat/Hello-World/tarball/main: 401 Bad credentials`,
+		},
+		{
+			name: "InvalidArchive",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/tarball/main", 200, http.Header{}, `not a tarball`},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			ref:           "main",
+			expectedError: `gzip: invalid header`,
+		},
+		{
+			name: "ParentDirectory",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/tarball/main", 200, http.Header{}, newTarGz(archiveSHA,
+					tarEntry{Name: "octocat-Hello-World-c3d0be4/../../evil", Type: tar.TypeReg, Content: "evil"},
+				)},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			ref:           "main",
+			expectedError: `unsafe path in archive: octocat-Hello-World-c3d0be4/../../evil`,
+		},
+		{
+			name: "AbsolutePath",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/tarball/main", 200, http.Header{}, newTarGz(archiveSHA,
+					tarEntry{Name: "/etc/evil", Type: tar.TypeReg, Content: "evil"},
+				)},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			ref:           "main",
+			expectedError: `unsafe path in archive: /etc/evil`,
+		},
+		{
+			name: "SymlinkEscape",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/tarball/main", 200, http.Header{}, newTarGz(archiveSHA,
+					tarEntry{Name: "octocat-Hello-World-c3d0be4/docs/evil", Type: tar.TypeSymlink, Linkname: "../../evil"},
+				)},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			ref:           "main",
+			expectedError: `unsafe path in archive: octocat-Hello-World-c3d0be4/docs/evil -> ../../evil`,
+		},
+		{
+			name: "WriteThroughSymlink",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/tarball/main", 200, http.Header{}, newTarGz(archiveSHA,
+					tarEntry{Name: "octocat-Hello-World-c3d0be4/link", Type: tar.TypeSymlink, Linkname: "."},
+					tarEntry{Name: "octocat-Hello-World-c3d0be4/link/evil", Type: tar.TypeSymlink, Linkname: ".."},
+				)},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			ref:           "main",
+			expectedError: `unsafe path in archive: link/evil`,
+		},
+		{
+			name: "ChainedSymlinkEscape",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/tarball/main", 200, http.Header{}, newTarGz(archiveSHA,
+					tarEntry{Name: "octocat-Hello-World-c3d0be4/dir/", Type: tar.TypeDir},
+					tarEntry{Name: "octocat-Hello-World-c3d0be4/dir/s1", Type: tar.TypeSymlink, Linkname: ".."},
+					tarEntry{Name: "octocat-Hello-World-c3d0be4/s2", Type: tar.TypeSymlink, Linkname: "dir/s1/../escaped"},
+					tarEntry{Name: "octocat-Hello-World-c3d0be4/s2", Type: tar.TypeReg, Content: "evil"},
+				)},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			ref:           "main",
+			expectedError: `unsafe path in archive: octocat-Hello-World-c3d0be4/s2 -> dir/s1/../escaped`,
+		},
+		{
+			name: "RedirectedSymlinkEscape",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/tarball/main", 200, http.Header{}, newTarGz(archiveSHA,
+					tarEntry{Name: "octocat-Hello-World-c3d0be4/s2", Type: tar.TypeSymlink, Linkname: "a/b/../../escaped"},
+					tarEntry{Name: "octocat-Hello-World-c3d0be4/a/", Type: tar.TypeDir},
+					tarEntry{Name: "octocat-Hello-World-c3d0be4/a/b", Type: tar.TypeSymlink, Linkname: ".."},
+				)},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			ref:           "main",
+			expectedError: `unsafe path in archive: octocat-Hello-World-c3d0be4/s2 -> a/b/../../escaped`,
+		},
+		{
+			name: "WriteOverSymlink",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/tarball/main", 200, http.Header{}, newTarGz(archiveSHA,
+					tarEntry{Name: "octocat-Hello-World-c3d0be4/README.md", Type: tar.TypeReg, Content: "Hello, World!"},
+					tarEntry{Name: "octocat-Hello-World-c3d0be4/link", Type: tar.TypeSymlink, Linkname: "README.md"},
+					tarEntry{Name: "octocat-Hello-World-c3d0be4/link", Type: tar.TypeReg, Content: "evil"},
+				)},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			ref:           "main",
+			expectedError: `unsafe path in archive: link`,
+		},
+		{
+			name: "TooLarge",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/tarball/main", 200, http.Header{}, archive},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx: context.Background(),
+			ref: "main",
+			opts: ExtractOptions{
+				MaxSize: 16,
+			},
+			expectedError: `archive exceeds the maximum size: 16 bytes`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/tarball/main", 200, header, archive},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:         context.Background(),
+			ref:         "main",
+			expectedSHA: archiveSHA,
+			expectedFiles: map[string]string{
+				"README.md":     "Hello, World!",
+				"docs/guide.md": "# Guide",
+				"docs/index.md": "-> guide.md",
+				"main.go":       "package main",
+			},
+		},
+		{
+			name: "IncludeExclude",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/tarball/feature/docs", 200, header, archive},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx: context.Background(),
+			ref: "feature/docs",
+			opts: ExtractOptions{
+				Include: []string{"docs", "*.md"},
+				Exclude: []string{"docs/index.md"},
+			},
+			expectedSHA: archiveSHA,
+			expectedFiles: map[string]string{
+				"README.md":     "Hello, World!",
+				"docs/guide.md": "# Guide",
+			},
+		},
+		{
+			name: "NoGlobalHeader",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/tarball/main", 200, header, newTarGz("",
+					tarEntry{Name: "octocat-Hello-World-c3d0be4/README.md", Type: tar.TypeReg, Content: "Hello, World!"},
+				)},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:         context.Background(),
+			ref:         "main",
+			expectedSHA: "c3d0be4",
+			expectedFiles: map[string]string{
+				"README.md": "Hello, World!",
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			defer ts.Close()
+
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			destDir := filepath.Join(t.TempDir(), "repo")
+			sha, resp, err := tc.s.ExtractArchive(tc.ctx, tc.ref, destDir, tc.opts)
+
+			assert.NoFileExists(t, filepath.Join(filepath.Dir(destDir), "escaped"))
+
+			if tc.expectedError != "" {
+				assert.Empty(t, sha)
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedSHA, sha)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, expectedRate, resp.Rate)
+				assert.Equal(t, tc.expectedFiles, readTree(t, destDir))
+			}
+		})
+	}
+}
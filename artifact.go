@@ -0,0 +1,271 @@
+package github
+
+import (
+	"archive/zip"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+type (
+	// Artifact is a GitHub Actions artifact object.
+	Artifact struct {
+		ID                 int                  `json:"id"`
+		Name               string               `json:"name"`
+		SizeInBytes        int64                `json:"size_in_bytes"`
+		Digest             string               `json:"digest"`
+		Expired            bool                 `json:"expired"`
+		URL                string               `json:"url"`
+		ArchiveDownloadURL string               `json:"archive_download_url"`
+		WorkflowRun        *ArtifactWorkflowRun `json:"workflow_run"`
+		CreatedAt          *time.Time           `json:"created_at"`
+		UpdatedAt          *time.Time           `json:"updated_at"`
+		ExpiresAt          *time.Time           `json:"expires_at"`
+	}
+
+	// ArtifactWorkflowRun is the workflow run that produced an artifact.
+	ArtifactWorkflowRun struct {
+		ID               int    `json:"id"`
+		RepositoryID     int    `json:"repository_id"`
+		HeadRepositoryID int    `json:"head_repository_id"`
+		HeadBranch       string `json:"head_branch"`
+		HeadSHA          string `json:"head_sha"`
+	}
+)
+
+// ExpiredAt determines whether or not an artifact is expired or will be expired at a given time.
+func (a Artifact) ExpiredAt(t time.Time) bool {
+	return a.Expired || (a.ExpiresAt != nil && !a.ExpiresAt.After(t))
+}
+
+// ArtifactsFilter are used for fetching Artifacts.
+type ArtifactsFilter struct {
+	Name string
+}
+
+// Artifacts retrieves all artifacts in the repository page by page.
+// See https://docs.github.com/en/rest/actions/artifacts#list-artifacts-for-a-repository
+func (s *WorkflowService) Artifacts(ctx context.Context, pageSize, pageNo int, filter ArtifactsFilter) ([]Artifact, *Response, error) {
+	url := fmt.Sprintf("/repos/%s/%s/actions/artifacts", s.owner, s.repo)
+	return s.listArtifacts(ctx, url, pageSize, pageNo, filter)
+}
+
+// RunArtifacts retrieves all artifacts of a workflow run page by page.
+// See https://docs.github.com/en/rest/actions/artifacts#list-workflow-run-artifacts
+func (s *WorkflowService) RunArtifacts(ctx context.Context, runID int, pageSize, pageNo int, filter ArtifactsFilter) ([]Artifact, *Response, error) {
+	url := fmt.Sprintf("/repos/%s/%s/actions/runs/%d/artifacts", s.owner, s.repo, runID)
+	return s.listArtifacts(ctx, url, pageSize, pageNo, filter)
+}
+
+func (s *WorkflowService) listArtifacts(ctx context.Context, url string, pageSize, pageNo int, filter ArtifactsFilter) ([]Artifact, *Response, error) {
+	req, err := s.client.NewPageRequest(ctx, "GET", url, pageSize, pageNo, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	q := req.URL.Query()
+	if filter.Name != "" {
+		q.Add("name", filter.Name)
+	}
+	req.URL.RawQuery = q.Encode()
+
+	body := new(struct {
+		TotalCount int        `json:"total_count"`
+		Artifacts  []Artifact `json:"artifacts"`
+	})
+
+	resp, err := s.client.Do(req, body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return body.Artifacts, resp, nil
+}
+
+// Artifact retrieves an artifact by its id.
+// See https://docs.github.com/en/rest/actions/artifacts#get-an-artifact
+func (s *WorkflowService) Artifact(ctx context.Context, artifactID int) (*Artifact, *Response, error) {
+	url := fmt.Sprintf("/repos/%s/%s/actions/artifacts/%d", s.owner, s.repo, artifactID)
+	req, err := s.client.NewRequest(ctx, "GET", url, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	artifact := new(Artifact)
+
+	resp, err := s.client.Do(req, artifact)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return artifact, resp, nil
+}
+
+// DeleteArtifact deletes an artifact by its id.
+// See https://docs.github.com/en/rest/actions/artifacts#delete-an-artifact
+func (s *WorkflowService) DeleteArtifact(ctx context.Context, artifactID int) (*Response, error) {
+	url := fmt.Sprintf("/repos/%s/%s/actions/artifacts/%d", s.owner, s.repo, artifactID)
+	req, err := s.client.NewRequest(ctx, "DELETE", url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// DownloadArtifact downloads an artifact as a zip archive.
+// The API redirects to a short-lived URL that the archive is downloaded from.
+// See https://docs.github.com/en/rest/actions/artifacts#download-an-artifact
+func (s *WorkflowService) DownloadArtifact(ctx context.Context, artifactID int, w io.Writer) (*Response, error) {
+	url := fmt.Sprintf("/repos/%s/%s/actions/artifacts/%d/zip", s.owner, s.repo, artifactID)
+	req, err := s.client.NewRequest(ctx, "GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, w)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// ExtractArtifact downloads an artifact and extracts it into a directory.
+// Since a zip archive cannot be read sequentially, it is downloaded to a temporary file first.
+// Entries with absolute paths, parent directory references, or symbolic links pointing outside
+// of the destination directory are rejected with ErrUnsafeArchivePath.
+func (s *WorkflowService) ExtractArtifact(ctx context.Context, artifactID int, destDir string, opts ExtractOptions) (*Response, error) {
+	f, err := os.CreateTemp("", "artifact-*.zip")
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		_ = f.Close()
+		_ = os.Remove(f.Name())
+	}()
+
+	resp, err := s.DownloadArtifact(ctx, artifactID, f)
+	if err != nil {
+		return nil, err
+	}
+
+	info, err := f.Stat()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := extractZip(f, info.Size(), destDir, opts); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// extractZip extracts a zip archive into a directory.
+func extractZip(r io.ReaderAt, size int64, destDir string, opts ExtractOptions) (err error) {
+	zr, err := zip.NewReader(r, size)
+	if err != nil {
+		return err
+	}
+
+	e, err := newExtractor(destDir, opts)
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		if closeErr := e.Close(); err == nil {
+			err = closeErr
+		}
+	}()
+
+	for _, zf := range zr.File {
+		name := strings.TrimSuffix(zf.Name, "/")
+		if path.IsAbs(name) || strings.Contains(name, `\`) || !filepath.IsLocal(filepath.FromSlash(name)) {
+			return fmt.Errorf("%w: %s", ErrUnsafeArchivePath, zf.Name)
+		}
+
+		rel := path.Clean(name)
+		if !opts.selected(rel) {
+			continue
+		}
+
+		if err := ensureNoSymlinks(destDir, rel); err != nil {
+			return err
+		}
+
+		mode := zf.Mode()
+
+		switch {
+		case mode.IsDir():
+			if err := e.mkdirAll(rel); err != nil {
+				return err
+			}
+
+		case mode&os.ModeSymlink != 0:
+			linkname, err := readZipLink(zf)
+			if err != nil {
+				return err
+			}
+
+			if err := e.symlink(zf.Name, rel, linkname); err != nil {
+				return err
+			}
+
+		case mode.IsRegular():
+			rc, err := zf.Open()
+			if err != nil {
+				return err
+			}
+
+			perm := mode.Perm()
+			if perm == 0 {
+				perm = 0644
+			}
+
+			// The uncompressed size is verified by archive/zip while reading the file.
+			err = e.writeFile(rel, rc, int64(zf.UncompressedSize64), perm)
+			_ = rc.Close()
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// maxLinkTargetSize is the maximum size of the target of a symbolic link in a zip archive.
+const maxLinkTargetSize = 4096
+
+// readZipLink reads the target of a symbolic link stored as the content of a zip archive entry.
+func readZipLink(zf *zip.File) (string, error) {
+	rc, err := zf.Open()
+	if err != nil {
+		return "", err
+	}
+	defer rc.Close()
+
+	b, err := io.ReadAll(io.LimitReader(rc, maxLinkTargetSize+1))
+	if err != nil {
+		return "", err
+	}
+
+	if len(b) > maxLinkTargetSize {
+		return "", fmt.Errorf("%w: %s", ErrUnsafeArchivePath, zf.Name)
+	}
+
+	return string(b), nil
+}
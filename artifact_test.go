@@ -0,0 +1,941 @@
+package github
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"io"
+	"io/fs"
+	"net/http"
+	"net/url"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	artifactBody = `{
+		"id": 11,
+		"name": "Rails",
+		"size_in_bytes": 556,
+		"digest": "sha256:cfc3236bdad15b5898bca8408945c9e19e1917da8704adc20eaa618444290a8c",
+		"expired": false,
+		"url": "https://api.github.com/repos/octocat/Hello-World/actions/artifacts/11",
+		"archive_download_url": "https://api.github.com/repos/octocat/Hello-World/actions/artifacts/11/zip",
+		"workflow_run": {
+			"id": 2332938,
+			"repository_id": 1296269,
+			"head_repository_id": 1296269,
+			"head_branch": "main",
+			"head_sha": "328faa0536e6fef19753d9d91dc96a9931694ce3"
+		},
+		"created_at": "2020-01-10T14:59:22Z",
+		"updated_at": "2020-01-10T14:59:22Z",
+		"expires_at": "2020-03-21T14:59:22Z"
+	}`
+
+	artifactsBody = `{
+		"total_count": 1,
+		"artifacts": [` + artifactBody + `]
+	}`
+)
+
+var artifact = Artifact{
+	ID:                 11,
+	Name:               "Rails",
+	SizeInBytes:        556,
+	Digest:             "sha256:cfc3236bdad15b5898bca8408945c9e19e1917da8704adc20eaa618444290a8c",
+	URL:                "https://api.github.com/repos/octocat/Hello-World/actions/artifacts/11",
+	ArchiveDownloadURL: "https://api.github.com/repos/octocat/Hello-World/actions/artifacts/11/zip",
+	WorkflowRun: &ArtifactWorkflowRun{
+		ID:               2332938,
+		RepositoryID:     1296269,
+		HeadRepositoryID: 1296269,
+		HeadBranch:       "main",
+		HeadSHA:          "328faa0536e6fef19753d9d91dc96a9931694ce3",
+	},
+	CreatedAt: parseGitHubTimePtr("2020-01-10T14:59:22Z"),
+	UpdatedAt: parseGitHubTimePtr("2020-01-10T14:59:22Z"),
+	ExpiresAt: parseGitHubTimePtr("2020-03-21T14:59:22Z"),
+}
+
+type zipEntry struct {
+	Name    string
+	Mode    fs.FileMode
+	Content string
+}
+
+// newZip creates a zip archive similar to the ones created for artifacts.
+func newZip(entries ...zipEntry) string {
+	buf := new(bytes.Buffer)
+	zw := zip.NewWriter(buf)
+
+	for _, e := range entries {
+		hdr := &zip.FileHeader{
+			Name:   e.Name,
+			Method: zip.Deflate,
+		}
+		hdr.SetMode(e.Mode)
+
+		w, _ := zw.CreateHeader(hdr)
+		_, _ = io.WriteString(w, e.Content)
+	}
+
+	_ = zw.Close()
+
+	return buf.String()
+}
+
+func TestArtifact_ExpiredAt(t *testing.T) {
+	tests := []struct {
+		name            string
+		a               Artifact
+		t               time.Time
+		expectedExpired bool
+	}{
+		{
+			name:            "Expired",
+			a:               Artifact{Expired: true},
+			t:               parseGitHubTime("2020-01-01T00:00:00Z"),
+			expectedExpired: true,
+		},
+		{
+			name:            "NoExpiration",
+			a:               Artifact{},
+			t:               parseGitHubTime("2020-01-01T00:00:00Z"),
+			expectedExpired: false,
+		},
+		{
+			name:            "BeforeExpiration",
+			a:               artifact,
+			t:               parseGitHubTime("2020-03-21T14:59:21Z"),
+			expectedExpired: false,
+		},
+		{
+			name:            "AfterExpiration",
+			a:               artifact,
+			t:               parseGitHubTime("2020-03-21T14:59:22Z"),
+			expectedExpired: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expectedExpired, tc.a.ExpiredAt(tc.t))
+		})
+	}
+}
+
+func TestWorkflowService_Artifacts(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	tests := []struct {
+		name              string
+		mockResponses     []MockResponse
+		s                 *WorkflowService
+		ctx               context.Context
+		pageSize          int
+		pageNo            int
+		filter            ArtifactsFilter
+		expectedArtifacts []Artifact
+		expectedResponse  *Response
+		expectedError     string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &WorkflowService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           nil,
+			pageSize:      10,
+			pageNo:        1,
+			filter:        ArtifactsFilter{Name: "Rails"},
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/actions/artifacts", 401, http.Header{}, `{
+					"message": "Bad credentials"
+				}`},
+			},
+			s: &WorkflowService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			pageSize:      10,
+			pageNo:        1,
+			filter:        ArtifactsFilter{Name: "Rails"},
+			expectedError: `GET /repos/octocat/Hello-World/actions/artifacts: 401 Bad credentials`,
+		},
+		{
+			name: "InvalidResponse",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/actions/artifacts", 200, http.Header{}, `{`},
+			},
+			s: &WorkflowService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			pageSize:      10,
+			pageNo:        1,
+			filter:        ArtifactsFilter{Name: "Rails"},
+			expectedError: `unexpected EOF`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/actions/artifacts", 200, header, artifactsBody},
+			},
+			s: &WorkflowService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:               context.Background(),
+			pageSize:          10,
+			pageNo:            1,
+			filter:            ArtifactsFilter{Name: "Rails"},
+			expectedArtifacts: []Artifact{artifact},
+			expectedResponse: &Response{
+				Pages: expectedPages,
+				Rate:  expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			defer ts.Close()
+
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			artifacts, resp, err := tc.s.Artifacts(tc.ctx, tc.pageSize, tc.pageNo, tc.filter)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, artifacts)
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedArtifacts, artifacts)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Pages, resp.Pages)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestWorkflowService_RunArtifacts(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	tests := []struct {
+		name              string
+		mockResponses     []MockResponse
+		s                 *WorkflowService
+		ctx               context.Context
+		runID             int
+		pageSize          int
+		pageNo            int
+		filter            ArtifactsFilter
+		expectedArtifacts []Artifact
+		expectedResponse  *Response
+		expectedError     string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &WorkflowService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           nil,
+			runID:         2332938,
+			pageSize:      10,
+			pageNo:        1,
+			filter:        ArtifactsFilter{},
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/actions/runs/2332938/artifacts", 401, http.Header{}, `{
+					"message": "Bad credentials"
+				}`},
+			},
+			s: &WorkflowService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			runID:         2332938,
+			pageSize:      10,
+			pageNo:        1,
+			filter:        ArtifactsFilter{},
+			expectedError: `GET /repos/octocat/Hello-World/actions/runs/2332938/artifacts: 401 Bad credentials`,
+		},
+		{
+			name: "InvalidResponse",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/actions/runs/2332938/artifacts", 200, http.Header{}, `{`},
+			},
+			s: &WorkflowService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			runID:         2332938,
+			pageSize:      10,
+			pageNo:        1,
+			filter:        ArtifactsFilter{},
+			expectedError: `unexpected EOF`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/actions/runs/2332938/artifacts", 200, header, artifactsBody},
+			},
+			s: &WorkflowService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:               context.Background(),
+			runID:             2332938,
+			pageSize:          10,
+			pageNo:            1,
+			filter:            ArtifactsFilter{},
+			expectedArtifacts: []Artifact{artifact},
+			expectedResponse: &Response{
+				Pages: expectedPages,
+				Rate:  expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			defer ts.Close()
+
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			artifacts, resp, err := tc.s.RunArtifacts(tc.ctx, tc.runID, tc.pageSize, tc.pageNo, tc.filter)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, artifacts)
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedArtifacts, artifacts)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Pages, resp.Pages)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestWorkflowService_Artifact(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	tests := []struct {
+		name             string
+		mockResponses    []MockResponse
+		s                *WorkflowService
+		ctx              context.Context
+		artifactID       int
+		expectedArtifact *Artifact
+		expectedResponse *Response
+		expectedError    string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &WorkflowService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           nil,
+			artifactID:    11,
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/actions/artifacts/11", 401, http.Header{}, `{
+					"message": "Bad credentials"
+				}`},
+			},
+			s: &WorkflowService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			artifactID:    11,
+			expectedError: `GET /repos/octocat/Hello-World/actions/artifacts/11: 401 Bad credentials`,
+		},
+		{
+			name: "InvalidResponse",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/actions/artifacts/11", 200, http.Header{}, `{`},
+			},
+			s: &WorkflowService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			artifactID:    11,
+			expectedError: `unexpected EOF`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/actions/artifacts/11", 200, header, artifactBody},
+			},
+			s: &WorkflowService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:              context.Background(),
+			artifactID:       11,
+			expectedArtifact: &artifact,
+			expectedResponse: &Response{
+				Rate: expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			defer ts.Close()
+
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			a, resp, err := tc.s.Artifact(tc.ctx, tc.artifactID)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, a)
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedArtifact, a)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestWorkflowService_DeleteArtifact(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	tests := []struct {
+		name             string
+		mockResponses    []MockResponse
+		s                *WorkflowService
+		ctx              context.Context
+		artifactID       int
+		expectedResponse *Response
+		expectedError    string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &WorkflowService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           nil,
+			artifactID:    11,
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"DELETE", "/repos/octocat/Hello-World/actions/artifacts/11", 401, http.Header{}, `{
+					"message": "Bad credentials"
+				}`},
+			},
+			s: &WorkflowService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			artifactID:    11,
+			expectedError: `DELETE /repos/octocat/Hello-World/actions/artifacts/11: 401 Bad credentials`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"DELETE", "/repos/octocat/Hello-World/actions/artifacts/11", 204, header, ``},
+			},
+			s: &WorkflowService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:        context.Background(),
+			artifactID: 11,
+			expectedResponse: &Response{
+				Rate: expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			defer ts.Close()
+
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			resp, err := tc.s.DeleteArtifact(tc.ctx, tc.artifactID)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestWorkflowService_DownloadArtifact(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	tests := []struct {
+		name             string
+		mockResponses    []MockResponse
+		s                *WorkflowService
+		ctx              context.Context
+		artifactID       int
+		w                io.Writer
+		expectedResponse *Response
+		expectedError    string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &WorkflowService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           nil,
+			artifactID:    11,
+			w:             new(bytes.Buffer),
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/actions/artifacts/11/zip", 401, http.Header{}, `{
+					"message": "Bad credentials"
+				}`},
+			},
+			s: &WorkflowService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			artifactID:    11,
+			w:             new(bytes.Buffer),
+			expectedError: `GET /repos/octocat/Hello-World/actions/artifacts/11/zip: 401 Bad credentials`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/actions/artifacts/11/zip", 302, http.Header{"Location": {"/blob/artifact.zip"}}, ``},
+				{"GET", "/blob/artifact.zip", 200, header, `artifact content`},
+			},
+			s: &WorkflowService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:        context.Background(),
+			artifactID: 11,
+			w:          new(bytes.Buffer),
+			expectedResponse: &Response{
+				Rate: expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			defer ts.Close()
+
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			resp, err := tc.s.DownloadArtifact(tc.ctx, tc.artifactID, tc.w)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+				assert.Equal(t, `artifact content`, tc.w.(*bytes.Buffer).String())
+			}
+		})
+	}
+}
+
+func TestWorkflowService_ExtractArtifact(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	archive := newZip(
+		zipEntry{Name: "coverage/", Mode: fs.ModeDir | 0755},
+		zipEntry{Name: "coverage/index.html", Mode: 0644, Content: "<html></html>"},
+		zipEntry{Name: "coverage/latest.html", Mode: fs.ModeSymlink | 0777, Content: "index.html"},
+		zipEntry{Name: "report.txt", Mode: 0644, Content: "ok"},
+	)
+
+	tests := []struct {
+		name          string
+		mockResponses []MockResponse
+		s             *WorkflowService
+		ctx           context.Context
+		artifactID    int
+		opts          ExtractOptions
+		expectedFiles map[string]string
+		expectedError string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &WorkflowService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           nil,
+			artifactID:    11,
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/actions/artifacts/11/zip", 410, http.Header{}, `{
+					"message": "Artifact has expired"
+				}`},
+			},
+			s: &WorkflowService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			artifactID:    11,
+			expectedError: `GET /repos/octocat/Hello-World/actions/artifacts/11/zip: 410 Artifact has expired`,
+		},
+		{
+			name: "InvalidArchive",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/actions/artifacts/11/zip", 200, http.Header{}, `not a zip`},
+			},
+			s: &WorkflowService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			artifactID:    11,
+			expectedError: `zip: not a valid zip file`,
+		},
+		{
+			name: "ParentDirectory",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/actions/artifacts/11/zip", 200, http.Header{}, newZip(
+					zipEntry{Name: "../../evil", Mode: 0644, Content: "evil"},
+				)},
+			},
+			s: &WorkflowService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			artifactID:    11,
+			expectedError: `unsafe path in archive: ../../evil`,
+		},
+		{
+			name: "AbsolutePath",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/actions/artifacts/11/zip", 200, http.Header{}, newZip(
+					zipEntry{Name: "/etc/evil", Mode: 0644, Content: "evil"},
+				)},
+			},
+			s: &WorkflowService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			artifactID:    11,
+			expectedError: `unsafe path in archive: /etc/evil`,
+		},
+		{
+			name: "Backslash",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/actions/artifacts/11/zip", 200, http.Header{}, newZip(
+					zipEntry{Name: `..\..\evil`, Mode: 0644, Content: "evil"},
+				)},
+			},
+			s: &WorkflowService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			artifactID:    11,
+			expectedError: `unsafe path in archive: ..\..\evil`,
+		},
+		{
+			name: "SymlinkEscape",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/actions/artifacts/11/zip", 200, http.Header{}, newZip(
+					zipEntry{Name: "coverage/evil", Mode: fs.ModeSymlink | 0777, Content: "../../evil"},
+				)},
+			},
+			s: &WorkflowService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			artifactID:    11,
+			expectedError: `unsafe path in archive: coverage/evil -> ../../evil`,
+		},
+		{
+			name: "WriteThroughSymlink",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/actions/artifacts/11/zip", 200, http.Header{}, newZip(
+					zipEntry{Name: "link", Mode: fs.ModeSymlink | 0777, Content: "."},
+					zipEntry{Name: "link/evil", Mode: 0644, Content: "evil"},
+				)},
+			},
+			s: &WorkflowService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			artifactID:    11,
+			expectedError: `unsafe path in archive: link/evil`,
+		},
+		{
+			name: "ChainedSymlinkEscape",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/actions/artifacts/11/zip", 200, http.Header{}, newZip(
+					zipEntry{Name: "dir/", Mode: fs.ModeDir | 0755},
+					zipEntry{Name: "dir/s1", Mode: fs.ModeSymlink | 0777, Content: ".."},
+					zipEntry{Name: "s2", Mode: fs.ModeSymlink | 0777, Content: "dir/s1/../escaped"},
+					zipEntry{Name: "s2", Mode: 0644, Content: "evil"},
+				)},
+			},
+			s: &WorkflowService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			artifactID:    11,
+			expectedError: `unsafe path in archive: s2 -> dir/s1/../escaped`,
+		},
+		{
+			name: "RedirectedSymlinkEscape",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/actions/artifacts/11/zip", 200, http.Header{}, newZip(
+					zipEntry{Name: "s2", Mode: fs.ModeSymlink | 0777, Content: "a/b/../../escaped"},
+					zipEntry{Name: "a/", Mode: fs.ModeDir | 0755},
+					zipEntry{Name: "a/b", Mode: fs.ModeSymlink | 0777, Content: ".."},
+				)},
+			},
+			s: &WorkflowService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			artifactID:    11,
+			expectedError: `unsafe path in archive: s2 -> a/b/../../escaped`,
+		},
+		{
+			name: "WriteOverSymlink",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/actions/artifacts/11/zip", 200, http.Header{}, newZip(
+					zipEntry{Name: "report.txt", Mode: 0644, Content: "ok"},
+					zipEntry{Name: "link", Mode: fs.ModeSymlink | 0777, Content: "report.txt"},
+					zipEntry{Name: "link", Mode: 0644, Content: "evil"},
+				)},
+			},
+			s: &WorkflowService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			artifactID:    11,
+			expectedError: `unsafe path in archive: link`,
+		},
+		{
+			name: "LongSymlinkTarget",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/actions/artifacts/11/zip", 200, http.Header{}, newZip(
+					zipEntry{Name: "link", Mode: fs.ModeSymlink | 0777, Content: strings.Repeat("a/", 4096)},
+				)},
+			},
+			s: &WorkflowService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			artifactID:    11,
+			expectedError: `unsafe path in archive: link`,
+		},
+		{
+			name: "TooLarge",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/actions/artifacts/11/zip", 200, http.Header{}, archive},
+			},
+			s: &WorkflowService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:        context.Background(),
+			artifactID: 11,
+			opts: ExtractOptions{
+				MaxSize: 8,
+			},
+			expectedError: `archive exceeds the maximum size: 8 bytes`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/actions/artifacts/11/zip", 302, http.Header{"Location": {"/blob/artifact.zip"}}, ``},
+				{"GET", "/blob/artifact.zip", 200, header, archive},
+			},
+			s: &WorkflowService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:        context.Background(),
+			artifactID: 11,
+			expectedFiles: map[string]string{
+				"coverage/index.html":  "<html></html>",
+				"coverage/latest.html": "-> index.html",
+				"report.txt":           "ok",
+			},
+		},
+		{
+			name: "IncludeExclude",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/actions/artifacts/11/zip", 200, header, archive},
+			},
+			s: &WorkflowService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:        context.Background(),
+			artifactID: 11,
+			opts: ExtractOptions{
+				Include: []string{"coverage"},
+				Exclude: []string{"*/latest.html"},
+			},
+			expectedFiles: map[string]string{
+				"coverage/index.html": "<html></html>",
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			defer ts.Close()
+
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			destDir := filepath.Join(t.TempDir(), "artifact")
+			resp, err := tc.s.ExtractArtifact(tc.ctx, tc.artifactID, destDir, tc.opts)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, expectedRate, resp.Rate)
+				assert.Equal(t, tc.expectedFiles, readTree(t, destDir))
+			}
+
+			assert.NoFileExists(t, filepath.Join(filepath.Dir(destDir), "escaped"))
+		})
+	}
+}
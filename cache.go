@@ -0,0 +1,355 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+	"time"
+)
+
+// CachesService provides GitHub APIs for GitHub Actions caches in a repository.
+// See https://docs.github.com/en/rest/actions/cache
+type CachesService struct {
+	client      *Client
+	owner, repo string
+}
+
+type (
+	// CacheUsage is the GitHub Actions cache usage of a repository.
+	CacheUsage struct {
+		FullName                string `json:"full_name"`
+		ActiveCachesSizeInBytes int64  `json:"active_caches_size_in_bytes"`
+		ActiveCachesCount       int    `json:"active_caches_count"`
+	}
+
+	// OrgCacheUsage is the total GitHub Actions cache usage of an organization.
+	OrgCacheUsage struct {
+		TotalActiveCachesSizeInBytes int64 `json:"total_active_caches_size_in_bytes"`
+		TotalActiveCachesCount       int   `json:"total_active_caches_count"`
+	}
+
+	// Cache is a GitHub Actions cache object.
+	Cache struct {
+		ID             int       `json:"id"`
+		Ref            string    `json:"ref"`
+		Key            string    `json:"key"`
+		Version        string    `json:"version"`
+		SizeInBytes    int64     `json:"size_in_bytes"`
+		LastAccessedAt time.Time `json:"last_accessed_at"`
+		CreatedAt      time.Time `json:"created_at"`
+	}
+)
+
+// CachesFilter are used for fetching Caches.
+// Key is a key or a key prefix.
+// Sort is either created_at, last_accessed_at, or size_in_bytes.
+// Direction is either asc or desc.
+type CachesFilter struct {
+	Key       string
+	Ref       string
+	Sort      string
+	Direction string
+}
+
+// EvictionReason is the reason a cache is evicted by an eviction policy.
+type EvictionReason string
+
+const (
+	// EvictionAge means the cache is older than the maximum age.
+	EvictionAge EvictionReason = "age"
+	// EvictionRef means the cache belongs to an evicted ref.
+	EvictionRef EvictionReason = "ref"
+	// EvictionSize means the cache is least recently accessed and the total size exceeds the target size.
+	EvictionSize EvictionReason = "size"
+)
+
+type (
+	// EvictionPolicy determines which caches are evicted.
+	// The caches matching MaxAge or Refs are evicted first.
+	// Then, the least recently accessed caches are evicted until the total size is at most TargetSize.
+	EvictionPolicy struct {
+		// MaxAge evicts the caches created longer ago than this duration.
+		// If zero, caches are not evicted by age.
+		MaxAge time.Duration
+		// Refs is a list of glob patterns (see path.Match) for evicting the caches of matching refs,
+		// such as refs/pull/123/merge or refs/pull/*/merge.
+		Refs []string
+		// TargetSize is the maximum total size of the remaining caches in bytes.
+		// If zero, caches are not evicted by size.
+		TargetSize int64
+		// DryRun only computes the evictions without deleting any cache.
+		DryRun bool
+	}
+
+	// CacheEviction is a cache evicted by an eviction policy.
+	CacheEviction struct {
+		Cache   Cache          `json:"cache"`
+		Reason  EvictionReason `json:"reason"`
+		Deleted bool           `json:"deleted"`
+	}
+
+	// EvictionResult is the result of applying an eviction policy.
+	EvictionResult struct {
+		Evictions  []CacheEviction `json:"evictions"`
+		SizeBefore int64           `json:"size_before"`
+		SizeAfter  int64           `json:"size_after"`
+	}
+)
+
+// String returns a human-readable summary of the evictions.
+func (r *EvictionResult) String() string {
+	var b strings.Builder
+	for _, e := range r.Evictions {
+		fmt.Fprintf(&b, "- %d %s %s (%d bytes, last accessed %s): %s\n",
+			e.Cache.ID, e.Cache.Ref, e.Cache.Key, e.Cache.SizeInBytes, e.Cache.LastAccessedAt.Format(time.RFC3339), e.Reason,
+		)
+	}
+	fmt.Fprintf(&b, "%d caches evicted, %d -> %d bytes", len(r.Evictions), r.SizeBefore, r.SizeAfter)
+
+	return b.String()
+}
+
+// planEviction determines the caches evicted by a policy at a given time.
+// The evictions are ordered from the least recently accessed cache.
+func planEviction(caches []Cache, policy EvictionPolicy, now time.Time) *EvictionResult {
+	sorted := make([]Cache, len(caches))
+	copy(sorted, caches)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].LastAccessedAt.Before(sorted[j].LastAccessedAt)
+	})
+
+	result := &EvictionResult{
+		Evictions: []CacheEviction{},
+	}
+
+	for _, c := range sorted {
+		result.SizeBefore += c.SizeInBytes
+	}
+
+	reasons := map[int]EvictionReason{}
+	size := result.SizeBefore
+
+	for _, c := range sorted {
+		if policy.MaxAge > 0 && now.Sub(c.CreatedAt) > policy.MaxAge {
+			reasons[c.ID] = EvictionAge
+		} else if matchRef(policy.Refs, c.Ref) {
+			reasons[c.ID] = EvictionRef
+		} else {
+			continue
+		}
+		size -= c.SizeInBytes
+	}
+
+	if policy.TargetSize > 0 {
+		for _, c := range sorted {
+			if size <= policy.TargetSize {
+				break
+			}
+			if _, ok := reasons[c.ID]; !ok {
+				reasons[c.ID] = EvictionSize
+				size -= c.SizeInBytes
+			}
+		}
+	}
+
+	for _, c := range sorted {
+		if reason, ok := reasons[c.ID]; ok {
+			result.Evictions = append(result.Evictions, CacheEviction{Cache: c, Reason: reason})
+		}
+	}
+	result.SizeAfter = size
+
+	return result
+}
+
+// matchRef determines whether or not a ref matches any of the patterns.
+func matchRef(patterns []string, ref string) bool {
+	for _, pattern := range patterns {
+		if ok, _ := path.Match(pattern, ref); ok {
+			return true
+		}
+	}
+
+	return false
+}
+
+// CacheUsage retrieves the total GitHub Actions cache usage of the organization.
+// See https://docs.github.com/en/rest/actions/cache#get-github-actions-cache-usage-for-an-organization
+func (s *OrgService) CacheUsage(ctx context.Context) (*OrgCacheUsage, *Response, error) {
+	url := fmt.Sprintf("/orgs/%s/actions/cache/usage", s.org)
+	req, err := s.client.NewRequest(ctx, "GET", url, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	usage := new(OrgCacheUsage)
+
+	resp, err := s.client.Do(req, usage)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return usage, resp, nil
+}
+
+// CacheUsageByRepo retrieves the GitHub Actions cache usage of all repositories in the organization page by page.
+// See https://docs.github.com/en/rest/actions/cache#list-repositories-with-github-actions-cache-usage-for-an-organization
+func (s *OrgService) CacheUsageByRepo(ctx context.Context, pageSize, pageNo int) ([]CacheUsage, *Response, error) {
+	url := fmt.Sprintf("/orgs/%s/actions/cache/usage-by-repository", s.org)
+	req, err := s.client.NewPageRequest(ctx, "GET", url, pageSize, pageNo, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	body := new(struct {
+		TotalCount            int          `json:"total_count"`
+		RepositoryCacheUsages []CacheUsage `json:"repository_cache_usages"`
+	})
+
+	resp, err := s.client.Do(req, body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return body.RepositoryCacheUsages, resp, nil
+}
+
+// Usage retrieves the GitHub Actions cache usage of the repository.
+// See https://docs.github.com/en/rest/actions/cache#get-github-actions-cache-usage-for-a-repository
+func (s *CachesService) Usage(ctx context.Context) (*CacheUsage, *Response, error) {
+	url := fmt.Sprintf("/repos/%s/%s/actions/cache/usage", s.owner, s.repo)
+	req, err := s.client.NewRequest(ctx, "GET", url, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	usage := new(CacheUsage)
+
+	resp, err := s.client.Do(req, usage)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return usage, resp, nil
+}
+
+// List retrieves all caches in the repository page by page.
+// See https://docs.github.com/en/rest/actions/cache#list-github-actions-caches-for-a-repository
+func (s *CachesService) List(ctx context.Context, pageSize, pageNo int, filter CachesFilter) ([]Cache, *Response, error) {
+	url := fmt.Sprintf("/repos/%s/%s/actions/caches", s.owner, s.repo)
+	req, err := s.client.NewPageRequest(ctx, "GET", url, pageSize, pageNo, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	q := req.URL.Query()
+	if filter.Key != "" {
+		q.Add("key", filter.Key)
+	}
+	if filter.Ref != "" {
+		q.Add("ref", filter.Ref)
+	}
+	if filter.Sort != "" {
+		q.Add("sort", filter.Sort)
+	}
+	if filter.Direction != "" {
+		q.Add("direction", filter.Direction)
+	}
+	req.URL.RawQuery = q.Encode()
+
+	body := new(struct {
+		TotalCount   int     `json:"total_count"`
+		ActionsCache []Cache `json:"actions_caches"`
+	})
+
+	resp, err := s.client.Do(req, body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return body.ActionsCache, resp, nil
+}
+
+// DeleteByKey deletes the caches with a key and returns the deleted caches.
+// If ref is not empty, only the caches of the ref are deleted.
+// See https://docs.github.com/en/rest/actions/cache#delete-github-actions-caches-for-a-repository-using-a-cache-key
+func (s *CachesService) DeleteByKey(ctx context.Context, key, ref string) ([]Cache, *Response, error) {
+	url := fmt.Sprintf("/repos/%s/%s/actions/caches", s.owner, s.repo)
+	req, err := s.client.NewRequest(ctx, "DELETE", url, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	q := req.URL.Query()
+	q.Add("key", key)
+	if ref != "" {
+		q.Add("ref", ref)
+	}
+	req.URL.RawQuery = q.Encode()
+
+	body := new(struct {
+		TotalCount   int     `json:"total_count"`
+		ActionsCache []Cache `json:"actions_caches"`
+	})
+
+	resp, err := s.client.Do(req, body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return body.ActionsCache, resp, nil
+}
+
+// Delete deletes a cache by its id.
+// See https://docs.github.com/en/rest/actions/cache#delete-a-github-actions-cache-for-a-repository-using-a-cache-id
+func (s *CachesService) Delete(ctx context.Context, cacheID int) (*Response, error) {
+	url := fmt.Sprintf("/repos/%s/%s/actions/caches/%d", s.owner, s.repo, cacheID)
+	req, err := s.client.NewRequest(ctx, "DELETE", url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// Evict deletes the caches in the repository according to an eviction policy.
+// The result is returned even if deleting a cache fails, so the caches deleted so far can be reported.
+// In that case, the size after eviction only accounts for the caches actually deleted.
+// With the DryRun option, the evictions are only computed.
+func (s *CachesService) Evict(ctx context.Context, policy EvictionPolicy) (*EvictionResult, error) {
+	caches := []Cache{}
+	for pageNo := 1; pageNo != 0; {
+		page, resp, err := s.List(ctx, 100, pageNo, CachesFilter{})
+		if err != nil {
+			return nil, err
+		}
+		caches = append(caches, page...)
+		pageNo = resp.Pages.Next
+	}
+
+	result := planEviction(caches, policy, time.Now())
+	if policy.DryRun {
+		return result, nil
+	}
+
+	for i := range result.Evictions {
+		e := &result.Evictions[i]
+		if _, err := s.Delete(ctx, e.Cache.ID); err != nil {
+			result.SizeAfter = result.SizeBefore
+			for _, d := range result.Evictions[:i] {
+				result.SizeAfter -= d.Cache.SizeInBytes
+			}
+			return result, fmt.Errorf("delete cache %d: %w", e.Cache.ID, err)
+		}
+		e.Deleted = true
+	}
+
+	return result, nil
+}
@@ -0,0 +1,1008 @@
+package github
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	cacheUsageBody = `{
+		"full_name": "octocat/Hello-World",
+		"active_caches_size_in_bytes": 2322142,
+		"active_caches_count": 3
+	}`
+
+	orgCacheUsageBody = `{
+		"total_active_caches_size_in_bytes": 3344284,
+		"total_active_caches_count": 5
+	}`
+
+	cacheUsageByRepoBody = `{
+		"total_count": 1,
+		"repository_cache_usages": [
+			{
+				"full_name": "octocat/Hello-World",
+				"active_caches_size_in_bytes": 2322142,
+				"active_caches_count": 3
+			}
+		]
+	}`
+
+	cachesBody = `{
+		"total_count": 1,
+		"actions_caches": [
+			{
+				"id": 505,
+				"ref": "refs/heads/main",
+				"key": "Linux-node-958aff96db2d75d67787d1e634ae70b659de937b",
+				"version": "73885106f58cc52a7df9ec4d4a5622a5614813162cb516c759a30af6bf56e6f0",
+				"last_accessed_at": "2019-01-24T22:45:36.000Z",
+				"created_at": "2019-01-24T22:45:36.000Z",
+				"size_in_bytes": 1024
+			}
+		]
+	}`
+)
+
+var (
+	cacheUsage = CacheUsage{
+		FullName:                "octocat/Hello-World",
+		ActiveCachesSizeInBytes: 2322142,
+		ActiveCachesCount:       3,
+	}
+
+	orgCacheUsage = OrgCacheUsage{
+		TotalActiveCachesSizeInBytes: 3344284,
+		TotalActiveCachesCount:       5,
+	}
+
+	cache = Cache{
+		ID:             505,
+		Ref:            "refs/heads/main",
+		Key:            "Linux-node-958aff96db2d75d67787d1e634ae70b659de937b",
+		Version:        "73885106f58cc52a7df9ec4d4a5622a5614813162cb516c759a30af6bf56e6f0",
+		SizeInBytes:    1024,
+		LastAccessedAt: parseGitHubTime("2019-01-24T22:45:36Z"),
+		CreatedAt:      parseGitHubTime("2019-01-24T22:45:36Z"),
+	}
+)
+
+func TestOrgService_CacheUsage(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	tests := []struct {
+		name             string
+		mockResponses    []MockResponse
+		s                *OrgService
+		ctx              context.Context
+		expectedUsage    *OrgCacheUsage
+		expectedResponse *Response
+		expectedError    string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &OrgService{
+				client: c,
+				org:    "octo-org",
+			},
+			ctx:           nil,
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"GET", "/orgs/octo-org/actions/cache/usage", 401, http.Header{}, `{
+					"message": "Bad credentials"
+				}`},
+			},
+			s: &OrgService{
+				client: c,
+				org:    "octo-org",
+			},
+			ctx:           context.Background(),
+			expectedError: `GET /orgs/octo-org/actions/cache/usage: 401 Bad credentials`,
+		},
+		{
+			name: "InvalidResponse",
+			mockResponses: []MockResponse{
+				{"GET", "/orgs/octo-org/actions/cache/usage", 200, http.Header{}, `{`},
+			},
+			s: &OrgService{
+				client: c,
+				org:    "octo-org",
+			},
+			ctx:           context.Background(),
+			expectedError: `unexpected EOF`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"GET", "/orgs/octo-org/actions/cache/usage", 200, header, orgCacheUsageBody},
+			},
+			s: &OrgService{
+				client: c,
+				org:    "octo-org",
+			},
+			ctx:           context.Background(),
+			expectedUsage: &orgCacheUsage,
+			expectedResponse: &Response{
+				Rate: expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			defer ts.Close()
+
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			usage, resp, err := tc.s.CacheUsage(tc.ctx)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, usage)
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedUsage, usage)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestOrgService_CacheUsageByRepo(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	tests := []struct {
+		name             string
+		mockResponses    []MockResponse
+		s                *OrgService
+		ctx              context.Context
+		pageSize         int
+		pageNo           int
+		expectedUsages   []CacheUsage
+		expectedResponse *Response
+		expectedError    string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &OrgService{
+				client: c,
+				org:    "octo-org",
+			},
+			ctx:           nil,
+			pageSize:      10,
+			pageNo:        1,
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"GET", "/orgs/octo-org/actions/cache/usage-by-repository", 401, http.Header{}, `{
+					"message": "Bad credentials"
+				}`},
+			},
+			s: &OrgService{
+				client: c,
+				org:    "octo-org",
+			},
+			ctx:           context.Background(),
+			pageSize:      10,
+			pageNo:        1,
+			expectedError: `GET /orgs/octo-org/actions/cache/usage-by-repository: 401 Bad credentials`,
+		},
+		{
+			name: "InvalidResponse",
+			mockResponses: []MockResponse{
+				{"GET", "/orgs/octo-org/actions/cache/usage-by-repository", 200, http.Header{}, `{`},
+			},
+			s: &OrgService{
+				client: c,
+				org:    "octo-org",
+			},
+			ctx:           context.Background(),
+			pageSize:      10,
+			pageNo:        1,
+			expectedError: `unexpected EOF`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"GET", "/orgs/octo-org/actions/cache/usage-by-repository", 200, header, cacheUsageByRepoBody},
+			},
+			s: &OrgService{
+				client: c,
+				org:    "octo-org",
+			},
+			ctx:            context.Background(),
+			pageSize:       10,
+			pageNo:         1,
+			expectedUsages: []CacheUsage{cacheUsage},
+			expectedResponse: &Response{
+				Pages: expectedPages,
+				Rate:  expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			defer ts.Close()
+
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			usages, resp, err := tc.s.CacheUsageByRepo(tc.ctx, tc.pageSize, tc.pageNo)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, usages)
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedUsages, usages)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Pages, resp.Pages)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestCachesService_Usage(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	tests := []struct {
+		name             string
+		mockResponses    []MockResponse
+		s                *CachesService
+		ctx              context.Context
+		expectedUsage    *CacheUsage
+		expectedResponse *Response
+		expectedError    string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &CachesService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           nil,
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/actions/cache/usage", 401, http.Header{}, `{
+					"message": "Bad credentials"
+				}`},
+			},
+			s: &CachesService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			expectedError: `GET /repos/octocat/Hello-World/actions/cache/usage: 401 Bad credentials`,
+		},
+		{
+			name: "InvalidResponse",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/actions/cache/usage", 200, http.Header{}, `{`},
+			},
+			s: &CachesService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			expectedError: `unexpected EOF`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/actions/cache/usage", 200, header, cacheUsageBody},
+			},
+			s: &CachesService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			expectedUsage: &cacheUsage,
+			expectedResponse: &Response{
+				Rate: expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			defer ts.Close()
+
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			usage, resp, err := tc.s.Usage(tc.ctx)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, usage)
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedUsage, usage)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestCachesService_List(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	tests := []struct {
+		name             string
+		mockResponses    []MockResponse
+		s                *CachesService
+		ctx              context.Context
+		pageSize         int
+		pageNo           int
+		filter           CachesFilter
+		expectedCaches   []Cache
+		expectedResponse *Response
+		expectedError    string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &CachesService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           nil,
+			pageSize:      10,
+			pageNo:        1,
+			filter:        CachesFilter{Ref: "refs/heads/main", Sort: "last_accessed_at", Direction: "asc"},
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/actions/caches", 401, http.Header{}, `{
+					"message": "Bad credentials"
+				}`},
+			},
+			s: &CachesService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			pageSize:      10,
+			pageNo:        1,
+			filter:        CachesFilter{Ref: "refs/heads/main", Sort: "last_accessed_at", Direction: "asc"},
+			expectedError: `GET /repos/octocat/Hello-World/actions/caches: 401 Bad credentials`,
+		},
+		{
+			name: "InvalidResponse",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/actions/caches", 200, http.Header{}, `{`},
+			},
+			s: &CachesService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			pageSize:      10,
+			pageNo:        1,
+			filter:        CachesFilter{Ref: "refs/heads/main", Sort: "last_accessed_at", Direction: "asc"},
+			expectedError: `unexpected EOF`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/actions/caches", 200, header, cachesBody},
+			},
+			s: &CachesService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:            context.Background(),
+			pageSize:       10,
+			pageNo:         1,
+			filter:         CachesFilter{Ref: "refs/heads/main", Sort: "last_accessed_at", Direction: "asc"},
+			expectedCaches: []Cache{cache},
+			expectedResponse: &Response{
+				Pages: expectedPages,
+				Rate:  expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			defer ts.Close()
+
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			caches, resp, err := tc.s.List(tc.ctx, tc.pageSize, tc.pageNo, tc.filter)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, caches)
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedCaches, caches)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Pages, resp.Pages)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestCachesService_DeleteByKey(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	tests := []struct {
+		name             string
+		mockResponses    []MockResponse
+		s                *CachesService
+		ctx              context.Context
+		key              string
+		ref              string
+		expectedCaches   []Cache
+		expectedResponse *Response
+		expectedError    string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &CachesService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           nil,
+			key:           "Linux-node-958aff96db2d75d67787d1e634ae70b659de937b",
+			ref:           "refs/heads/main",
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"DELETE", "/repos/octocat/Hello-World/actions/caches", 401, http.Header{}, `{
+					"message": "Bad credentials"
+				}`},
+			},
+			s: &CachesService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			key:           "Linux-node-958aff96db2d75d67787d1e634ae70b659de937b",
+			ref:           "refs/heads/main",
+			expectedError: `DELETE /repos/octocat/Hello-World/actions/caches: 401 Bad credentials`,
+		},
+		{
+			name: "InvalidResponse",
+			mockResponses: []MockResponse{
+				{"DELETE", "/repos/octocat/Hello-World/actions/caches", 200, http.Header{}, `{`},
+			},
+			s: &CachesService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			key:           "Linux-node-958aff96db2d75d67787d1e634ae70b659de937b",
+			ref:           "refs/heads/main",
+			expectedError: `unexpected EOF`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"DELETE", "/repos/octocat/Hello-World/actions/caches", 200, header, cachesBody},
+			},
+			s: &CachesService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:            context.Background(),
+			key:            "Linux-node-958aff96db2d75d67787d1e634ae70b659de937b",
+			ref:            "refs/heads/main",
+			expectedCaches: []Cache{cache},
+			expectedResponse: &Response{
+				Rate: expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			defer ts.Close()
+
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			caches, resp, err := tc.s.DeleteByKey(tc.ctx, tc.key, tc.ref)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, caches)
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedCaches, caches)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestCachesService_Delete(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	tests := []struct {
+		name             string
+		mockResponses    []MockResponse
+		s                *CachesService
+		ctx              context.Context
+		cacheID          int
+		expectedResponse *Response
+		expectedError    string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &CachesService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           nil,
+			cacheID:       505,
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"DELETE", "/repos/octocat/Hello-World/actions/caches/505", 401, http.Header{}, `{
+					"message": "Bad credentials"
+				}`},
+			},
+			s: &CachesService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			cacheID:       505,
+			expectedError: `DELETE /repos/octocat/Hello-World/actions/caches/505: 401 Bad credentials`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"DELETE", "/repos/octocat/Hello-World/actions/caches/505", 204, header, ``},
+			},
+			s: &CachesService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:     context.Background(),
+			cacheID: 505,
+			expectedResponse: &Response{
+				Rate: expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			defer ts.Close()
+
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			resp, err := tc.s.Delete(tc.ctx, tc.cacheID)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestEvictionResult_String(t *testing.T) {
+	tests := []struct {
+		name           string
+		r              *EvictionResult
+		expectedString string
+	}{
+		{
+			name: "NoEviction",
+			r: &EvictionResult{
+				Evictions:  []CacheEviction{},
+				SizeBefore: 1024,
+				SizeAfter:  1024,
+			},
+			expectedString: "0 caches evicted, 1024 -> 1024 bytes",
+		},
+		{
+			name: "OK",
+			r: &EvictionResult{
+				Evictions: []CacheEviction{
+					{Cache: cache, Reason: EvictionRef},
+				},
+				SizeBefore: 1024,
+				SizeAfter:  0,
+			},
+			expectedString: "- 505 refs/heads/main Linux-node-958aff96db2d75d67787d1e634ae70b659de937b (1024 bytes, last accessed 2019-01-24T22:45:36Z): ref\n1 caches evicted, 1024 -> 0 bytes",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expectedString, tc.r.String())
+		})
+	}
+}
+
+func TestPlanEviction(t *testing.T) {
+	now := parseGitHubTime("2024-06-30T00:00:00Z")
+	day := 24 * time.Hour
+
+	main := Cache{ID: 1, Ref: "refs/heads/main", Key: "main", SizeInBytes: 400, CreatedAt: now.Add(-10 * day), LastAccessedAt: now.Add(-1 * day)}
+	old := Cache{ID: 2, Ref: "refs/heads/main", Key: "old", SizeInBytes: 300, CreatedAt: now.Add(-30 * day), LastAccessedAt: now.Add(-20 * day)}
+	pr := Cache{ID: 3, Ref: "refs/pull/7/merge", Key: "pr", SizeInBytes: 200, CreatedAt: now.Add(-2 * day), LastAccessedAt: now.Add(-2 * day)}
+	feat := Cache{ID: 4, Ref: "refs/heads/feature", Key: "feat", SizeInBytes: 100, CreatedAt: now.Add(-5 * day), LastAccessedAt: now.Add(-5 * day)}
+	caches := []Cache{main, old, pr, feat}
+
+	tests := []struct {
+		name           string
+		caches         []Cache
+		policy         EvictionPolicy
+		expectedResult *EvictionResult
+	}{
+		{
+			name:   "NoCaches",
+			caches: []Cache{},
+			policy: EvictionPolicy{MaxAge: day, TargetSize: 100},
+			expectedResult: &EvictionResult{
+				Evictions: []CacheEviction{},
+			},
+		},
+		{
+			name:   "NoPolicy",
+			caches: caches,
+			policy: EvictionPolicy{},
+			expectedResult: &EvictionResult{
+				Evictions:  []CacheEviction{},
+				SizeBefore: 1000,
+				SizeAfter:  1000,
+			},
+		},
+		{
+			name:   "ByAge",
+			caches: caches,
+			policy: EvictionPolicy{MaxAge: 7 * day},
+			expectedResult: &EvictionResult{
+				Evictions: []CacheEviction{
+					{Cache: old, Reason: EvictionAge},
+					{Cache: main, Reason: EvictionAge},
+				},
+				SizeBefore: 1000,
+				SizeAfter:  300,
+			},
+		},
+		{
+			name:   "ByRef",
+			caches: caches,
+			policy: EvictionPolicy{Refs: []string{"refs/pull/*/merge", "refs/heads/feature"}},
+			expectedResult: &EvictionResult{
+				Evictions: []CacheEviction{
+					{Cache: feat, Reason: EvictionRef},
+					{Cache: pr, Reason: EvictionRef},
+				},
+				SizeBefore: 1000,
+				SizeAfter:  700,
+			},
+		},
+		{
+			name:   "BySize",
+			caches: caches,
+			policy: EvictionPolicy{TargetSize: 500},
+			expectedResult: &EvictionResult{
+				Evictions: []CacheEviction{
+					{Cache: old, Reason: EvictionSize},
+					{Cache: feat, Reason: EvictionSize},
+					{Cache: pr, Reason: EvictionSize},
+				},
+				SizeBefore: 1000,
+				SizeAfter:  400,
+			},
+		},
+		{
+			name:   "TargetSizeAlreadyMet",
+			caches: caches,
+			policy: EvictionPolicy{Refs: []string{"refs/pull/7/merge"}, TargetSize: 800},
+			expectedResult: &EvictionResult{
+				Evictions: []CacheEviction{
+					{Cache: pr, Reason: EvictionRef},
+				},
+				SizeBefore: 1000,
+				SizeAfter:  800,
+			},
+		},
+		{
+			name:   "Combined",
+			caches: caches,
+			policy: EvictionPolicy{MaxAge: 20 * day, Refs: []string{"refs/pull/*/merge"}, TargetSize: 450},
+			expectedResult: &EvictionResult{
+				Evictions: []CacheEviction{
+					{Cache: old, Reason: EvictionAge},
+					{Cache: feat, Reason: EvictionSize},
+					{Cache: pr, Reason: EvictionRef},
+				},
+				SizeBefore: 1000,
+				SizeAfter:  400,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			result := planEviction(tc.caches, tc.policy, now)
+
+			assert.Equal(t, tc.expectedResult, result)
+		})
+	}
+}
+
+func TestCachesService_Evict(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	policy := EvictionPolicy{Refs: []string{"refs/heads/main"}}
+
+	newerCache := Cache{
+		ID:             506,
+		Ref:            "refs/heads/main",
+		Key:            "Linux-node-4f2c2a6a4ad7a2c6a3b1a4b1de0fbc1d5e9b1c3a",
+		SizeInBytes:    2048,
+		LastAccessedAt: parseGitHubTime("2019-01-25T22:45:36Z"),
+		CreatedAt:      parseGitHubTime("2019-01-25T22:45:36Z"),
+	}
+
+	twoCachesBody := `{
+		"total_count": 2,
+		"actions_caches": [
+			{
+				"id": 506,
+				"ref": "refs/heads/main",
+				"key": "Linux-node-4f2c2a6a4ad7a2c6a3b1a4b1de0fbc1d5e9b1c3a",
+				"last_accessed_at": "2019-01-25T22:45:36.000Z",
+				"created_at": "2019-01-25T22:45:36.000Z",
+				"size_in_bytes": 2048
+			},
+			{
+				"id": 505,
+				"ref": "refs/heads/main",
+				"key": "Linux-node-958aff96db2d75d67787d1e634ae70b659de937b",
+				"version": "73885106f58cc52a7df9ec4d4a5622a5614813162cb516c759a30af6bf56e6f0",
+				"last_accessed_at": "2019-01-24T22:45:36.000Z",
+				"created_at": "2019-01-24T22:45:36.000Z",
+				"size_in_bytes": 1024
+			}
+		]
+	}`
+
+	tests := []struct {
+		name           string
+		mockResponses  []MockResponse
+		s              *CachesService
+		ctx            context.Context
+		policy         EvictionPolicy
+		expectedResult *EvictionResult
+		expectedError  string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &CachesService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           nil,
+			policy:        policy,
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "ListFails",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/actions/caches", 401, http.Header{}, `{
+					"message": "Bad credentials"
+				}`},
+			},
+			s: &CachesService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			policy:        policy,
+			expectedError: `GET /repos/octocat/Hello-World/actions/caches: 401 Bad credentials`,
+		},
+		{
+			name: "DeleteFails",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/actions/caches", 200, http.Header{}, cachesBody},
+				{"DELETE", "/repos/octocat/Hello-World/actions/caches/505", 401, http.Header{}, `{
+					"message": "Bad credentials"
+				}`},
+			},
+			s: &CachesService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:    context.Background(),
+			policy: policy,
+			expectedResult: &EvictionResult{
+				Evictions: []CacheEviction{
+					{Cache: cache, Reason: EvictionRef},
+				},
+				SizeBefore: 1024,
+				SizeAfter:  1024,
+			},
+			expectedError: `delete cache 505: DELETE /repos/octocat/Hello-World/actions/caches/505: 401 Bad credentials`,
+		},
+		{
+			name: "PartialDelete",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/actions/caches", 200, http.Header{}, twoCachesBody},
+				{"DELETE", "/repos/octocat/Hello-World/actions/caches/505", 204, http.Header{}, ``},
+				{"DELETE", "/repos/octocat/Hello-World/actions/caches/506", 401, http.Header{}, `{
+					"message": "Bad credentials"
+				}`},
+			},
+			s: &CachesService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:    context.Background(),
+			policy: policy,
+			expectedResult: &EvictionResult{
+				Evictions: []CacheEviction{
+					{Cache: cache, Reason: EvictionRef, Deleted: true},
+					{Cache: newerCache, Reason: EvictionRef},
+				},
+				SizeBefore: 3072,
+				SizeAfter:  2048,
+			},
+			expectedError: `delete cache 506: DELETE /repos/octocat/Hello-World/actions/caches/506: 401 Bad credentials`,
+		},
+		{
+			name: "DryRun",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/actions/caches", 200, http.Header{}, cachesBody},
+			},
+			s: &CachesService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:    context.Background(),
+			policy: EvictionPolicy{Refs: []string{"refs/heads/main"}, DryRun: true},
+			expectedResult: &EvictionResult{
+				Evictions: []CacheEviction{
+					{Cache: cache, Reason: EvictionRef},
+				},
+				SizeBefore: 1024,
+			},
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/actions/caches", 200, http.Header{}, cachesBody},
+				{"DELETE", "/repos/octocat/Hello-World/actions/caches/505", 204, http.Header{}, ``},
+			},
+			s: &CachesService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:    context.Background(),
+			policy: policy,
+			expectedResult: &EvictionResult{
+				Evictions: []CacheEviction{
+					{Cache: cache, Reason: EvictionRef, Deleted: true},
+				},
+				SizeBefore: 1024,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			defer ts.Close()
+
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			result, err := tc.s.Evict(tc.ctx, tc.policy)
+
+			if tc.expectedError != "" {
+				assert.Equal(t, tc.expectedResult, result)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedResult, result)
+			}
+		})
+	}
+}
@@ -0,0 +1,439 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// maxAnnotations is the maximum number of annotations GitHub accepts in a single check run request.
+const maxAnnotations = 50
+
+// ChecksService provides GitHub APIs for check runs and check suites in a repository.
+// See https://docs.github.com/en/rest/checks
+type ChecksService struct {
+	client      *Client
+	owner, repo string
+}
+
+// CheckStatus is the status of a GitHub check run or check suite.
+type CheckStatus string
+
+const (
+	// CheckStatusQueued is the status of a queued check.
+	CheckStatusQueued CheckStatus = "queued"
+	// CheckStatusInProgress is the status of a check in progress.
+	CheckStatusInProgress CheckStatus = "in_progress"
+	// CheckStatusCompleted is the status of a completed check.
+	CheckStatusCompleted CheckStatus = "completed"
+)
+
+// CheckConclusion is the conclusion of a completed GitHub check run or check suite.
+type CheckConclusion string
+
+const (
+	// ConclusionActionRequired means additional action is required.
+	ConclusionActionRequired CheckConclusion = "action_required"
+	// ConclusionCancelled means the check was cancelled.
+	ConclusionCancelled CheckConclusion = "cancelled"
+	// ConclusionFailure means the check failed.
+	ConclusionFailure CheckConclusion = "failure"
+	// ConclusionNeutral means the check neither succeeded nor failed.
+	ConclusionNeutral CheckConclusion = "neutral"
+	// ConclusionSuccess means the check succeeded.
+	ConclusionSuccess CheckConclusion = "success"
+	// ConclusionSkipped means the check was skipped.
+	ConclusionSkipped CheckConclusion = "skipped"
+	// ConclusionStale means the check was marked stale by GitHub.
+	ConclusionStale CheckConclusion = "stale"
+	// ConclusionTimedOut means the check timed out.
+	ConclusionTimedOut CheckConclusion = "timed_out"
+)
+
+type (
+	// CheckAnnotation is an annotation for a specific line of code in a check run.
+	CheckAnnotation struct {
+		Path            string `json:"path"`
+		StartLine       int    `json:"start_line"`
+		EndLine         int    `json:"end_line"`
+		StartColumn     *int   `json:"start_column,omitempty"` // Only when StartLine equals EndLine
+		EndColumn       *int   `json:"end_column,omitempty"`   // Only when StartLine equals EndLine
+		AnnotationLevel string `json:"annotation_level"`       // Either notice, warning, or failure
+		Message         string `json:"message"`
+		Title           string `json:"title,omitempty"`
+		RawDetails      string `json:"raw_details,omitempty"`
+	}
+
+	// CheckImage is an image displayed in the output of a check run.
+	CheckImage struct {
+		Alt      string `json:"alt"`
+		ImageURL string `json:"image_url"`
+		Caption  string `json:"caption,omitempty"`
+	}
+
+	// CheckOutput is the output of a check run.
+	// Title and Summary are required when an output is provided.
+	CheckOutput struct {
+		Title       string            `json:"title"`
+		Summary     string            `json:"summary"`
+		Text        string            `json:"text,omitempty"`
+		Annotations []CheckAnnotation `json:"annotations,omitempty"`
+		Images      []CheckImage      `json:"images,omitempty"`
+	}
+
+	// CheckAction is a button displayed on a check run that triggers a requested_action check_run webhook event.
+	CheckAction struct {
+		Label       string `json:"label"`       // At most 20 characters
+		Description string `json:"description"` // At most 40 characters
+		Identifier  string `json:"identifier"`  // At most 20 characters
+	}
+
+	// CheckRunParams is used for creating or updating a GitHub check run.
+	// Name and HeadSHA are required for creating a check run.
+	// Conclusion is required if CompletedAt is set or Status is completed.
+	CheckRunParams struct {
+		Name        string          `json:"name,omitempty"`
+		HeadSHA     string          `json:"head_sha,omitempty"`
+		DetailsURL  string          `json:"details_url,omitempty"`
+		ExternalID  string          `json:"external_id,omitempty"`
+		Status      CheckStatus     `json:"status,omitempty"`
+		Conclusion  CheckConclusion `json:"conclusion,omitempty"`
+		StartedAt   *time.Time      `json:"started_at,omitempty"`
+		CompletedAt *time.Time      `json:"completed_at,omitempty"`
+		Output      *CheckOutput    `json:"output,omitempty"`
+		Actions     []CheckAction   `json:"actions,omitempty"`
+	}
+
+	// CheckRunOutput is the output of a GitHub check run.
+	CheckRunOutput struct {
+		Title            string `json:"title"`
+		Summary          string `json:"summary"`
+		Text             string `json:"text"`
+		AnnotationsCount int    `json:"annotations_count"`
+		AnnotationsURL   string `json:"annotations_url"`
+	}
+
+	// CheckRun is a GitHub check run object.
+	// Only the ID of CheckSuite is available.
+	CheckRun struct {
+		ID          int             `json:"id"`
+		Name        string          `json:"name"`
+		HeadSHA     string          `json:"head_sha"`
+		ExternalID  string          `json:"external_id"`
+		Status      CheckStatus     `json:"status"`
+		Conclusion  CheckConclusion `json:"conclusion"`
+		Output      CheckRunOutput  `json:"output"`
+		CheckSuite  CheckSuite      `json:"check_suite"`
+		App         App             `json:"app"`
+		URL         string          `json:"url"`
+		HTMLURL     string          `json:"html_url"`
+		DetailsURL  string          `json:"details_url"`
+		StartedAt   *time.Time      `json:"started_at"`
+		CompletedAt *time.Time      `json:"completed_at"`
+	}
+
+	// CheckSuite is a GitHub check suite object.
+	CheckSuite struct {
+		ID                   int             `json:"id"`
+		HeadBranch           string          `json:"head_branch"`
+		HeadSHA              string          `json:"head_sha"`
+		Status               CheckStatus     `json:"status"`
+		Conclusion           CheckConclusion `json:"conclusion"`
+		Before               string          `json:"before"`
+		After                string          `json:"after"`
+		App                  App             `json:"app"`
+		LatestCheckRunsCount int             `json:"latest_check_runs_count"`
+		URL                  string          `json:"url"`
+		CheckRunsURL         string          `json:"check_runs_url"`
+		CreatedAt            time.Time       `json:"created_at"`
+		UpdatedAt            time.Time       `json:"updated_at"`
+	}
+
+	// CheckRunEvent is the payload of a check_run webhook event.
+	// RequestedAction is only set when Action is requested_action and contains the identifier of the action requested by a user.
+	CheckRunEvent struct {
+		Action          string   `json:"action"` // Either created, completed, rerequested, or requested_action
+		CheckRun        CheckRun `json:"check_run"`
+		RequestedAction *struct {
+			Identifier string `json:"identifier"`
+		} `json:"requested_action"`
+		Repository Repository `json:"repository"`
+		Sender     User       `json:"sender"`
+	}
+)
+
+// CheckRunsFilter are used for fetching CheckRuns.
+type CheckRunsFilter struct {
+	CheckName string
+	Status    CheckStatus
+	Filter    string // Either latest or all
+	AppID     int
+}
+
+// CheckSuitesFilter are used for fetching CheckSuites.
+type CheckSuitesFilter struct {
+	CheckName string
+	AppID     int
+}
+
+// splitAnnotations splits the annotations of a check run into the first batch that can be sent with the params and the rest.
+func splitAnnotations(params CheckRunParams) (CheckRunParams, []CheckAnnotation) {
+	if params.Output == nil || len(params.Output.Annotations) <= maxAnnotations {
+		return params, nil
+	}
+
+	output := *params.Output
+	rest := output.Annotations[maxAnnotations:]
+	output.Annotations = output.Annotations[:maxAnnotations]
+	params.Output = &output
+
+	return params, rest
+}
+
+// CreateRun creates a new check run for a commit.
+// If there are more than 50 annotations, the remaining annotations are added to the check run with sequential updates.
+// See https://docs.github.com/en/rest/checks/runs#create-a-check-run
+func (s *ChecksService) CreateRun(ctx context.Context, params CheckRunParams) (*CheckRun, *Response, error) {
+	params, rest := splitAnnotations(params)
+
+	url := fmt.Sprintf("/repos/%s/%s/check-runs", s.owner, s.repo)
+	req, err := s.client.NewRequest(ctx, "POST", url, params)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	run := new(CheckRun)
+
+	resp, err := s.client.Do(req, run)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if len(rest) > 0 {
+		return s.annotateRun(ctx, run.ID, params.Output, rest)
+	}
+
+	return run, resp, nil
+}
+
+// UpdateRun updates an existing check run.
+// If there are more than 50 annotations, they are added to the check run with sequential updates.
+// The returned check run and response are from the last update.
+// See https://docs.github.com/en/rest/checks/runs#update-a-check-run
+func (s *ChecksService) UpdateRun(ctx context.Context, id int, params CheckRunParams) (*CheckRun, *Response, error) {
+	params, rest := splitAnnotations(params)
+
+	run, resp, err := s.updateRun(ctx, id, params)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if len(rest) > 0 {
+		return s.annotateRun(ctx, id, params.Output, rest)
+	}
+
+	return run, resp, nil
+}
+
+// annotateRun adds annotations to a check run in batches of at most 50 annotations.
+func (s *ChecksService) annotateRun(ctx context.Context, id int, output *CheckOutput, annotations []CheckAnnotation) (*CheckRun, *Response, error) {
+	var run *CheckRun
+	var resp *Response
+	var err error
+
+	for len(annotations) > 0 {
+		n := min(len(annotations), maxAnnotations)
+		params := CheckRunParams{
+			Output: &CheckOutput{
+				Title:       output.Title,
+				Summary:     output.Summary,
+				Annotations: annotations[:n],
+			},
+		}
+
+		if run, resp, err = s.updateRun(ctx, id, params); err != nil {
+			return nil, nil, err
+		}
+
+		annotations = annotations[n:]
+	}
+
+	return run, resp, nil
+}
+
+func (s *ChecksService) updateRun(ctx context.Context, id int, params CheckRunParams) (*CheckRun, *Response, error) {
+	url := fmt.Sprintf("/repos/%s/%s/check-runs/%d", s.owner, s.repo, id)
+	req, err := s.client.NewRequest(ctx, "PATCH", url, params)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	run := new(CheckRun)
+
+	resp, err := s.client.Do(req, run)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return run, resp, nil
+}
+
+// GetRun retrieves a check run by its id.
+// See https://docs.github.com/en/rest/checks/runs#get-a-check-run
+func (s *ChecksService) GetRun(ctx context.Context, id int) (*CheckRun, *Response, error) {
+	url := fmt.Sprintf("/repos/%s/%s/check-runs/%d", s.owner, s.repo, id)
+	req, err := s.client.NewRequest(ctx, "GET", url, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	run := new(CheckRun)
+
+	resp, err := s.client.Do(req, run)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return run, resp, nil
+}
+
+// ListRuns retrieves all check runs for a reference (SHA, branch, or tag) page by page.
+// See https://docs.github.com/en/rest/checks/runs#list-check-runs-for-a-git-reference
+func (s *ChecksService) ListRuns(ctx context.Context, ref string, pageSize, pageNo int, filter CheckRunsFilter) ([]CheckRun, *Response, error) {
+	url := fmt.Sprintf("/repos/%s/%s/commits/%s/check-runs", s.owner, s.repo, escapePath(ref))
+	req, err := s.client.NewPageRequest(ctx, "GET", url, pageSize, pageNo, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	q := req.URL.Query()
+	if filter.CheckName != "" {
+		q.Add("check_name", filter.CheckName)
+	}
+	if filter.Status != "" {
+		q.Add("status", string(filter.Status))
+	}
+	if filter.Filter != "" {
+		q.Add("filter", filter.Filter)
+	}
+	if filter.AppID != 0 {
+		q.Add("app_id", strconv.Itoa(filter.AppID))
+	}
+	req.URL.RawQuery = q.Encode()
+
+	body := new(struct {
+		TotalCount int        `json:"total_count"`
+		CheckRuns  []CheckRun `json:"check_runs"`
+	})
+
+	resp, err := s.client.Do(req, body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return body.CheckRuns, resp, nil
+}
+
+// ListRunAnnotations retrieves all annotations for a check run page by page.
+// See https://docs.github.com/en/rest/checks/runs#list-check-run-annotations
+func (s *ChecksService) ListRunAnnotations(ctx context.Context, id, pageSize, pageNo int) ([]CheckAnnotation, *Response, error) {
+	url := fmt.Sprintf("/repos/%s/%s/check-runs/%d/annotations", s.owner, s.repo, id)
+	req, err := s.client.NewPageRequest(ctx, "GET", url, pageSize, pageNo, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	annotations := []CheckAnnotation{}
+
+	resp, err := s.client.Do(req, &annotations)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return annotations, resp, nil
+}
+
+// RerequestRun triggers GitHub to rerequest an existing check run.
+// See https://docs.github.com/en/rest/checks/runs#rerequest-a-check-run
+func (s *ChecksService) RerequestRun(ctx context.Context, id int) (*Response, error) {
+	url := fmt.Sprintf("/repos/%s/%s/check-runs/%d/rerequest", s.owner, s.repo, id)
+	req, err := s.client.NewRequest(ctx, "POST", url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// GetSuite retrieves a check suite by its id.
+// See https://docs.github.com/en/rest/checks/suites#get-a-check-suite
+func (s *ChecksService) GetSuite(ctx context.Context, id int) (*CheckSuite, *Response, error) {
+	url := fmt.Sprintf("/repos/%s/%s/check-suites/%d", s.owner, s.repo, id)
+	req, err := s.client.NewRequest(ctx, "GET", url, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	suite := new(CheckSuite)
+
+	resp, err := s.client.Do(req, suite)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return suite, resp, nil
+}
+
+// ListSuites retrieves all check suites for a reference (SHA, branch, or tag) page by page.
+// See https://docs.github.com/en/rest/checks/suites#list-check-suites-for-a-git-reference
+func (s *ChecksService) ListSuites(ctx context.Context, ref string, pageSize, pageNo int, filter CheckSuitesFilter) ([]CheckSuite, *Response, error) {
+	url := fmt.Sprintf("/repos/%s/%s/commits/%s/check-suites", s.owner, s.repo, escapePath(ref))
+	req, err := s.client.NewPageRequest(ctx, "GET", url, pageSize, pageNo, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	q := req.URL.Query()
+	if filter.CheckName != "" {
+		q.Add("check_name", filter.CheckName)
+	}
+	if filter.AppID != 0 {
+		q.Add("app_id", strconv.Itoa(filter.AppID))
+	}
+	req.URL.RawQuery = q.Encode()
+
+	body := new(struct {
+		TotalCount  int          `json:"total_count"`
+		CheckSuites []CheckSuite `json:"check_suites"`
+	})
+
+	resp, err := s.client.Do(req, body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return body.CheckSuites, resp, nil
+}
+
+// RerequestSuite triggers GitHub to rerequest an existing check suite.
+// See https://docs.github.com/en/rest/checks/suites#rerequest-a-check-suite
+func (s *ChecksService) RerequestSuite(ctx context.Context, id int) (*Response, error) {
+	url := fmt.Sprintf("/repos/%s/%s/check-suites/%d/rerequest", s.owner, s.repo, id)
+	req, err := s.client.NewRequest(ctx, "POST", url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,1401 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	checkRunBody = `{
+		"id": 4,
+		"name": "mighty_readme",
+		"head_sha": "ce587453ced02b1526dfb4cb910479d431683101",
+		"external_id": "42",
+		"status": "completed",
+		"conclusion": "success",
+		"output": {
+			"title": "Mighty Readme report",
+			"summary": "There are 0 failures, 2 warnings, and 1 notice.",
+			"text": "You may have some misspelled words on lines 2 and 4.",
+			"annotations_count": 2,
+			"annotations_url": "https://api.github.com/repos/octocat/Hello-World/check-runs/4/annotations"
+		},
+		"check_suite": {
+			"id": 5
+		},
+		"app": {
+			"id": 1,
+			"slug": "octoapp",
+			"name": "Octocat App",
+			"owner": {
+				"login": "octocat",
+				"id": 1,
+				"type": "User"
+			}
+		},
+		"url": "https://api.github.com/repos/octocat/Hello-World/check-runs/4",
+		"html_url": "https://github.com/octocat/Hello-World/runs/4",
+		"details_url": "https://example.com",
+		"started_at": "2018-05-04T01:14:52Z",
+		"completed_at": "2018-05-04T01:14:52Z"
+	}`
+
+	checkRunsBody = `{
+		"total_count": 1,
+		"check_runs": [
+			{
+				"id": 4,
+				"name": "mighty_readme",
+				"head_sha": "ce587453ced02b1526dfb4cb910479d431683101",
+				"external_id": "42",
+				"status": "completed",
+				"conclusion": "success",
+				"output": {
+					"title": "Mighty Readme report",
+					"summary": "There are 0 failures, 2 warnings, and 1 notice.",
+					"text": "You may have some misspelled words on lines 2 and 4.",
+					"annotations_count": 2,
+					"annotations_url": "https://api.github.com/repos/octocat/Hello-World/check-runs/4/annotations"
+				},
+				"check_suite": {
+					"id": 5
+				},
+				"app": {
+					"id": 1,
+					"slug": "octoapp",
+					"name": "Octocat App",
+					"owner": {
+						"login": "octocat",
+						"id": 1,
+						"type": "User"
+					}
+				},
+				"url": "https://api.github.com/repos/octocat/Hello-World/check-runs/4",
+				"html_url": "https://github.com/octocat/Hello-World/runs/4",
+				"details_url": "https://example.com",
+				"started_at": "2018-05-04T01:14:52Z",
+				"completed_at": "2018-05-04T01:14:52Z"
+			}
+		]
+	}`
+
+	checkAnnotationsBody = `[
+		{
+			"path": "README.md",
+			"start_line": 2,
+			"end_line": 2,
+			"start_column": 5,
+			"end_column": 10,
+			"annotation_level": "warning",
+			"title": "Spell Checker",
+			"message": "Check your spelling for 'banaas'.",
+			"raw_details": "Do you mean 'bananas' or 'banana'?"
+		}
+	]`
+
+	checkSuiteBody = `{
+		"id": 5,
+		"head_branch": "main",
+		"head_sha": "d6fde92930d4715a2b49857d24b940956b26d2d3",
+		"status": "completed",
+		"conclusion": "neutral",
+		"before": "146e867f55c26428e5f9fade55a9bbf5e95a7912",
+		"after": "d6fde92930d4715a2b49857d24b940956b26d2d3",
+		"app": {
+			"id": 1,
+			"slug": "octoapp",
+			"name": "Octocat App",
+			"owner": {
+				"login": "octocat",
+				"id": 1,
+				"type": "User"
+			}
+		},
+		"latest_check_runs_count": 1,
+		"url": "https://api.github.com/repos/octocat/Hello-World/check-suites/5",
+		"check_runs_url": "https://api.github.com/repos/octocat/Hello-World/check-suites/5/check-runs",
+		"created_at": "2018-05-04T01:14:52Z",
+		"updated_at": "2018-05-04T01:14:52Z"
+	}`
+
+	checkSuitesBody = `{
+		"total_count": 1,
+		"check_suites": [
+			{
+				"id": 5,
+				"head_branch": "main",
+				"head_sha": "d6fde92930d4715a2b49857d24b940956b26d2d3",
+				"status": "completed",
+				"conclusion": "neutral",
+				"before": "146e867f55c26428e5f9fade55a9bbf5e95a7912",
+				"after": "d6fde92930d4715a2b49857d24b940956b26d2d3",
+				"app": {
+					"id": 1,
+					"slug": "octoapp",
+					"name": "Octocat App",
+					"owner": {
+						"login": "octocat",
+						"id": 1,
+						"type": "User"
+					}
+				},
+				"latest_check_runs_count": 1,
+				"url": "https://api.github.com/repos/octocat/Hello-World/check-suites/5",
+				"check_runs_url": "https://api.github.com/repos/octocat/Hello-World/check-suites/5/check-runs",
+				"created_at": "2018-05-04T01:14:52Z",
+				"updated_at": "2018-05-04T01:14:52Z"
+			}
+		]
+	}`
+)
+
+var (
+	checkApp = App{
+		ID:   1,
+		Slug: "octoapp",
+		Name: "Octocat App",
+		Owner: User{
+			ID:    1,
+			Login: "octocat",
+			Type:  "User",
+		},
+	}
+
+	checkRun = CheckRun{
+		ID:         4,
+		Name:       "mighty_readme",
+		HeadSHA:    "ce587453ced02b1526dfb4cb910479d431683101",
+		ExternalID: "42",
+		Status:     CheckStatusCompleted,
+		Conclusion: ConclusionSuccess,
+		Output: CheckRunOutput{
+			Title:            "Mighty Readme report",
+			Summary:          "There are 0 failures, 2 warnings, and 1 notice.",
+			Text:             "You may have some misspelled words on lines 2 and 4.",
+			AnnotationsCount: 2,
+			AnnotationsURL:   "https://api.github.com/repos/octocat/Hello-World/check-runs/4/annotations",
+		},
+		CheckSuite: CheckSuite{
+			ID: 5,
+		},
+		App:         checkApp,
+		URL:         "https://api.github.com/repos/octocat/Hello-World/check-runs/4",
+		HTMLURL:     "https://github.com/octocat/Hello-World/runs/4",
+		DetailsURL:  "https://example.com",
+		StartedAt:   parseGitHubTimePtr("2018-05-04T01:14:52Z"),
+		CompletedAt: parseGitHubTimePtr("2018-05-04T01:14:52Z"),
+	}
+
+	annotationStartColumn = 5
+	annotationEndColumn   = 10
+
+	checkAnnotation = CheckAnnotation{
+		Path:            "README.md",
+		StartLine:       2,
+		EndLine:         2,
+		StartColumn:     &annotationStartColumn,
+		EndColumn:       &annotationEndColumn,
+		AnnotationLevel: "warning",
+		Title:           "Spell Checker",
+		Message:         "Check your spelling for 'banaas'.",
+		RawDetails:      "Do you mean 'bananas' or 'banana'?",
+	}
+
+	checkSuite = CheckSuite{
+		ID:                   5,
+		HeadBranch:           "main",
+		HeadSHA:              "d6fde92930d4715a2b49857d24b940956b26d2d3",
+		Status:               CheckStatusCompleted,
+		Conclusion:           ConclusionNeutral,
+		Before:               "146e867f55c26428e5f9fade55a9bbf5e95a7912",
+		After:                "d6fde92930d4715a2b49857d24b940956b26d2d3",
+		App:                  checkApp,
+		LatestCheckRunsCount: 1,
+		URL:                  "https://api.github.com/repos/octocat/Hello-World/check-suites/5",
+		CheckRunsURL:         "https://api.github.com/repos/octocat/Hello-World/check-suites/5/check-runs",
+		CreatedAt:            parseGitHubTime("2018-05-04T01:14:52Z"),
+		UpdatedAt:            parseGitHubTime("2018-05-04T01:14:52Z"),
+	}
+)
+
+func newCheckAnnotations(n int) []CheckAnnotation {
+	annotations := make([]CheckAnnotation, n)
+	for i := range annotations {
+		annotations[i] = CheckAnnotation{
+			Path:            "main.go",
+			StartLine:       i + 1,
+			EndLine:         i + 1,
+			AnnotationLevel: "notice",
+			Message:         fmt.Sprintf("annotation %d", i+1),
+		}
+	}
+
+	return annotations
+}
+
+func TestSplitAnnotations(t *testing.T) {
+	annotations := newCheckAnnotations(120)
+
+	tests := []struct {
+		name               string
+		params             CheckRunParams
+		expectedParams     CheckRunParams
+		expectedRemainders []CheckAnnotation
+	}{
+		{
+			name: "NoOutput",
+			params: CheckRunParams{
+				Status: CheckStatusInProgress,
+			},
+			expectedParams: CheckRunParams{
+				Status: CheckStatusInProgress,
+			},
+			expectedRemainders: nil,
+		},
+		{
+			name: "FewAnnotations",
+			params: CheckRunParams{
+				Output: &CheckOutput{
+					Title:       "Lint",
+					Summary:     "3 issues",
+					Annotations: annotations[:3],
+				},
+			},
+			expectedParams: CheckRunParams{
+				Output: &CheckOutput{
+					Title:       "Lint",
+					Summary:     "3 issues",
+					Annotations: annotations[:3],
+				},
+			},
+			expectedRemainders: nil,
+		},
+		{
+			name: "ManyAnnotations",
+			params: CheckRunParams{
+				Output: &CheckOutput{
+					Title:       "Lint",
+					Summary:     "120 issues",
+					Annotations: annotations,
+				},
+			},
+			expectedParams: CheckRunParams{
+				Output: &CheckOutput{
+					Title:       "Lint",
+					Summary:     "120 issues",
+					Annotations: annotations[:50],
+				},
+			},
+			expectedRemainders: annotations[50:],
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			params, rest := splitAnnotations(tc.params)
+
+			assert.Equal(t, tc.expectedParams, params)
+			assert.Equal(t, tc.expectedRemainders, rest)
+		})
+	}
+}
+
+func TestCheckRunEvent(t *testing.T) {
+	payload := `{
+		"action": "requested_action",
+		"check_run": {
+			"id": 4,
+			"name": "mighty_readme",
+			"status": "completed",
+			"conclusion": "neutral"
+		},
+		"requested_action": {
+			"identifier": "fix_errors"
+		},
+		"repository": {
+			"id": 1296269,
+			"name": "Hello-World",
+			"full_name": "octocat/Hello-World"
+		},
+		"sender": {
+			"login": "octocat",
+			"id": 1,
+			"type": "User"
+		}
+	}`
+
+	var event CheckRunEvent
+	err := json.Unmarshal([]byte(payload), &event)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "requested_action", event.Action)
+	assert.Equal(t, 4, event.CheckRun.ID)
+	assert.NotNil(t, event.RequestedAction)
+	assert.Equal(t, "fix_errors", event.RequestedAction.Identifier)
+	assert.Equal(t, "octocat/Hello-World", event.Repository.FullName)
+	assert.Equal(t, "octocat", event.Sender.Login)
+}
+
+func TestChecksService_CreateRun(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	params := CheckRunParams{
+		Name:       "mighty_readme",
+		HeadSHA:    "ce587453ced02b1526dfb4cb910479d431683101",
+		ExternalID: "42",
+		Status:     CheckStatusCompleted,
+		Conclusion: ConclusionSuccess,
+		Output: &CheckOutput{
+			Title:       "Mighty Readme report",
+			Summary:     "There are 0 failures, 2 warnings, and 1 notice.",
+			Annotations: []CheckAnnotation{checkAnnotation},
+		},
+		Actions: []CheckAction{
+			{
+				Label:       "Fix",
+				Description: "Fix the spelling errors",
+				Identifier:  "fix_errors",
+			},
+		},
+	}
+
+	manyParams := CheckRunParams{
+		Name:    "lint",
+		HeadSHA: "ce587453ced02b1526dfb4cb910479d431683101",
+		Status:  CheckStatusCompleted,
+		Output: &CheckOutput{
+			Title:       "Lint report",
+			Summary:     "120 issues",
+			Annotations: newCheckAnnotations(120),
+		},
+	}
+
+	tests := []struct {
+		name             string
+		mockResponses    []MockResponse
+		s                *ChecksService
+		ctx              context.Context
+		params           CheckRunParams
+		expectedRun      *CheckRun
+		expectedResponse *Response
+		expectedError    string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &ChecksService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           nil,
+			params:        params,
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"POST", "/repos/octocat/Hello-World/check-runs", 422, http.Header{}, `{
+					"message": "Validation Failed"
+				}`},
+			},
+			s: &ChecksService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			params:        params,
+			expectedError: `POST /repos/octocat/Hello-World/check-runs: 422 Validation Failed`,
+		},
+		{
+			name: "InvalidResponse",
+			mockResponses: []MockResponse{
+				{"POST", "/repos/octocat/Hello-World/check-runs", 201, http.Header{}, `{`},
+			},
+			s: &ChecksService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			params:        params,
+			expectedError: `unexpected EOF`,
+		},
+		{
+			name: "AnnotationsFail",
+			mockResponses: []MockResponse{
+				{"POST", "/repos/octocat/Hello-World/check-runs", 201, header, `{"id": 4, "output": {"annotations_count": 50}}`},
+				{"PATCH", "/repos/octocat/Hello-World/check-runs/4", 422, http.Header{}, `{
+					"message": "Validation Failed"
+				}`},
+			},
+			s: &ChecksService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			params:        manyParams,
+			expectedError: `PATCH /repos/octocat/Hello-World/check-runs/4: 422 Validation Failed`,
+		},
+		{
+			name: "ManyAnnotations",
+			mockResponses: []MockResponse{
+				{"POST", "/repos/octocat/Hello-World/check-runs", 201, header, `{"id": 4, "output": {"annotations_count": 50}}`},
+				{"PATCH", "/repos/octocat/Hello-World/check-runs/4", 200, header, `{"id": 4, "output": {"annotations_count": 100}}`},
+				{"PATCH", "/repos/octocat/Hello-World/check-runs/4", 200, header, `{"id": 4, "output": {"annotations_count": 120}}`},
+			},
+			s: &ChecksService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:    context.Background(),
+			params: manyParams,
+			expectedRun: &CheckRun{
+				ID: 4,
+				Output: CheckRunOutput{
+					AnnotationsCount: 120,
+				},
+			},
+			expectedResponse: &Response{
+				Rate: expectedRate,
+			},
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"POST", "/repos/octocat/Hello-World/check-runs", 201, header, checkRunBody},
+			},
+			s: &ChecksService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:         context.Background(),
+			params:      params,
+			expectedRun: &checkRun,
+			expectedResponse: &Response{
+				Rate: expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			defer ts.Close()
+
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			run, resp, err := tc.s.CreateRun(tc.ctx, tc.params)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, run)
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedRun, run)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestChecksService_UpdateRun(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	params := CheckRunParams{
+		Status:     CheckStatusCompleted,
+		Conclusion: ConclusionSuccess,
+	}
+
+	manyParams := CheckRunParams{
+		Name:    "lint",
+		HeadSHA: "ce587453ced02b1526dfb4cb910479d431683101",
+		Status:  CheckStatusCompleted,
+		Output: &CheckOutput{
+			Title:       "Lint report",
+			Summary:     "120 issues",
+			Annotations: newCheckAnnotations(120),
+		},
+	}
+
+	tests := []struct {
+		name             string
+		mockResponses    []MockResponse
+		s                *ChecksService
+		ctx              context.Context
+		id               int
+		params           CheckRunParams
+		expectedRun      *CheckRun
+		expectedResponse *Response
+		expectedError    string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &ChecksService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           nil,
+			id:            4,
+			params:        params,
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"PATCH", "/repos/octocat/Hello-World/check-runs/4", 422, http.Header{}, `{
+					"message": "Validation Failed"
+				}`},
+			},
+			s: &ChecksService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			id:            4,
+			params:        params,
+			expectedError: `PATCH /repos/octocat/Hello-World/check-runs/4: 422 Validation Failed`,
+		},
+		{
+			name: "InvalidResponse",
+			mockResponses: []MockResponse{
+				{"PATCH", "/repos/octocat/Hello-World/check-runs/4", 200, http.Header{}, `{`},
+			},
+			s: &ChecksService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			id:            4,
+			params:        params,
+			expectedError: `unexpected EOF`,
+		},
+		{
+			name: "ManyAnnotations",
+			mockResponses: []MockResponse{
+				{"PATCH", "/repos/octocat/Hello-World/check-runs/4", 200, header, `{"id": 4, "output": {"annotations_count": 50}}`},
+				{"PATCH", "/repos/octocat/Hello-World/check-runs/4", 200, header, `{"id": 4, "output": {"annotations_count": 100}}`},
+				{"PATCH", "/repos/octocat/Hello-World/check-runs/4", 200, header, `{"id": 4, "output": {"annotations_count": 120}}`},
+			},
+			s: &ChecksService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:    context.Background(),
+			id:     4,
+			params: manyParams,
+			expectedRun: &CheckRun{
+				ID: 4,
+				Output: CheckRunOutput{
+					AnnotationsCount: 120,
+				},
+			},
+			expectedResponse: &Response{
+				Rate: expectedRate,
+			},
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"PATCH", "/repos/octocat/Hello-World/check-runs/4", 200, header, checkRunBody},
+			},
+			s: &ChecksService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:         context.Background(),
+			id:          4,
+			params:      params,
+			expectedRun: &checkRun,
+			expectedResponse: &Response{
+				Rate: expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			defer ts.Close()
+
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			run, resp, err := tc.s.UpdateRun(tc.ctx, tc.id, tc.params)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, run)
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedRun, run)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestChecksService_GetRun(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	tests := []struct {
+		name             string
+		mockResponses    []MockResponse
+		s                *ChecksService
+		ctx              context.Context
+		id               int
+		expectedRun      *CheckRun
+		expectedResponse *Response
+		expectedError    string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &ChecksService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           nil,
+			id:            4,
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/check-runs/4", 404, http.Header{}, `{
+					"message": "Not Found"
+				}`},
+			},
+			s: &ChecksService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			id:            4,
+			expectedError: `GET /repos/octocat/Hello-World/check-runs/4: 404 Not Found`,
+		},
+		{
+			name: "InvalidResponse",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/check-runs/4", 200, http.Header{}, `{`},
+			},
+			s: &ChecksService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			id:            4,
+			expectedError: `unexpected EOF`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/check-runs/4", 200, header, checkRunBody},
+			},
+			s: &ChecksService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:         context.Background(),
+			id:          4,
+			expectedRun: &checkRun,
+			expectedResponse: &Response{
+				Rate: expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			defer ts.Close()
+
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			run, resp, err := tc.s.GetRun(tc.ctx, tc.id)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, run)
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedRun, run)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestChecksService_ListRuns(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	filter := CheckRunsFilter{
+		CheckName: "mighty_readme",
+		Status:    CheckStatusCompleted,
+		Filter:    "latest",
+		AppID:     1,
+	}
+
+	tests := []struct {
+		name             string
+		mockResponses    []MockResponse
+		s                *ChecksService
+		ctx              context.Context
+		ref              string
+		pageSize         int
+		pageNo           int
+		filter           CheckRunsFilter
+		expectedRuns     []CheckRun
+		expectedResponse *Response
+		expectedError    string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &ChecksService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           nil,
+			ref:           "main",
+			pageSize:      10,
+			pageNo:        1,
+			filter:        filter,
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/commits/main/check-runs", 401, http.Header{}, `{
+					"message": "Bad credentials"
+				}`},
+			},
+			s: &ChecksService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			ref:           "main",
+			pageSize:      10,
+			pageNo:        1,
+			filter:        filter,
+			expectedError: `GET /repos/octocat/Hello-World/commits/main/check-runs: 401 Bad credentials`,
+		},
+		{
+			name: "InvalidResponse",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/commits/main/check-runs", 200, http.Header{}, `{`},
+			},
+			s: &ChecksService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			ref:           "main",
+			pageSize:      10,
+			pageNo:        1,
+			filter:        filter,
+			expectedError: `unexpected EOF`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/commits/main/check-runs", 200, header, checkRunsBody},
+			},
+			s: &ChecksService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:          context.Background(),
+			ref:          "main",
+			pageSize:     10,
+			pageNo:       1,
+			filter:       filter,
+			expectedRuns: []CheckRun{checkRun},
+			expectedResponse: &Response{
+				Pages: expectedPages,
+				Rate:  expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			defer ts.Close()
+
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			runs, resp, err := tc.s.ListRuns(tc.ctx, tc.ref, tc.pageSize, tc.pageNo, tc.filter)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, runs)
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedRuns, runs)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Pages, resp.Pages)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestChecksService_ListRunAnnotations(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	tests := []struct {
+		name                string
+		mockResponses       []MockResponse
+		s                   *ChecksService
+		ctx                 context.Context
+		id                  int
+		pageSize            int
+		pageNo              int
+		expectedAnnotations []CheckAnnotation
+		expectedResponse    *Response
+		expectedError       string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &ChecksService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           nil,
+			id:            4,
+			pageSize:      10,
+			pageNo:        1,
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/check-runs/4/annotations", 401, http.Header{}, `{
+					"message": "Bad credentials"
+				}`},
+			},
+			s: &ChecksService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			id:            4,
+			pageSize:      10,
+			pageNo:        1,
+			expectedError: `GET /repos/octocat/Hello-World/check-runs/4/annotations: 401 Bad credentials`,
+		},
+		{
+			name: "InvalidResponse",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/check-runs/4/annotations", 200, http.Header{}, `{`},
+			},
+			s: &ChecksService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			id:            4,
+			pageSize:      10,
+			pageNo:        1,
+			expectedError: `unexpected EOF`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/check-runs/4/annotations", 200, header, checkAnnotationsBody},
+			},
+			s: &ChecksService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:                 context.Background(),
+			id:                  4,
+			pageSize:            10,
+			pageNo:              1,
+			expectedAnnotations: []CheckAnnotation{checkAnnotation},
+			expectedResponse: &Response{
+				Pages: expectedPages,
+				Rate:  expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			defer ts.Close()
+
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			annotations, resp, err := tc.s.ListRunAnnotations(tc.ctx, tc.id, tc.pageSize, tc.pageNo)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, annotations)
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedAnnotations, annotations)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Pages, resp.Pages)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestChecksService_RerequestRun(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	tests := []struct {
+		name             string
+		mockResponses    []MockResponse
+		s                *ChecksService
+		ctx              context.Context
+		id               int
+		expectedResponse *Response
+		expectedError    string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &ChecksService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           nil,
+			id:            4,
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"POST", "/repos/octocat/Hello-World/check-runs/4/rerequest", 403, http.Header{}, `{
+					"message": "Forbidden"
+				}`},
+			},
+			s: &ChecksService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			id:            4,
+			expectedError: `POST /repos/octocat/Hello-World/check-runs/4/rerequest: 403 Forbidden`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"POST", "/repos/octocat/Hello-World/check-runs/4/rerequest", 201, header, `{}`},
+			},
+			s: &ChecksService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx: context.Background(),
+			id:  4,
+			expectedResponse: &Response{
+				Rate: expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			defer ts.Close()
+
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			resp, err := tc.s.RerequestRun(tc.ctx, tc.id)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestChecksService_GetSuite(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	tests := []struct {
+		name             string
+		mockResponses    []MockResponse
+		s                *ChecksService
+		ctx              context.Context
+		id               int
+		expectedSuite    *CheckSuite
+		expectedResponse *Response
+		expectedError    string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &ChecksService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           nil,
+			id:            5,
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/check-suites/5", 404, http.Header{}, `{
+					"message": "Not Found"
+				}`},
+			},
+			s: &ChecksService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			id:            5,
+			expectedError: `GET /repos/octocat/Hello-World/check-suites/5: 404 Not Found`,
+		},
+		{
+			name: "InvalidResponse",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/check-suites/5", 200, http.Header{}, `{`},
+			},
+			s: &ChecksService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			id:            5,
+			expectedError: `unexpected EOF`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/check-suites/5", 200, header, checkSuiteBody},
+			},
+			s: &ChecksService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			id:            5,
+			expectedSuite: &checkSuite,
+			expectedResponse: &Response{
+				Rate: expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			defer ts.Close()
+
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			suite, resp, err := tc.s.GetSuite(tc.ctx, tc.id)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, suite)
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedSuite, suite)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestChecksService_ListSuites(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	filter := CheckSuitesFilter{
+		CheckName: "mighty_readme",
+		AppID:     1,
+	}
+
+	tests := []struct {
+		name             string
+		mockResponses    []MockResponse
+		s                *ChecksService
+		ctx              context.Context
+		ref              string
+		pageSize         int
+		pageNo           int
+		filter           CheckSuitesFilter
+		expectedSuites   []CheckSuite
+		expectedResponse *Response
+		expectedError    string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &ChecksService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           nil,
+			ref:           "main",
+			pageSize:      10,
+			pageNo:        1,
+			filter:        filter,
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/commits/main/check-suites", 401, http.Header{}, `{
+					"message": "Bad credentials"
+				}`},
+			},
+			s: &ChecksService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			ref:           "main",
+			pageSize:      10,
+			pageNo:        1,
+			filter:        filter,
+			expectedError: `GET /repos/octocat/Hello-World/commits/main/check-suites: 401 Bad credentials`,
+		},
+		{
+			name: "InvalidResponse",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/commits/main/check-suites", 200, http.Header{}, `{`},
+			},
+			s: &ChecksService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			ref:           "main",
+			pageSize:      10,
+			pageNo:        1,
+			filter:        filter,
+			expectedError: `unexpected EOF`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/commits/main/check-suites", 200, header, checkSuitesBody},
+			},
+			s: &ChecksService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:            context.Background(),
+			ref:            "main",
+			pageSize:       10,
+			pageNo:         1,
+			filter:         filter,
+			expectedSuites: []CheckSuite{checkSuite},
+			expectedResponse: &Response{
+				Pages: expectedPages,
+				Rate:  expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			defer ts.Close()
+
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			suites, resp, err := tc.s.ListSuites(tc.ctx, tc.ref, tc.pageSize, tc.pageNo, tc.filter)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, suites)
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedSuites, suites)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Pages, resp.Pages)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestChecksService_RerequestSuite(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	tests := []struct {
+		name             string
+		mockResponses    []MockResponse
+		s                *ChecksService
+		ctx              context.Context
+		id               int
+		expectedResponse *Response
+		expectedError    string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &ChecksService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           nil,
+			id:            5,
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"POST", "/repos/octocat/Hello-World/check-suites/5/rerequest", 403, http.Header{}, `{
+					"message": "Forbidden"
+				}`},
+			},
+			s: &ChecksService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			id:            5,
+			expectedError: `POST /repos/octocat/Hello-World/check-suites/5/rerequest: 403 Forbidden`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"POST", "/repos/octocat/Hello-World/check-suites/5/rerequest", 201, header, `{}`},
+			},
+			s: &ChecksService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx: context.Background(),
+			id:  5,
+			expectedResponse: &Response{
+				Rate: expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			defer ts.Close()
+
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			resp, err := tc.s.RerequestSuite(tc.ctx, tc.id)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
@@ -35,8 +35,9 @@ const (
 	userAgent = "github.com/gardenbed/go-github"
 
 	// See https://docs.github.com/rest/overview/media-types
-	mediaJSON   = "application/json"
-	mediaTypeV3 = "application/vnd.github.v3+json"
+	mediaJSON      = "application/json"
+	mediaTypeV3    = "application/vnd.github.v3+json"
+	mediaTypeV3Raw = "application/vnd.github.v3.raw"
 	// mediaTypeV3SHA   = "application/vnd.github.v3.sha"
 	// mediaTypeV3Diff  = "application/vnd.github.v3.diff"
 	// mediaTypeV3Patch = "application/vnd.github.v3.patch"
@@ -308,6 +309,7 @@ func (c *Client) Do(req *http.Request, body interface{}) (*Response, error) {
 	isSuccess := func(statusCode int) bool {
 		return statusCode == http.StatusOK ||
 			statusCode == http.StatusCreated ||
+			statusCode == http.StatusAccepted ||
 			statusCode == http.StatusNoContent
 	}
 
@@ -349,6 +351,8 @@ func (c *Client) Do(req *http.Request, body interface{}) (*Response, error) {
 				}
 			}
 
+			return nil, respErr
+
 		case http.StatusNotFound:
 			return nil, &NotFoundError{
 				err: respErr,
@@ -403,6 +407,12 @@ func (c *Client) EnsureScopes(ctx context.Context, scopes ...Scope) error {
 
 // Repo returns a service providing GitHub APIs for a specific repository.
 func (c *Client) Repo(owner, repo string) *RepoService {
+	git := &GitService{
+		client: c,
+		owner:  owner,
+		repo:   repo,
+	}
+
 	return &RepoService{
 		client: c,
 		owner:  owner,
@@ -422,5 +432,89 @@ func (c *Client) Repo(owner, repo string) *RepoService {
 			owner:  owner,
 			repo:   repo,
 		},
+		Hooks: &HookService{
+			client:   c,
+			basePath: fmt.Sprintf("/repos/%s/%s", owner, repo),
+		},
+		Rulesets: &RulesetService{
+			client:   c,
+			basePath: fmt.Sprintf("/repos/%s/%s", owner, repo),
+		},
+		Contents: &ContentsService{
+			client: c,
+			owner:  owner,
+			repo:   repo,
+			git:    git,
+		},
+		Git: git,
+		Checks: &ChecksService{
+			client: c,
+			owner:  owner,
+			repo:   repo,
+		},
+		Deployments: &DeploymentService{
+			client: c,
+			owner:  owner,
+			repo:   repo,
+		},
+		Insights: &InsightsService{
+			client: c,
+			owner:  owner,
+			repo:   repo,
+		},
+		Workflows: &WorkflowService{
+			client: c,
+			owner:  owner,
+			repo:   repo,
+		},
+		Secrets: &SecretsService{
+			client:   c,
+			basePath: fmt.Sprintf("/repos/%s/%s/actions", owner, repo),
+		},
+		Variables: &VariablesService{
+			client:   c,
+			basePath: fmt.Sprintf("/repos/%s/%s/actions", owner, repo),
+		},
+		Runners: &RunnersService{
+			client:   c,
+			basePath: fmt.Sprintf("/repos/%s/%s/actions", owner, repo),
+		},
+		Caches: &CachesService{
+			client: c,
+			owner:  owner,
+			repo:   repo,
+		},
+	}
+}
+
+// Org returns a service providing GitHub APIs for a specific organization.
+func (c *Client) Org(org string) *OrgService {
+	return &OrgService{
+		client: c,
+		org:    org,
+		Hooks: &HookService{
+			client:   c,
+			basePath: fmt.Sprintf("/orgs/%s", org),
+		},
+		Rulesets: &RulesetService{
+			client:   c,
+			basePath: fmt.Sprintf("/orgs/%s", org),
+		},
+		Secrets: &SecretsService{
+			client:   c,
+			basePath: fmt.Sprintf("/orgs/%s/actions", org),
+		},
+		Variables: &VariablesService{
+			client:   c,
+			basePath: fmt.Sprintf("/orgs/%s/actions", org),
+		},
+		Runners: &RunnersService{
+			client:   c,
+			basePath: fmt.Sprintf("/orgs/%s/actions", org),
+		},
+		RunnerGroups: &RunnerGroupsService{
+			client:   c,
+			basePath: fmt.Sprintf("/orgs/%s/actions", org),
+		},
 	}
 }
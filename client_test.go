@@ -544,6 +544,23 @@ func TestClient_Do(t *testing.T) {
 			body:          nil,
 			expectedError: `GET /user: 403 You have triggered an abuse detection mechanism`,
 		},
+		{
+			name: "StatusForbidden",
+			mockResponses: []MockResponse{
+				{"DELETE", "/repos/octocat/Hello-World", 403, http.Header{}, `{
+					"message": "Must have admin rights to Repository.",
+					"documentation_url": "https://docs.github.com/rest/repos/repos#delete-a-repository"
+				}`},
+			},
+			c: &Client{
+				httpClient: &http.Client{},
+				rates:      map[rateGroup]Rate{},
+			},
+			reqMethod:     "DELETE",
+			reqURL:        "/repos/octocat/Hello-World",
+			body:          nil,
+			expectedError: `DELETE /repos/octocat/Hello-World: 403 Must have admin rights to Repository.`,
+		},
 		{
 			name: "NotFoundError",
 			mockResponses: []MockResponse{
@@ -655,6 +672,27 @@ func TestClient_Do(t *testing.T) {
 				Rate:  expectedRate,
 			},
 		},
+		{
+			name: "Success_Accepted",
+			mockResponses: []MockResponse{
+				{"POST", "/repos/octocat/Hello-World/forks", 202, header, `{
+						"id": 1296269,
+						"name": "Hello-World",
+						"full_name": "octocat/Hello-World"
+				}`},
+			},
+			c: &Client{
+				httpClient: &http.Client{},
+				rates:      map[rateGroup]Rate{},
+			},
+			reqMethod: "POST",
+			reqURL:    "/repos/octocat/Hello-World/forks",
+			body:      new(map[string]interface{}),
+			expectedResponse: &Response{
+				Pages: expectedPages,
+				Rate:  expectedRate,
+			},
+		},
 	}
 
 	for _, tc := range tests {
@@ -800,6 +838,109 @@ func TestClient_Repo(t *testing.T) {
 			assert.Equal(t, c, repo.Releases.client)
 			assert.Equal(t, tc.owner, repo.Releases.owner)
 			assert.Equal(t, tc.repo, repo.Releases.repo)
+
+			assert.NotNil(t, repo.Hooks)
+			assert.Equal(t, c, repo.Hooks.client)
+			assert.Equal(t, "/repos/octocat/Hello-World", repo.Hooks.basePath)
+
+			assert.NotNil(t, repo.Rulesets)
+			assert.Equal(t, c, repo.Rulesets.client)
+			assert.Equal(t, "/repos/octocat/Hello-World", repo.Rulesets.basePath)
+
+			assert.NotNil(t, repo.Contents)
+			assert.Equal(t, c, repo.Contents.client)
+			assert.Equal(t, tc.owner, repo.Contents.owner)
+			assert.Equal(t, tc.repo, repo.Contents.repo)
+			assert.Equal(t, repo.Git, repo.Contents.git)
+
+			assert.NotNil(t, repo.Git)
+			assert.Equal(t, c, repo.Git.client)
+			assert.Equal(t, tc.owner, repo.Git.owner)
+			assert.Equal(t, tc.repo, repo.Git.repo)
+
+			assert.NotNil(t, repo.Checks)
+			assert.Equal(t, c, repo.Checks.client)
+			assert.Equal(t, tc.owner, repo.Checks.owner)
+			assert.Equal(t, tc.repo, repo.Checks.repo)
+
+			assert.NotNil(t, repo.Deployments)
+			assert.Equal(t, c, repo.Deployments.client)
+			assert.Equal(t, tc.owner, repo.Deployments.owner)
+			assert.Equal(t, tc.repo, repo.Deployments.repo)
+
+			assert.NotNil(t, repo.Insights)
+			assert.Equal(t, c, repo.Insights.client)
+			assert.Equal(t, tc.owner, repo.Insights.owner)
+			assert.Equal(t, tc.repo, repo.Insights.repo)
+
+			assert.NotNil(t, repo.Workflows)
+			assert.Equal(t, c, repo.Workflows.client)
+			assert.Equal(t, tc.owner, repo.Workflows.owner)
+			assert.Equal(t, tc.repo, repo.Workflows.repo)
+
+			assert.NotNil(t, repo.Secrets)
+			assert.Equal(t, c, repo.Secrets.client)
+			assert.Equal(t, "/repos/octocat/Hello-World/actions", repo.Secrets.basePath)
+
+			assert.NotNil(t, repo.Variables)
+			assert.Equal(t, c, repo.Variables.client)
+			assert.Equal(t, "/repos/octocat/Hello-World/actions", repo.Variables.basePath)
+
+			assert.NotNil(t, repo.Runners)
+			assert.Equal(t, c, repo.Runners.client)
+			assert.Equal(t, "/repos/octocat/Hello-World/actions", repo.Runners.basePath)
+
+			assert.NotNil(t, repo.Caches)
+			assert.Equal(t, c, repo.Caches.client)
+			assert.Equal(t, tc.owner, repo.Caches.owner)
+			assert.Equal(t, tc.repo, repo.Caches.repo)
+		})
+	}
+}
+
+func TestClient_Org(t *testing.T) {
+	tests := []struct {
+		name string
+		org  string
+	}{
+		{
+			name: "OK",
+			org:  "octo-org",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			c := &Client{}
+
+			org := c.Org(tc.org)
+
+			assert.NotNil(t, org)
+			assert.Equal(t, c, org.client)
+			assert.Equal(t, tc.org, org.org)
+
+			assert.NotNil(t, org.Hooks)
+			assert.Equal(t, c, org.Hooks.client)
+			assert.Equal(t, "/orgs/octo-org", org.Hooks.basePath)
+
+			assert.NotNil(t, org.Rulesets)
+			assert.Equal(t, c, org.Rulesets.client)
+			assert.Equal(t, "/orgs/octo-org", org.Rulesets.basePath)
+
+			assert.NotNil(t, org.Secrets)
+			assert.Equal(t, c, org.Secrets.client)
+			assert.Equal(t, "/orgs/octo-org/actions", org.Secrets.basePath)
+
+			assert.NotNil(t, org.Variables)
+			assert.Equal(t, c, org.Variables.client)
+			assert.Equal(t, "/orgs/octo-org/actions", org.Variables.basePath)
+
+			assert.NotNil(t, org.Runners)
+			assert.Equal(t, c, org.Runners.client)
+			assert.Equal(t, "/orgs/octo-org/actions", org.Runners.basePath)
+			assert.NotNil(t, org.RunnerGroups)
+			assert.Equal(t, c, org.RunnerGroups.client)
+			assert.Equal(t, "/orgs/octo-org/actions", org.RunnerGroups.basePath)
 		})
 	}
 }
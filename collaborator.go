@@ -0,0 +1,298 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+type (
+	// Collaborator is a GitHub repository collaborator object.
+	Collaborator struct {
+		User
+		Permissions RepositoryPermissions `json:"permissions"`
+		RoleName    string                `json:"role_name"`
+	}
+
+	// Invitation is a GitHub repository invitation object.
+	Invitation struct {
+		ID          int        `json:"id"`
+		Repository  Repository `json:"repository"`
+		Invitee     User       `json:"invitee"`
+		Inviter     User       `json:"inviter"`
+		Permissions Permission `json:"permissions"`
+		Expired     bool       `json:"expired"`
+		URL         string     `json:"url"`
+		HTMLURL     string     `json:"html_url"`
+		CreatedAt   time.Time  `json:"created_at"`
+	}
+)
+
+// CollaboratorsFilter are used for fetching Collaborators.
+type CollaboratorsFilter struct {
+	Affiliation string // Either outside, direct, or all
+	Permission  Permission
+}
+
+// legacyPermission returns the permission name expected by the collaborator and team APIs.
+// These APIs use pull and push instead of read and write respectively.
+func legacyPermission(p Permission) string {
+	switch p {
+	case PermissionRead:
+		return "pull"
+	case PermissionWrite:
+		return "push"
+	default:
+		return string(p)
+	}
+}
+
+// Collaborators retrieves all collaborators of the repository page by page.
+// See https://docs.github.com/en/rest/collaborators/collaborators#list-repository-collaborators
+func (s *RepoService) Collaborators(ctx context.Context, pageSize, pageNo int, filter CollaboratorsFilter) ([]Collaborator, *Response, error) {
+	url := fmt.Sprintf("/repos/%s/%s/collaborators", s.owner, s.repo)
+	req, err := s.client.NewPageRequest(ctx, "GET", url, pageSize, pageNo, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	q := req.URL.Query()
+	if filter.Affiliation != "" {
+		q.Add("affiliation", filter.Affiliation)
+	}
+	if filter.Permission != "" {
+		q.Add("permission", legacyPermission(filter.Permission))
+	}
+	req.URL.RawQuery = q.Encode()
+
+	collaborators := []Collaborator{}
+
+	resp, err := s.client.Do(req, &collaborators)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return collaborators, resp, nil
+}
+
+// AddCollaborator adds a user as a collaborator of the repository.
+// If the user is not already a collaborator, an invitation is created and returned.
+// If the user is already a collaborator, the permission is updated and no invitation is returned.
+// See https://docs.github.com/en/rest/collaborators/collaborators#add-a-repository-collaborator
+func (s *RepoService) AddCollaborator(ctx context.Context, username string, permission Permission) (*Invitation, *Response, error) {
+	url := fmt.Sprintf("/repos/%s/%s/collaborators/%s", s.owner, s.repo, username)
+	body := struct {
+		Permission string `json:"permission,omitempty"`
+	}{
+		Permission: legacyPermission(permission),
+	}
+
+	req, err := s.client.NewRequest(ctx, "PUT", url, body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	invitation := new(Invitation)
+
+	resp, err := s.client.Do(req, invitation)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if resp.StatusCode == http.StatusNoContent {
+		return nil, resp, nil
+	}
+
+	return invitation, resp, nil
+}
+
+// RemoveCollaborator removes a collaborator from the repository.
+// See https://docs.github.com/en/rest/collaborators/collaborators#remove-a-repository-collaborator
+func (s *RepoService) RemoveCollaborator(ctx context.Context, username string) (*Response, error) {
+	url := fmt.Sprintf("/repos/%s/%s/collaborators/%s", s.owner, s.repo, username)
+	req, err := s.client.NewRequest(ctx, "DELETE", url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// Invitations retrieves all pending invitations of the repository page by page.
+// See https://docs.github.com/en/rest/collaborators/invitations#list-repository-invitations
+func (s *RepoService) Invitations(ctx context.Context, pageSize, pageNo int) ([]Invitation, *Response, error) {
+	url := fmt.Sprintf("/repos/%s/%s/invitations", s.owner, s.repo)
+	req, err := s.client.NewPageRequest(ctx, "GET", url, pageSize, pageNo, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	invitations := []Invitation{}
+
+	resp, err := s.client.Do(req, &invitations)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return invitations, resp, nil
+}
+
+// UpdateInvitation updates the permission of a pending invitation of the repository.
+// See https://docs.github.com/en/rest/collaborators/invitations#update-a-repository-invitation
+func (s *RepoService) UpdateInvitation(ctx context.Context, id int, permission Permission) (*Invitation, *Response, error) {
+	url := fmt.Sprintf("/repos/%s/%s/invitations/%d", s.owner, s.repo, id)
+	body := struct {
+		Permissions Permission `json:"permissions"`
+	}{
+		Permissions: permission,
+	}
+
+	req, err := s.client.NewRequest(ctx, "PATCH", url, body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	invitation := new(Invitation)
+
+	resp, err := s.client.Do(req, invitation)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return invitation, resp, nil
+}
+
+// DeleteInvitation deletes a pending invitation of the repository.
+// See https://docs.github.com/en/rest/collaborators/invitations#delete-a-repository-invitation
+func (s *RepoService) DeleteInvitation(ctx context.Context, id int) (*Response, error) {
+	url := fmt.Sprintf("/repos/%s/%s/invitations/%d", s.owner, s.repo, id)
+	req, err := s.client.NewRequest(ctx, "DELETE", url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// Teams retrieves all teams with access to the repository page by page.
+// See https://docs.github.com/en/rest/repos/repos#list-repository-teams
+func (s *RepoService) Teams(ctx context.Context, pageSize, pageNo int) ([]Team, *Response, error) {
+	url := fmt.Sprintf("/repos/%s/%s/teams", s.owner, s.repo)
+	req, err := s.client.NewPageRequest(ctx, "GET", url, pageSize, pageNo, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	teams := []Team{}
+
+	resp, err := s.client.Do(req, &teams)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return teams, resp, nil
+}
+
+// SetTeamPermission adds or updates the permission of a team on the repository.
+// The team should belong to the organization owning the repository.
+// See https://docs.github.com/en/rest/teams/teams#add-or-update-team-repository-permissions
+func (s *RepoService) SetTeamPermission(ctx context.Context, teamSlug string, permission Permission) (*Response, error) {
+	url := fmt.Sprintf("/orgs/%s/teams/%s/repos/%s/%s", s.owner, teamSlug, s.owner, s.repo)
+	body := struct {
+		Permission string `json:"permission"`
+	}{
+		Permission: legacyPermission(permission),
+	}
+
+	req, err := s.client.NewRequest(ctx, "PUT", url, body)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// RemoveTeam removes the access of a team to the repository.
+// See https://docs.github.com/en/rest/teams/teams#remove-a-repository-from-a-team
+func (s *RepoService) RemoveTeam(ctx context.Context, teamSlug string) (*Response, error) {
+	url := fmt.Sprintf("/orgs/%s/teams/%s/repos/%s/%s", s.owner, teamSlug, s.owner, s.repo)
+	req, err := s.client.NewRequest(ctx, "DELETE", url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// Invitations retrieves all pending repository invitations for the authenticated user page by page.
+// See https://docs.github.com/en/rest/collaborators/invitations#list-repository-invitations-for-the-authenticated-user
+func (s *UserService) Invitations(ctx context.Context, pageSize, pageNo int) ([]Invitation, *Response, error) {
+	req, err := s.client.NewPageRequest(ctx, "GET", "/user/repository_invitations", pageSize, pageNo, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	invitations := []Invitation{}
+
+	resp, err := s.client.Do(req, &invitations)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return invitations, resp, nil
+}
+
+// AcceptInvitation accepts a repository invitation for the authenticated user.
+// See https://docs.github.com/en/rest/collaborators/invitations#accept-a-repository-invitation
+func (s *UserService) AcceptInvitation(ctx context.Context, id int) (*Response, error) {
+	url := fmt.Sprintf("/user/repository_invitations/%d", id)
+	req, err := s.client.NewRequest(ctx, "PATCH", url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// DeclineInvitation declines a repository invitation for the authenticated user.
+// See https://docs.github.com/en/rest/collaborators/invitations#decline-a-repository-invitation
+func (s *UserService) DeclineInvitation(ctx context.Context, id int) (*Response, error) {
+	url := fmt.Sprintf("/user/repository_invitations/%d", id)
+	req, err := s.client.NewRequest(ctx, "DELETE", url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,1340 @@
+package github
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	collaboratorsBody = `[
+		{
+			"login": "octocat",
+			"id": 1,
+			"type": "User",
+			"url": "https://api.github.com/users/octocat",
+			"html_url": "https://github.com/octocat",
+			"permissions": {
+				"admin": false,
+				"maintain": false,
+				"push": true,
+				"triage": true,
+				"pull": true
+			},
+			"role_name": "write"
+		}
+	]`
+
+	invitationBody = `{
+		"id": 1,
+		"repository": {
+			"id": 1296269,
+			"name": "Hello-World",
+			"full_name": "octocat/Hello-World"
+		},
+		"invitee": {
+			"login": "octodog",
+			"id": 2,
+			"type": "User"
+		},
+		"inviter": {
+			"login": "octocat",
+			"id": 1,
+			"type": "User"
+		},
+		"permissions": "write",
+		"expired": false,
+		"url": "https://api.github.com/user/repository_invitations/1",
+		"html_url": "https://github.com/octocat/Hello-World/invitations",
+		"created_at": "2020-10-20T19:59:59Z"
+	}`
+
+	invitationsBody = `[
+		{
+			"id": 1,
+			"repository": {
+				"id": 1296269,
+				"name": "Hello-World",
+				"full_name": "octocat/Hello-World"
+			},
+			"invitee": {
+				"login": "octodog",
+				"id": 2,
+				"type": "User"
+			},
+			"inviter": {
+				"login": "octocat",
+				"id": 1,
+				"type": "User"
+			},
+			"permissions": "write",
+			"expired": false,
+			"url": "https://api.github.com/user/repository_invitations/1",
+			"html_url": "https://github.com/octocat/Hello-World/invitations",
+			"created_at": "2020-10-20T19:59:59Z"
+		}
+	]`
+
+	teamsBody = `[
+		{
+			"id": 1,
+			"name": "Justice League",
+			"slug": "justice-league",
+			"description": "A great team.",
+			"privacy": "closed",
+			"permission": "push",
+			"url": "https://api.github.com/teams/1",
+			"html_url": "https://github.com/orgs/octocat/teams/justice-league"
+		}
+	]`
+)
+
+var (
+	collaborator = Collaborator{
+		User: User{
+			ID:      1,
+			Login:   "octocat",
+			Type:    "User",
+			URL:     "https://api.github.com/users/octocat",
+			HTMLURL: "https://github.com/octocat",
+		},
+		Permissions: RepositoryPermissions{
+			Push:   true,
+			Triage: true,
+			Pull:   true,
+		},
+		RoleName: "write",
+	}
+
+	invitation = Invitation{
+		ID: 1,
+		Repository: Repository{
+			ID:       1296269,
+			Name:     "Hello-World",
+			FullName: "octocat/Hello-World",
+		},
+		Invitee: User{
+			ID:    2,
+			Login: "octodog",
+			Type:  "User",
+		},
+		Inviter: User{
+			ID:    1,
+			Login: "octocat",
+			Type:  "User",
+		},
+		Permissions: PermissionWrite,
+		Expired:     false,
+		URL:         "https://api.github.com/user/repository_invitations/1",
+		HTMLURL:     "https://github.com/octocat/Hello-World/invitations",
+		CreatedAt:   parseGitHubTime("2020-10-20T19:59:59Z"),
+	}
+
+	team = Team{
+		ID:          1,
+		Name:        "Justice League",
+		Slug:        "justice-league",
+		Description: "A great team.",
+		Privacy:     "closed",
+		Permission:  "push",
+		URL:         "https://api.github.com/teams/1",
+		HTMLURL:     "https://github.com/orgs/octocat/teams/justice-league",
+	}
+)
+
+func TestLegacyPermission(t *testing.T) {
+	tests := []struct {
+		name       string
+		permission Permission
+		expected   string
+	}{
+		{"Empty", "", ""},
+		{"Read", PermissionRead, "pull"},
+		{"Triage", PermissionTriage, "triage"},
+		{"Write", PermissionWrite, "push"},
+		{"Maintain", PermissionMaintain, "maintain"},
+		{"Admin", PermissionAdmin, "admin"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, legacyPermission(tc.permission))
+		})
+	}
+}
+
+func TestRepoService_Collaborators(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	tests := []struct {
+		name                  string
+		mockResponses         []MockResponse
+		s                     *RepoService
+		ctx                   context.Context
+		pageSize              int
+		pageNo                int
+		filter                CollaboratorsFilter
+		expectedCollaborators []Collaborator
+		expectedResponse      *Response
+		expectedError         string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:      nil,
+			pageSize: 10,
+			pageNo:   1,
+			filter: CollaboratorsFilter{
+				Affiliation: "direct",
+				Permission:  PermissionWrite,
+			},
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/collaborators", 401, http.Header{}, `{
+					"message": "Bad credentials"
+				}`},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:      context.Background(),
+			pageSize: 10,
+			pageNo:   1,
+			filter: CollaboratorsFilter{
+				Affiliation: "direct",
+				Permission:  PermissionWrite,
+			},
+			expectedError: `GET /repos/octocat/Hello-World/collaborators: 401 Bad credentials`,
+		},
+		{
+			name: "InvalidResponse",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/collaborators", 200, http.Header{}, `{`},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:      context.Background(),
+			pageSize: 10,
+			pageNo:   1,
+			filter: CollaboratorsFilter{
+				Affiliation: "direct",
+				Permission:  PermissionWrite,
+			},
+			expectedError: `unexpected EOF`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/collaborators", 200, header, collaboratorsBody},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:      context.Background(),
+			pageSize: 10,
+			pageNo:   1,
+			filter: CollaboratorsFilter{
+				Affiliation: "direct",
+				Permission:  PermissionWrite,
+			},
+			expectedCollaborators: []Collaborator{collaborator},
+			expectedResponse: &Response{
+				Pages: expectedPages,
+				Rate:  expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			defer ts.Close()
+
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			collaborators, resp, err := tc.s.Collaborators(tc.ctx, tc.pageSize, tc.pageNo, tc.filter)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, collaborators)
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedCollaborators, collaborators)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Pages, resp.Pages)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestRepoService_AddCollaborator(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	tests := []struct {
+		name               string
+		mockResponses      []MockResponse
+		s                  *RepoService
+		ctx                context.Context
+		username           string
+		permission         Permission
+		expectedInvitation *Invitation
+		expectedResponse   *Response
+		expectedError      string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           nil,
+			username:      "octodog",
+			permission:    PermissionWrite,
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"PUT", "/repos/octocat/Hello-World/collaborators/octodog", 401, http.Header{}, `{
+					"message": "Bad credentials"
+				}`},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			username:      "octodog",
+			permission:    PermissionWrite,
+			expectedError: `PUT /repos/octocat/Hello-World/collaborators/octodog: 401 Bad credentials`,
+		},
+		{
+			name: "InvalidResponse",
+			mockResponses: []MockResponse{
+				{"PUT", "/repos/octocat/Hello-World/collaborators/octodog", 201, http.Header{}, `{`},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			username:      "octodog",
+			permission:    PermissionWrite,
+			expectedError: `unexpected EOF`,
+		},
+		{
+			name: "ExistingCollaborator",
+			mockResponses: []MockResponse{
+				{"PUT", "/repos/octocat/Hello-World/collaborators/octodog", 204, header, ``},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:                context.Background(),
+			username:           "octodog",
+			permission:         PermissionWrite,
+			expectedInvitation: nil,
+			expectedResponse: &Response{
+				Rate: expectedRate,
+			},
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"PUT", "/repos/octocat/Hello-World/collaborators/octodog", 201, header, invitationBody},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:                context.Background(),
+			username:           "octodog",
+			permission:         PermissionWrite,
+			expectedInvitation: &invitation,
+			expectedResponse: &Response{
+				Rate: expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			defer ts.Close()
+
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			invitation, resp, err := tc.s.AddCollaborator(tc.ctx, tc.username, tc.permission)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, invitation)
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedInvitation, invitation)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestRepoService_RemoveCollaborator(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	tests := []struct {
+		name             string
+		mockResponses    []MockResponse
+		s                *RepoService
+		ctx              context.Context
+		username         string
+		expectedResponse *Response
+		expectedError    string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           nil,
+			username:      "octodog",
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"DELETE", "/repos/octocat/Hello-World/collaborators/octodog", 401, http.Header{}, `{
+					"message": "Bad credentials"
+				}`},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			username:      "octodog",
+			expectedError: `DELETE /repos/octocat/Hello-World/collaborators/octodog: 401 Bad credentials`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"DELETE", "/repos/octocat/Hello-World/collaborators/octodog", 204, header, ``},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:      context.Background(),
+			username: "octodog",
+			expectedResponse: &Response{
+				Rate: expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			defer ts.Close()
+
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			resp, err := tc.s.RemoveCollaborator(tc.ctx, tc.username)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestRepoService_Invitations(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	tests := []struct {
+		name                string
+		mockResponses       []MockResponse
+		s                   *RepoService
+		ctx                 context.Context
+		pageSize            int
+		pageNo              int
+		expectedInvitations []Invitation
+		expectedResponse    *Response
+		expectedError       string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           nil,
+			pageSize:      10,
+			pageNo:        1,
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/invitations", 401, http.Header{}, `{
+					"message": "Bad credentials"
+				}`},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			pageSize:      10,
+			pageNo:        1,
+			expectedError: `GET /repos/octocat/Hello-World/invitations: 401 Bad credentials`,
+		},
+		{
+			name: "InvalidResponse",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/invitations", 200, http.Header{}, `{`},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			pageSize:      10,
+			pageNo:        1,
+			expectedError: `unexpected EOF`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/invitations", 200, header, invitationsBody},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:                 context.Background(),
+			pageSize:            10,
+			pageNo:              1,
+			expectedInvitations: []Invitation{invitation},
+			expectedResponse: &Response{
+				Pages: expectedPages,
+				Rate:  expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			defer ts.Close()
+
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			invitations, resp, err := tc.s.Invitations(tc.ctx, tc.pageSize, tc.pageNo)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, invitations)
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedInvitations, invitations)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Pages, resp.Pages)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestRepoService_UpdateInvitation(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	tests := []struct {
+		name               string
+		mockResponses      []MockResponse
+		s                  *RepoService
+		ctx                context.Context
+		id                 int
+		permission         Permission
+		expectedInvitation *Invitation
+		expectedResponse   *Response
+		expectedError      string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           nil,
+			id:            1,
+			permission:    PermissionWrite,
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"PATCH", "/repos/octocat/Hello-World/invitations/1", 401, http.Header{}, `{
+					"message": "Bad credentials"
+				}`},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			id:            1,
+			permission:    PermissionWrite,
+			expectedError: `PATCH /repos/octocat/Hello-World/invitations/1: 401 Bad credentials`,
+		},
+		{
+			name: "InvalidResponse",
+			mockResponses: []MockResponse{
+				{"PATCH", "/repos/octocat/Hello-World/invitations/1", 200, http.Header{}, `{`},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			id:            1,
+			permission:    PermissionWrite,
+			expectedError: `unexpected EOF`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"PATCH", "/repos/octocat/Hello-World/invitations/1", 200, header, invitationBody},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:                context.Background(),
+			id:                 1,
+			permission:         PermissionWrite,
+			expectedInvitation: &invitation,
+			expectedResponse: &Response{
+				Rate: expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			defer ts.Close()
+
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			invitation, resp, err := tc.s.UpdateInvitation(tc.ctx, tc.id, tc.permission)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, invitation)
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedInvitation, invitation)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestRepoService_DeleteInvitation(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	tests := []struct {
+		name             string
+		mockResponses    []MockResponse
+		s                *RepoService
+		ctx              context.Context
+		id               int
+		expectedResponse *Response
+		expectedError    string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           nil,
+			id:            1,
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"DELETE", "/repos/octocat/Hello-World/invitations/1", 401, http.Header{}, `{
+					"message": "Bad credentials"
+				}`},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			id:            1,
+			expectedError: `DELETE /repos/octocat/Hello-World/invitations/1: 401 Bad credentials`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"DELETE", "/repos/octocat/Hello-World/invitations/1", 204, header, ``},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx: context.Background(),
+			id:  1,
+			expectedResponse: &Response{
+				Rate: expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			defer ts.Close()
+
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			resp, err := tc.s.DeleteInvitation(tc.ctx, tc.id)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestRepoService_Teams(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	tests := []struct {
+		name             string
+		mockResponses    []MockResponse
+		s                *RepoService
+		ctx              context.Context
+		pageSize         int
+		pageNo           int
+		expectedTeams    []Team
+		expectedResponse *Response
+		expectedError    string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           nil,
+			pageSize:      10,
+			pageNo:        1,
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/teams", 401, http.Header{}, `{
+					"message": "Bad credentials"
+				}`},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			pageSize:      10,
+			pageNo:        1,
+			expectedError: `GET /repos/octocat/Hello-World/teams: 401 Bad credentials`,
+		},
+		{
+			name: "InvalidResponse",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/teams", 200, http.Header{}, `{`},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			pageSize:      10,
+			pageNo:        1,
+			expectedError: `unexpected EOF`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/teams", 200, header, teamsBody},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			pageSize:      10,
+			pageNo:        1,
+			expectedTeams: []Team{team},
+			expectedResponse: &Response{
+				Pages: expectedPages,
+				Rate:  expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			defer ts.Close()
+
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			teams, resp, err := tc.s.Teams(tc.ctx, tc.pageSize, tc.pageNo)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, teams)
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedTeams, teams)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Pages, resp.Pages)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestRepoService_SetTeamPermission(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	tests := []struct {
+		name             string
+		mockResponses    []MockResponse
+		s                *RepoService
+		ctx              context.Context
+		teamSlug         string
+		permission       Permission
+		expectedResponse *Response
+		expectedError    string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           nil,
+			teamSlug:      "justice-league",
+			permission:    PermissionWrite,
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"PUT", "/orgs/octocat/teams/justice-league/repos/octocat/Hello-World", 401, http.Header{}, `{
+					"message": "Bad credentials"
+				}`},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			teamSlug:      "justice-league",
+			permission:    PermissionWrite,
+			expectedError: `PUT /orgs/octocat/teams/justice-league/repos/octocat/Hello-World: 401 Bad credentials`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"PUT", "/orgs/octocat/teams/justice-league/repos/octocat/Hello-World", 204, header, ``},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:        context.Background(),
+			teamSlug:   "justice-league",
+			permission: PermissionWrite,
+			expectedResponse: &Response{
+				Rate: expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			defer ts.Close()
+
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			resp, err := tc.s.SetTeamPermission(tc.ctx, tc.teamSlug, tc.permission)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestRepoService_RemoveTeam(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	tests := []struct {
+		name             string
+		mockResponses    []MockResponse
+		s                *RepoService
+		ctx              context.Context
+		teamSlug         string
+		expectedResponse *Response
+		expectedError    string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           nil,
+			teamSlug:      "justice-league",
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"DELETE", "/orgs/octocat/teams/justice-league/repos/octocat/Hello-World", 401, http.Header{}, `{
+					"message": "Bad credentials"
+				}`},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			teamSlug:      "justice-league",
+			expectedError: `DELETE /orgs/octocat/teams/justice-league/repos/octocat/Hello-World: 401 Bad credentials`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"DELETE", "/orgs/octocat/teams/justice-league/repos/octocat/Hello-World", 204, header, ``},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:      context.Background(),
+			teamSlug: "justice-league",
+			expectedResponse: &Response{
+				Rate: expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			defer ts.Close()
+
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			resp, err := tc.s.RemoveTeam(tc.ctx, tc.teamSlug)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestUserService_Invitations(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	tests := []struct {
+		name                string
+		mockResponses       []MockResponse
+		s                   *UserService
+		ctx                 context.Context
+		pageSize            int
+		pageNo              int
+		expectedInvitations []Invitation
+		expectedResponse    *Response
+		expectedError       string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &UserService{
+				client: c,
+			},
+			ctx:           nil,
+			pageSize:      10,
+			pageNo:        1,
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"GET", "/user/repository_invitations", 401, http.Header{}, `{
+					"message": "Bad credentials"
+				}`},
+			},
+			s: &UserService{
+				client: c,
+			},
+			ctx:           context.Background(),
+			pageSize:      10,
+			pageNo:        1,
+			expectedError: `GET /user/repository_invitations: 401 Bad credentials`,
+		},
+		{
+			name: "InvalidResponse",
+			mockResponses: []MockResponse{
+				{"GET", "/user/repository_invitations", 200, http.Header{}, `{`},
+			},
+			s: &UserService{
+				client: c,
+			},
+			ctx:           context.Background(),
+			pageSize:      10,
+			pageNo:        1,
+			expectedError: `unexpected EOF`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"GET", "/user/repository_invitations", 200, header, invitationsBody},
+			},
+			s: &UserService{
+				client: c,
+			},
+			ctx:                 context.Background(),
+			pageSize:            10,
+			pageNo:              1,
+			expectedInvitations: []Invitation{invitation},
+			expectedResponse: &Response{
+				Pages: expectedPages,
+				Rate:  expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			defer ts.Close()
+
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			invitations, resp, err := tc.s.Invitations(tc.ctx, tc.pageSize, tc.pageNo)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, invitations)
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedInvitations, invitations)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Pages, resp.Pages)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestUserService_AcceptInvitation(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	tests := []struct {
+		name             string
+		mockResponses    []MockResponse
+		s                *UserService
+		ctx              context.Context
+		id               int
+		expectedResponse *Response
+		expectedError    string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &UserService{
+				client: c,
+			},
+			ctx:           nil,
+			id:            1,
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"PATCH", "/user/repository_invitations/1", 401, http.Header{}, `{
+					"message": "Bad credentials"
+				}`},
+			},
+			s: &UserService{
+				client: c,
+			},
+			ctx:           context.Background(),
+			id:            1,
+			expectedError: `PATCH /user/repository_invitations/1: 401 Bad credentials`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"PATCH", "/user/repository_invitations/1", 204, header, ``},
+			},
+			s: &UserService{
+				client: c,
+			},
+			ctx: context.Background(),
+			id:  1,
+			expectedResponse: &Response{
+				Rate: expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			defer ts.Close()
+
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			resp, err := tc.s.AcceptInvitation(tc.ctx, tc.id)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestUserService_DeclineInvitation(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	tests := []struct {
+		name             string
+		mockResponses    []MockResponse
+		s                *UserService
+		ctx              context.Context
+		id               int
+		expectedResponse *Response
+		expectedError    string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &UserService{
+				client: c,
+			},
+			ctx:           nil,
+			id:            1,
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"DELETE", "/user/repository_invitations/1", 401, http.Header{}, `{
+					"message": "Bad credentials"
+				}`},
+			},
+			s: &UserService{
+				client: c,
+			},
+			ctx:           context.Background(),
+			id:            1,
+			expectedError: `DELETE /user/repository_invitations/1: 401 Bad credentials`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"DELETE", "/user/repository_invitations/1", 204, header, ``},
+			},
+			s: &UserService{
+				client: c,
+			},
+			ctx: context.Background(),
+			id:  1,
+			expectedResponse: &Response{
+				Rate: expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			defer ts.Close()
+
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			resp, err := tc.s.DeclineInvitation(tc.ctx, tc.id)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
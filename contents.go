@@ -0,0 +1,367 @@
+package github
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+)
+
+// ContentsService provides GitHub APIs for the contents of a repository.
+// See https://docs.github.com/en/rest/repos/contents
+type ContentsService struct {
+	client      *Client
+	owner, repo string
+	git         *GitService
+}
+
+// ContentType is the type of a GitHub repository content.
+type ContentType string
+
+const (
+	// ContentTypeFile is a regular file.
+	ContentTypeFile ContentType = "file"
+	// ContentTypeDir is a directory.
+	ContentTypeDir ContentType = "dir"
+	// ContentTypeSymlink is a symbolic link.
+	ContentTypeSymlink ContentType = "symlink"
+	// ContentTypeSubmodule is a Git submodule.
+	ContentTypeSubmodule ContentType = "submodule"
+)
+
+type (
+	// Content is a GitHub repository content object.
+	Content struct {
+		Type            ContentType `json:"type"`
+		Name            string      `json:"name"`
+		Path            string      `json:"path"`
+		SHA             string      `json:"sha"`
+		Size            int         `json:"size"`
+		Encoding        string      `json:"encoding"`
+		Content         string      `json:"content"`
+		Target          string      `json:"target"`
+		SubmoduleGitURL string      `json:"submodule_git_url"`
+		URL             string      `json:"url"`
+		GitURL          string      `json:"git_url"`
+		HTMLURL         string      `json:"html_url"`
+		DownloadURL     string      `json:"download_url"`
+
+		// Data is the decoded content of a file.
+		Data []byte `json:"-"`
+	}
+
+	// FileParams is used for creating or updating a file in a repository.
+	// SHA is the blob SHA of the file being replaced and it is required for updating a file.
+	// If Author or Committer is nil, the authenticated user will be used.
+	FileParams struct {
+		Message   string
+		Content   []byte
+		SHA       string
+		Branch    string
+		Author    *Signature
+		Committer *Signature
+	}
+
+	// DeleteFileParams is used for deleting a file in a repository.
+	// SHA is the blob SHA of the file being deleted.
+	DeleteFileParams struct {
+		Message   string
+		SHA       string
+		Branch    string
+		Author    *Signature
+		Committer *Signature
+	}
+
+	// FileCommit is the result of creating, updating, or deleting a file in a repository.
+	// Content is nil when a file is deleted.
+	FileCommit struct {
+		Content *Content  `json:"content"`
+		Commit  RawCommit `json:"commit"`
+	}
+)
+
+// identity is the author or committer of a commit when making a change.
+type identity struct {
+	Name  string `json:"name"`
+	Email string `json:"email"`
+	Date  string `json:"date,omitempty"`
+}
+
+func newIdentity(s *Signature) *identity {
+	if s == nil {
+		return nil
+	}
+
+	id := &identity{
+		Name:  s.Name,
+		Email: s.Email,
+	}
+
+	if !s.Time.IsZero() {
+		id.Date = s.Time.Format(time.RFC3339)
+	}
+
+	return id
+}
+
+// decodeContent decodes a content returned by GitHub API v3 based on its encoding.
+func decodeContent(encoding, content string) ([]byte, error) {
+	switch encoding {
+	case "base64":
+		// GitHub wraps base64-encoded contents with newlines.
+		data, err := base64.StdEncoding.DecodeString(strings.ReplaceAll(content, "\n", ""))
+		if err != nil {
+			return nil, err
+		}
+		return data, nil
+	case "", "utf-8":
+		return []byte(content), nil
+	default:
+		return nil, fmt.Errorf("unsupported content encoding: %s", encoding)
+	}
+}
+
+func (s *ContentsService) get(ctx context.Context, path, ref string) (json.RawMessage, *Response, error) {
+	url := fmt.Sprintf("/repos/%s/%s/contents/%s", s.owner, s.repo, escapePath(path))
+	req, err := s.client.NewRequest(ctx, "GET", url, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	q := req.URL.Query()
+	if ref != "" {
+		q.Add("ref", ref)
+	}
+	req.URL.RawQuery = q.Encode()
+
+	raw := json.RawMessage{}
+
+	resp, err := s.client.Do(req, &raw)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return raw, resp, nil
+}
+
+// decode populates the data of a file content either from the content itself or by fetching it separately.
+// Files larger than 1 MB are returned without their content, so they are downloaded using the raw media type.
+// If that fails too, the Git blob API is used as the last resort.
+func (s *ContentsService) decode(ctx context.Context, content *Content, ref string) error {
+	if content.Type != ContentTypeFile {
+		return nil
+	}
+
+	if content.Encoding != "none" {
+		data, err := decodeContent(content.Encoding, content.Content)
+		if err != nil {
+			return err
+		}
+		content.Data = data
+		return nil
+	}
+
+	buf := new(bytes.Buffer)
+	if _, err := s.Download(ctx, content.Path, ref, buf); err == nil {
+		content.Data = buf.Bytes()
+		return nil
+	}
+
+	blob, _, err := s.git.Blob(ctx, content.SHA)
+	if err != nil {
+		return err
+	}
+	content.Data = blob.Data
+
+	return nil
+}
+
+// Get retrieves a file, a symlink, or a submodule in the repository at a reference.
+// If ref is empty, the default branch of the repository will be used.
+// The content of a file is decoded and returned in the Data field.
+// See https://docs.github.com/en/rest/repos/contents#get-repository-content
+func (s *ContentsService) Get(ctx context.Context, path, ref string) (*Content, *Response, error) {
+	raw, resp, err := s.get(ctx, path, ref)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if bytes.HasPrefix(bytes.TrimSpace(raw), []byte("[")) {
+		return nil, nil, fmt.Errorf("%s is a directory", path)
+	}
+
+	content := new(Content)
+	if err := json.Unmarshal(raw, content); err != nil {
+		return nil, nil, err
+	}
+
+	if err := s.decode(ctx, content, ref); err != nil {
+		return nil, nil, err
+	}
+
+	return content, resp, nil
+}
+
+// List retrieves the contents of a directory in the repository at a reference.
+// If ref is empty, the default branch of the repository will be used.
+// See https://docs.github.com/en/rest/repos/contents#get-repository-content
+func (s *ContentsService) List(ctx context.Context, path, ref string) ([]Content, *Response, error) {
+	raw, resp, err := s.get(ctx, path, ref)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if !bytes.HasPrefix(bytes.TrimSpace(raw), []byte("[")) {
+		return nil, nil, fmt.Errorf("%s is not a directory", path)
+	}
+
+	contents := []Content{}
+	if err := json.Unmarshal(raw, &contents); err != nil {
+		return nil, nil, err
+	}
+
+	return contents, resp, nil
+}
+
+// Readme retrieves the preferred README file of the repository at a reference.
+// If ref is empty, the default branch of the repository will be used.
+// See https://docs.github.com/en/rest/repos/contents#get-a-repository-readme
+func (s *ContentsService) Readme(ctx context.Context, ref string) (*Content, *Response, error) {
+	url := fmt.Sprintf("/repos/%s/%s/readme", s.owner, s.repo)
+	req, err := s.client.NewRequest(ctx, "GET", url, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	q := req.URL.Query()
+	if ref != "" {
+		q.Add("ref", ref)
+	}
+	req.URL.RawQuery = q.Encode()
+
+	content := new(Content)
+
+	resp, err := s.client.Do(req, content)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if err := s.decode(ctx, content, ref); err != nil {
+		return nil, nil, err
+	}
+
+	return content, resp, nil
+}
+
+// Download streams the raw content of a file in the repository at a reference.
+// Files up to 100 MB are supported.
+// See https://docs.github.com/en/rest/repos/contents#get-repository-content
+func (s *ContentsService) Download(ctx context.Context, path, ref string, w io.Writer) (*Response, error) {
+	url := fmt.Sprintf("/repos/%s/%s/contents/%s", s.owner, s.repo, escapePath(path))
+	req, err := s.client.NewRequest(ctx, "GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set(headerAccept, mediaTypeV3Raw)
+
+	q := req.URL.Query()
+	if ref != "" {
+		q.Add("ref", ref)
+	}
+	req.URL.RawQuery = q.Encode()
+
+	resp, err := s.client.Do(req, w)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func (s *ContentsService) put(ctx context.Context, path string, params FileParams) (*FileCommit, *Response, error) {
+	url := fmt.Sprintf("/repos/%s/%s/contents/%s", s.owner, s.repo, escapePath(path))
+	body := struct {
+		Message   string    `json:"message"`
+		Content   string    `json:"content"`
+		SHA       string    `json:"sha,omitempty"`
+		Branch    string    `json:"branch,omitempty"`
+		Author    *identity `json:"author,omitempty"`
+		Committer *identity `json:"committer,omitempty"`
+	}{
+		Message:   params.Message,
+		Content:   base64.StdEncoding.EncodeToString(params.Content),
+		SHA:       params.SHA,
+		Branch:    params.Branch,
+		Author:    newIdentity(params.Author),
+		Committer: newIdentity(params.Committer),
+	}
+
+	req, err := s.client.NewRequest(ctx, "PUT", url, body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	fileCommit := new(FileCommit)
+
+	resp, err := s.client.Do(req, fileCommit)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return fileCommit, resp, nil
+}
+
+// Create creates a new file in the repository.
+// See https://docs.github.com/en/rest/repos/contents#create-or-update-file-contents
+func (s *ContentsService) Create(ctx context.Context, path string, params FileParams) (*FileCommit, *Response, error) {
+	return s.put(ctx, path, params)
+}
+
+// Update updates an existing file in the repository.
+// The SHA of the blob being replaced is required, so the update fails if the file has been changed meanwhile.
+// See https://docs.github.com/en/rest/repos/contents#create-or-update-file-contents
+func (s *ContentsService) Update(ctx context.Context, path string, params FileParams) (*FileCommit, *Response, error) {
+	if params.SHA == "" {
+		return nil, nil, fmt.Errorf("the blob SHA of %s is required for updating it", path)
+	}
+
+	return s.put(ctx, path, params)
+}
+
+// Delete deletes a file in the repository.
+// See https://docs.github.com/en/rest/repos/contents#delete-a-file
+func (s *ContentsService) Delete(ctx context.Context, path string, params DeleteFileParams) (*FileCommit, *Response, error) {
+	url := fmt.Sprintf("/repos/%s/%s/contents/%s", s.owner, s.repo, escapePath(path))
+	body := struct {
+		Message   string    `json:"message"`
+		SHA       string    `json:"sha"`
+		Branch    string    `json:"branch,omitempty"`
+		Author    *identity `json:"author,omitempty"`
+		Committer *identity `json:"committer,omitempty"`
+	}{
+		Message:   params.Message,
+		SHA:       params.SHA,
+		Branch:    params.Branch,
+		Author:    newIdentity(params.Author),
+		Committer: newIdentity(params.Committer),
+	}
+
+	req, err := s.client.NewRequest(ctx, "DELETE", url, body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	fileCommit := new(FileCommit)
+
+	resp, err := s.client.Do(req, fileCommit)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return fileCommit, resp, nil
+}
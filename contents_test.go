@@ -0,0 +1,1151 @@
+package github
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	fileContentBody = `{
+		"type": "file",
+		"encoding": "base64",
+		"size": 12,
+		"name": "README.md",
+		"path": "README.md",
+		"content": "SGVsbG8s\nIFdvcmxkIQ==\n",
+		"sha": "3d21ec53a331a6f037a91c368710b99387d012c1",
+		"url": "https://api.github.com/repos/octocat/Hello-World/contents/README.md",
+		"git_url": "https://api.github.com/repos/octocat/Hello-World/git/blobs/3d21ec53a331a6f037a91c368710b99387d012c1",
+		"html_url": "https://github.com/octocat/Hello-World/blob/main/README.md",
+		"download_url": "https://raw.githubusercontent.com/octocat/Hello-World/main/README.md"
+	}`
+
+	largeFileContentBody = `{
+		"type": "file",
+		"encoding": "none",
+		"size": 2097152,
+		"name": "large.bin",
+		"path": "docs/large.bin",
+		"content": "",
+		"sha": "9fb037999f264ba9a7fc6274d15fa3ae2ab98312"
+	}`
+
+	dirContentBody = `[
+		{
+			"type": "file",
+			"size": 12,
+			"name": "README.md",
+			"path": "docs/README.md",
+			"sha": "3d21ec53a331a6f037a91c368710b99387d012c1"
+		},
+		{
+			"type": "dir",
+			"size": 0,
+			"name": "images",
+			"path": "docs/images",
+			"sha": "a84d88e7554fc1fa21bcbc4efae3c782a70d2b9d"
+		}
+	]`
+
+	fileCommitBody = `{
+		"content": {
+			"type": "file",
+			"name": "README.md",
+			"path": "README.md",
+			"sha": "95b966ae1c166bd92f8ae7d1c313e738c731dfc3",
+			"size": 12
+		},
+		"commit": {
+			"sha": "7638417db6d59f3c431d3e1f261cc637155684cd",
+			"message": "Update README",
+			"author": {
+				"name": "The Octocat",
+				"email": "octocat@github.com",
+				"date": "2020-10-20T19:59:59Z"
+			},
+			"committer": {
+				"name": "The Octocat",
+				"email": "octocat@github.com",
+				"date": "2020-10-20T19:59:59Z"
+			},
+			"tree": {
+				"sha": "691272480426f78a0138979dd3ce63b77f706feb",
+				"url": "https://api.github.com/repos/octocat/Hello-World/git/trees/691272480426f78a0138979dd3ce63b77f706feb"
+			},
+			"parents": [
+				{
+					"sha": "1acc419d4d6a9ce985db7be48c6349a0475975b5",
+					"url": "https://api.github.com/repos/octocat/Hello-World/git/commits/1acc419d4d6a9ce985db7be48c6349a0475975b5"
+				}
+			]
+		}
+	}`
+)
+
+var (
+	fileContent = Content{
+		Type:        ContentTypeFile,
+		Name:        "README.md",
+		Path:        "README.md",
+		SHA:         "3d21ec53a331a6f037a91c368710b99387d012c1",
+		Size:        12,
+		Encoding:    "base64",
+		Content:     "SGVsbG8s\nIFdvcmxkIQ==\n",
+		URL:         "https://api.github.com/repos/octocat/Hello-World/contents/README.md",
+		GitURL:      "https://api.github.com/repos/octocat/Hello-World/git/blobs/3d21ec53a331a6f037a91c368710b99387d012c1",
+		HTMLURL:     "https://github.com/octocat/Hello-World/blob/main/README.md",
+		DownloadURL: "https://raw.githubusercontent.com/octocat/Hello-World/main/README.md",
+		Data:        []byte("Hello, World!"),
+	}
+
+	largeFileContent = Content{
+		Type:     ContentTypeFile,
+		Name:     "large.bin",
+		Path:     "docs/large.bin",
+		SHA:      "9fb037999f264ba9a7fc6274d15fa3ae2ab98312",
+		Size:     2097152,
+		Encoding: "none",
+		Data:     []byte("large content"),
+	}
+
+	dirContents = []Content{
+		{
+			Type: ContentTypeFile,
+			Name: "README.md",
+			Path: "docs/README.md",
+			SHA:  "3d21ec53a331a6f037a91c368710b99387d012c1",
+			Size: 12,
+		},
+		{
+			Type: ContentTypeDir,
+			Name: "images",
+			Path: "docs/images",
+			SHA:  "a84d88e7554fc1fa21bcbc4efae3c782a70d2b9d",
+		},
+	}
+
+	fileCommit = FileCommit{
+		Content: &Content{
+			Type: ContentTypeFile,
+			Name: "README.md",
+			Path: "README.md",
+			SHA:  "95b966ae1c166bd92f8ae7d1c313e738c731dfc3",
+			Size: 12,
+		},
+		Commit: RawCommit{
+			SHA:     "7638417db6d59f3c431d3e1f261cc637155684cd",
+			Message: "Update README",
+			Author: Signature{
+				Name:  "The Octocat",
+				Email: "octocat@github.com",
+				Time:  parseGitHubTime("2020-10-20T19:59:59Z"),
+			},
+			Committer: Signature{
+				Name:  "The Octocat",
+				Email: "octocat@github.com",
+				Time:  parseGitHubTime("2020-10-20T19:59:59Z"),
+			},
+			Tree: Hash{
+				SHA: "691272480426f78a0138979dd3ce63b77f706feb",
+				URL: "https://api.github.com/repos/octocat/Hello-World/git/trees/691272480426f78a0138979dd3ce63b77f706feb",
+			},
+			Parents: []Hash{
+				{
+					SHA: "1acc419d4d6a9ce985db7be48c6349a0475975b5",
+					URL: "https://api.github.com/repos/octocat/Hello-World/git/commits/1acc419d4d6a9ce985db7be48c6349a0475975b5",
+				},
+			},
+		},
+	}
+)
+
+func TestNewIdentity(t *testing.T) {
+	tests := []struct {
+		name             string
+		s                *Signature
+		expectedIdentity *identity
+	}{
+		{
+			name:             "Nil",
+			s:                nil,
+			expectedIdentity: nil,
+		},
+		{
+			name: "WithoutTime",
+			s: &Signature{
+				Name:  "The Octocat",
+				Email: "octocat@github.com",
+			},
+			expectedIdentity: &identity{
+				Name:  "The Octocat",
+				Email: "octocat@github.com",
+			},
+		},
+		{
+			name: "WithTime",
+			s: &Signature{
+				Name:  "The Octocat",
+				Email: "octocat@github.com",
+				Time:  parseGitHubTime("2020-10-20T19:59:59Z"),
+			},
+			expectedIdentity: &identity{
+				Name:  "The Octocat",
+				Email: "octocat@github.com",
+				Date:  "2020-10-20T19:59:59Z",
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expectedIdentity, newIdentity(tc.s))
+		})
+	}
+}
+
+func TestDecodeContent(t *testing.T) {
+	tests := []struct {
+		name          string
+		encoding      string
+		content       string
+		expectedData  []byte
+		expectedError string
+	}{
+		{
+			name:          "UnsupportedEncoding",
+			encoding:      "none",
+			content:       "",
+			expectedError: "unsupported content encoding: none",
+		},
+		{
+			name:          "InvalidBase64",
+			encoding:      "base64",
+			content:       "!!!",
+			expectedError: "illegal base64 data at input byte 0",
+		},
+		{
+			name:         "Base64",
+			encoding:     "base64",
+			content:      "SGVsbG8s\nIFdvcmxkIQ==\n",
+			expectedData: []byte("Hello, World!"),
+		},
+		{
+			name:         "UTF8",
+			encoding:     "utf-8",
+			content:      "Hello, World!",
+			expectedData: []byte("Hello, World!"),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			data, err := decodeContent(tc.encoding, tc.content)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, data)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedData, data)
+			}
+		})
+	}
+}
+
+func TestContentsService_Get(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	tests := []struct {
+		name             string
+		mockResponses    []MockResponse
+		s                *ContentsService
+		ctx              context.Context
+		path             string
+		ref              string
+		expectedContent  *Content
+		expectedResponse *Response
+		expectedError    string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &ContentsService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+				git: &GitService{
+					client: c,
+					owner:  "octocat",
+					repo:   "Hello-World",
+				},
+			},
+			ctx:           nil,
+			path:          "README.md",
+			ref:           "main",
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/contents/README.md", 401, http.Header{}, `{
+					"message": "Bad credentials"
+				}`},
+			},
+			s: &ContentsService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+				git: &GitService{
+					client: c,
+					owner:  "octocat",
+					repo:   "Hello-World",
+				},
+			},
+			ctx:           context.Background(),
+			path:          "README.md",
+			ref:           "main",
+			expectedError: `GET /repos/octocat/Hello-World/contents/README.md: 401 Bad credentials`,
+		},
+		{
+			name: "InvalidResponse",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/contents/README.md", 200, http.Header{}, `{`},
+			},
+			s: &ContentsService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+				git: &GitService{
+					client: c,
+					owner:  "octocat",
+					repo:   "Hello-World",
+				},
+			},
+			ctx:           context.Background(),
+			path:          "README.md",
+			ref:           "main",
+			expectedError: `unexpected EOF`,
+		},
+		{
+			name: "Directory",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/contents/docs", 200, http.Header{}, dirContentBody},
+			},
+			s: &ContentsService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+				git: &GitService{
+					client: c,
+					owner:  "octocat",
+					repo:   "Hello-World",
+				},
+			},
+			ctx:           context.Background(),
+			path:          "docs",
+			ref:           "main",
+			expectedError: `docs is a directory`,
+		},
+		{
+			name: "LargeFile_BlobFails",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/contents/docs/large.bin", 200, http.Header{}, largeFileContentBody},
+				{"GET", "/repos/octocat/Hello-World/contents/docs/large.bin", 403, http.Header{}, `{
+					"message": "This API returns blobs up to 100 MB in size."
+				}`},
+				{"GET", "/repos/octocat/Hello-World/git/blobs/9fb037999f264ba9a7fc6274d15fa3ae2ab98312", 403, http.Header{}, `{
+					"message": "This API returns blobs up to 100 MB in size."
+				}`},
+			},
+			s: &ContentsService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+				git: &GitService{
+					client: c,
+					owner:  "octocat",
+					repo:   "Hello-World",
+				},
+			},
+			ctx:           context.Background(),
+			path:          "docs/large.bin",
+			ref:           "main",
+			expectedError: `GET /repos/octocat/Hello-World/git/blobs/9fb037999f264ba9a7fc6274d15fa3ae2ab98312: 403 This API returns blobs up to 100 MB in size.`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/contents/README.md", 200, header, fileContentBody},
+			},
+			s: &ContentsService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+				git: &GitService{
+					client: c,
+					owner:  "octocat",
+					repo:   "Hello-World",
+				},
+			},
+			ctx:             context.Background(),
+			path:            "README.md",
+			ref:             "main",
+			expectedContent: &fileContent,
+			expectedResponse: &Response{
+				Rate: expectedRate,
+			},
+		},
+		{
+			name: "Success_LargeFile_Raw",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/contents/docs/large.bin", 200, header, largeFileContentBody},
+				{"GET", "/repos/octocat/Hello-World/contents/docs/large.bin", 200, header, `large content`},
+			},
+			s: &ContentsService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+				git: &GitService{
+					client: c,
+					owner:  "octocat",
+					repo:   "Hello-World",
+				},
+			},
+			ctx:             context.Background(),
+			path:            "docs/large.bin",
+			ref:             "main",
+			expectedContent: &largeFileContent,
+			expectedResponse: &Response{
+				Rate: expectedRate,
+			},
+		},
+		{
+			name: "Success_LargeFile_Blob",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/contents/docs/large.bin", 200, header, largeFileContentBody},
+				{"GET", "/repos/octocat/Hello-World/contents/docs/large.bin", 500, http.Header{}, ``},
+				{"GET", "/repos/octocat/Hello-World/git/blobs/9fb037999f264ba9a7fc6274d15fa3ae2ab98312", 200, header, `{
+					"content": "bGFyZ2UgY29udGVudA==",
+					"encoding": "base64"
+				}`},
+			},
+			s: &ContentsService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+				git: &GitService{
+					client: c,
+					owner:  "octocat",
+					repo:   "Hello-World",
+				},
+			},
+			ctx:             context.Background(),
+			path:            "docs/large.bin",
+			ref:             "main",
+			expectedContent: &largeFileContent,
+			expectedResponse: &Response{
+				Rate: expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			defer ts.Close()
+
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			content, resp, err := tc.s.Get(tc.ctx, tc.path, tc.ref)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, content)
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedContent, content)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestContentsService_List(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	tests := []struct {
+		name             string
+		mockResponses    []MockResponse
+		s                *ContentsService
+		ctx              context.Context
+		path             string
+		ref              string
+		expectedContents []Content
+		expectedResponse *Response
+		expectedError    string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &ContentsService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           nil,
+			path:          "docs",
+			ref:           "main",
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/contents/docs", 404, http.Header{}, `{
+					"message": "Not Found"
+				}`},
+			},
+			s: &ContentsService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			path:          "docs",
+			ref:           "main",
+			expectedError: `GET /repos/octocat/Hello-World/contents/docs: 404 Not Found`,
+		},
+		{
+			name: "NotDirectory",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/contents/README.md", 200, http.Header{}, fileContentBody},
+			},
+			s: &ContentsService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			path:          "README.md",
+			ref:           "main",
+			expectedError: `README.md is not a directory`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/contents/docs", 200, header, dirContentBody},
+			},
+			s: &ContentsService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:              context.Background(),
+			path:             "docs",
+			ref:              "main",
+			expectedContents: dirContents,
+			expectedResponse: &Response{
+				Rate: expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			defer ts.Close()
+
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			contents, resp, err := tc.s.List(tc.ctx, tc.path, tc.ref)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, contents)
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedContents, contents)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestContentsService_Readme(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	tests := []struct {
+		name             string
+		mockResponses    []MockResponse
+		s                *ContentsService
+		ctx              context.Context
+		ref              string
+		expectedContent  *Content
+		expectedResponse *Response
+		expectedError    string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &ContentsService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+				git: &GitService{
+					client: c,
+					owner:  "octocat",
+					repo:   "Hello-World",
+				},
+			},
+			ctx:           nil,
+			ref:           "",
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/readme", 404, http.Header{}, `{
+					"message": "Not Found"
+				}`},
+			},
+			s: &ContentsService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+				git: &GitService{
+					client: c,
+					owner:  "octocat",
+					repo:   "Hello-World",
+				},
+			},
+			ctx:           context.Background(),
+			ref:           "",
+			expectedError: `GET /repos/octocat/Hello-World/readme: 404 Not Found`,
+		},
+		{
+			name: "InvalidContent",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/readme", 200, http.Header{}, `{
+					"type": "file",
+					"encoding": "base64",
+					"content": "!!!"
+				}`},
+			},
+			s: &ContentsService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+				git: &GitService{
+					client: c,
+					owner:  "octocat",
+					repo:   "Hello-World",
+				},
+			},
+			ctx:           context.Background(),
+			ref:           "",
+			expectedError: `illegal base64 data at input byte 0`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/readme", 200, header, fileContentBody},
+			},
+			s: &ContentsService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+				git: &GitService{
+					client: c,
+					owner:  "octocat",
+					repo:   "Hello-World",
+				},
+			},
+			ctx:             context.Background(),
+			ref:             "v0.1.0",
+			expectedContent: &fileContent,
+			expectedResponse: &Response{
+				Rate: expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			defer ts.Close()
+
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			content, resp, err := tc.s.Readme(tc.ctx, tc.ref)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, content)
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedContent, content)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestContentsService_Download(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	tests := []struct {
+		name             string
+		mockResponses    []MockResponse
+		s                *ContentsService
+		ctx              context.Context
+		path             string
+		ref              string
+		expectedContent  string
+		expectedResponse *Response
+		expectedError    string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &ContentsService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           nil,
+			path:          "docs/my file.txt",
+			ref:           "main",
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/contents/docs/my file.txt", 404, http.Header{}, `{
+					"message": "Not Found"
+				}`},
+			},
+			s: &ContentsService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			path:          "docs/my file.txt",
+			ref:           "main",
+			expectedError: `GET /repos/octocat/Hello-World/contents/docs/my file.txt: 404 Not Found`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/contents/docs/my file.txt", 200, header, `Hello, World!`},
+			},
+			s: &ContentsService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:             context.Background(),
+			path:            "docs/my file.txt",
+			ref:             "main",
+			expectedContent: "Hello, World!",
+			expectedResponse: &Response{
+				Rate: expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			defer ts.Close()
+
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			buf := new(bytes.Buffer)
+			resp, err := tc.s.Download(tc.ctx, tc.path, tc.ref, buf)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedContent, buf.String())
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestContentsService_Create(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	params := FileParams{
+		Message: "Update README",
+		Content: []byte("Hello, World!"),
+		Branch:  "main",
+		Author: &Signature{
+			Name:  "The Octocat",
+			Email: "octocat@github.com",
+		},
+	}
+
+	tests := []struct {
+		name               string
+		mockResponses      []MockResponse
+		s                  *ContentsService
+		ctx                context.Context
+		path               string
+		params             FileParams
+		expectedFileCommit *FileCommit
+		expectedResponse   *Response
+		expectedError      string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &ContentsService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           nil,
+			path:          "README.md",
+			params:        params,
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"PUT", "/repos/octocat/Hello-World/contents/README.md", 422, http.Header{}, `{
+					"message": "Invalid request"
+				}`},
+			},
+			s: &ContentsService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			path:          "README.md",
+			params:        params,
+			expectedError: `PUT /repos/octocat/Hello-World/contents/README.md: 422 Invalid request`,
+		},
+		{
+			name: "InvalidResponse",
+			mockResponses: []MockResponse{
+				{"PUT", "/repos/octocat/Hello-World/contents/README.md", 201, http.Header{}, `{`},
+			},
+			s: &ContentsService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			path:          "README.md",
+			params:        params,
+			expectedError: `unexpected EOF`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"PUT", "/repos/octocat/Hello-World/contents/README.md", 201, header, fileCommitBody},
+			},
+			s: &ContentsService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:                context.Background(),
+			path:               "README.md",
+			params:             params,
+			expectedFileCommit: &fileCommit,
+			expectedResponse: &Response{
+				Rate: expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			defer ts.Close()
+
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			fileCommit, resp, err := tc.s.Create(tc.ctx, tc.path, tc.params)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, fileCommit)
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedFileCommit, fileCommit)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestContentsService_Update(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	params := FileParams{
+		Message: "Update README",
+		Content: []byte("Hello, World!"),
+		SHA:     "3d21ec53a331a6f037a91c368710b99387d012c1",
+		Branch:  "main",
+	}
+
+	tests := []struct {
+		name               string
+		mockResponses      []MockResponse
+		s                  *ContentsService
+		ctx                context.Context
+		path               string
+		params             FileParams
+		expectedFileCommit *FileCommit
+		expectedResponse   *Response
+		expectedError      string
+	}{
+		{
+			name:          "MissingSHA",
+			mockResponses: []MockResponse{},
+			s: &ContentsService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:  context.Background(),
+			path: "README.md",
+			params: FileParams{
+				Message: "Update README",
+				Content: []byte("Hello, World!"),
+			},
+			expectedError: `the blob SHA of README.md is required for updating it`,
+		},
+		{
+			name: "Conflict",
+			mockResponses: []MockResponse{
+				{"PUT", "/repos/octocat/Hello-World/contents/README.md", 409, http.Header{}, `{
+					"message": "README.md does not match 3d21ec53a331a6f037a91c368710b99387d012c1"
+				}`},
+			},
+			s: &ContentsService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			path:          "README.md",
+			params:        params,
+			expectedError: `PUT /repos/octocat/Hello-World/contents/README.md: 409 README.md does not match 3d21ec53a331a6f037a91c368710b99387d012c1`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"PUT", "/repos/octocat/Hello-World/contents/README.md", 200, header, fileCommitBody},
+			},
+			s: &ContentsService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:                context.Background(),
+			path:               "README.md",
+			params:             params,
+			expectedFileCommit: &fileCommit,
+			expectedResponse: &Response{
+				Rate: expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			defer ts.Close()
+
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			fileCommit, resp, err := tc.s.Update(tc.ctx, tc.path, tc.params)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, fileCommit)
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedFileCommit, fileCommit)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestContentsService_Delete(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	params := DeleteFileParams{
+		Message: "Delete README",
+		SHA:     "3d21ec53a331a6f037a91c368710b99387d012c1",
+		Branch:  "main",
+	}
+
+	tests := []struct {
+		name               string
+		mockResponses      []MockResponse
+		s                  *ContentsService
+		ctx                context.Context
+		path               string
+		params             DeleteFileParams
+		expectedFileCommit *FileCommit
+		expectedResponse   *Response
+		expectedError      string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &ContentsService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           nil,
+			path:          "README.md",
+			params:        params,
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"DELETE", "/repos/octocat/Hello-World/contents/README.md", 422, http.Header{}, `{
+					"message": "Invalid request"
+				}`},
+			},
+			s: &ContentsService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			path:          "README.md",
+			params:        params,
+			expectedError: `DELETE /repos/octocat/Hello-World/contents/README.md: 422 Invalid request`,
+		},
+		{
+			name: "InvalidResponse",
+			mockResponses: []MockResponse{
+				{"DELETE", "/repos/octocat/Hello-World/contents/README.md", 200, http.Header{}, `{`},
+			},
+			s: &ContentsService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			path:          "README.md",
+			params:        params,
+			expectedError: `unexpected EOF`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"DELETE", "/repos/octocat/Hello-World/contents/README.md", 200, header, `{
+					"content": null,
+					"commit": {
+						"sha": "7638417db6d59f3c431d3e1f261cc637155684cd",
+						"message": "Delete README"
+					}
+				}`},
+			},
+			s: &ContentsService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:    context.Background(),
+			path:   "README.md",
+			params: params,
+			expectedFileCommit: &FileCommit{
+				Commit: RawCommit{
+					SHA:     "7638417db6d59f3c431d3e1f261cc637155684cd",
+					Message: "Delete README",
+				},
+			},
+			expectedResponse: &Response{
+				Rate: expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			defer ts.Close()
+
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			fileCommit, resp, err := tc.s.Delete(tc.ctx, tc.path, tc.params)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, fileCommit)
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedFileCommit, fileCommit)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
@@ -0,0 +1,192 @@
+package github
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// ErrInvalidPublicKey occurs when a public key is not a well-formed OpenSSH public key.
+var ErrInvalidPublicKey = errors.New("invalid public key")
+
+// publicKeyFields are the number of fields encoded in a public key blob after the key type for each supported key type.
+var publicKeyFields = map[string]int{
+	"ssh-rsa":                            2, // e, n
+	"ssh-dss":                            4, // p, q, g, y
+	"ssh-ed25519":                        1, // public key
+	"ecdsa-sha2-nistp256":                2, // curve, public key
+	"ecdsa-sha2-nistp384":                2, // curve, public key
+	"ecdsa-sha2-nistp521":                2, // curve, public key
+	"sk-ssh-ed25519@openssh.com":         2, // public key, application
+	"sk-ecdsa-sha2-nistp256@openssh.com": 3, // curve, public key, application
+}
+
+type (
+	// DeployKey is a GitHub deploy key object.
+	DeployKey struct {
+		ID        int        `json:"id"`
+		Key       string     `json:"key"`
+		Title     string     `json:"title"`
+		ReadOnly  bool       `json:"read_only"`
+		Verified  bool       `json:"verified"`
+		AddedBy   string     `json:"added_by"`
+		URL       string     `json:"url"`
+		CreatedAt time.Time  `json:"created_at"`
+		LastUsed  *time.Time `json:"last_used"`
+	}
+
+	// DeployKeyParams is used for creating a GitHub deploy key.
+	DeployKeyParams struct {
+		Title    string `json:"title"`
+		Key      string `json:"key"`
+		ReadOnly bool   `json:"read_only"`
+	}
+)
+
+// parsePublicKey parses an OpenSSH public key in the authorized_keys format (type, base64 blob, and an optional comment).
+// It returns the decoded blob of the key.
+func parsePublicKey(key string) ([]byte, error) {
+	fields := strings.Fields(key)
+	if len(fields) < 2 {
+		return nil, fmt.Errorf("%w: expected key type and key data", ErrInvalidPublicKey)
+	}
+
+	keyType := fields[0]
+	n, ok := publicKeyFields[keyType]
+	if !ok {
+		return nil, fmt.Errorf("%w: unsupported key type %q", ErrInvalidPublicKey, keyType)
+	}
+
+	blob, err := base64.StdEncoding.DecodeString(fields[1])
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidPublicKey, err)
+	}
+
+	// The blob is a sequence of length-prefixed strings starting with the key type.
+	b := blob
+	for i := 0; i <= n; i++ {
+		if len(b) < 4 {
+			return nil, fmt.Errorf("%w: truncated key data", ErrInvalidPublicKey)
+		}
+
+		l := binary.BigEndian.Uint32(b)
+		if uint64(len(b)-4) < uint64(l) {
+			return nil, fmt.Errorf("%w: truncated key data", ErrInvalidPublicKey)
+		}
+
+		if i == 0 && string(b[4:4+l]) != keyType {
+			return nil, fmt.Errorf("%w: key type %q does not match key data", ErrInvalidPublicKey, keyType)
+		}
+
+		b = b[4+l:]
+	}
+
+	if len(b) > 0 {
+		return nil, fmt.Errorf("%w: unexpected trailing key data", ErrInvalidPublicKey)
+	}
+
+	return blob, nil
+}
+
+// ValidatePublicKey verifies that a key is a well-formed OpenSSH public key.
+func ValidatePublicKey(key string) error {
+	_, err := parsePublicKey(key)
+	return err
+}
+
+// PublicKeyFingerprint returns the SHA256 fingerprint of an OpenSSH public key in the same format as ssh-keygen -l.
+func PublicKeyFingerprint(key string) (string, error) {
+	blob, err := parsePublicKey(key)
+	if err != nil {
+		return "", err
+	}
+
+	sum := sha256.Sum256(blob)
+
+	return "SHA256:" + base64.RawStdEncoding.EncodeToString(sum[:]), nil
+}
+
+// DeployKeys retrieves all deploy keys of the repository page by page.
+// See https://docs.github.com/en/rest/deploy-keys/deploy-keys#list-deploy-keys
+func (s *RepoService) DeployKeys(ctx context.Context, pageSize, pageNo int) ([]DeployKey, *Response, error) {
+	url := fmt.Sprintf("/repos/%s/%s/keys", s.owner, s.repo)
+	req, err := s.client.NewPageRequest(ctx, "GET", url, pageSize, pageNo, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	keys := []DeployKey{}
+
+	resp, err := s.client.Do(req, &keys)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return keys, resp, nil
+}
+
+// DeployKey retrieves a deploy key of the repository by its id.
+// See https://docs.github.com/en/rest/deploy-keys/deploy-keys#get-a-deploy-key
+func (s *RepoService) DeployKey(ctx context.Context, id int) (*DeployKey, *Response, error) {
+	url := fmt.Sprintf("/repos/%s/%s/keys/%d", s.owner, s.repo, id)
+	req, err := s.client.NewRequest(ctx, "GET", url, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	key := new(DeployKey)
+
+	resp, err := s.client.Do(req, key)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return key, resp, nil
+}
+
+// CreateDeployKey creates a new deploy key for the repository.
+// The key is validated before sending the request.
+// See https://docs.github.com/en/rest/deploy-keys/deploy-keys#create-a-deploy-key
+func (s *RepoService) CreateDeployKey(ctx context.Context, params DeployKeyParams) (*DeployKey, *Response, error) {
+	if err := ValidatePublicKey(params.Key); err != nil {
+		return nil, nil, err
+	}
+
+	url := fmt.Sprintf("/repos/%s/%s/keys", s.owner, s.repo)
+	req, err := s.client.NewRequest(ctx, "POST", url, params)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	key := new(DeployKey)
+
+	resp, err := s.client.Do(req, key)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return key, resp, nil
+}
+
+// DeleteDeployKey deletes a deploy key of the repository.
+// Deploy keys are immutable, so a key should be deleted and created again for updating it.
+// See https://docs.github.com/en/rest/deploy-keys/deploy-keys#delete-a-deploy-key
+func (s *RepoService) DeleteDeployKey(ctx context.Context, id int) (*Response, error) {
+	url := fmt.Sprintf("/repos/%s/%s/keys/%d", s.owner, s.repo, id)
+	req, err := s.client.NewRequest(ctx, "DELETE", url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,585 @@
+package github
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	ed25519PublicKey = "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIEOFPwM8hgsUCnEXmAcnYSjXUcvOuMSAWi31NjQexWIb mirror@example.com"
+	rsaPublicKey     = "ssh-rsa AAAAB3NzaC1yc2EAAAADAQABAAAAgQDUqTXOl0UjJmhLS7E4qHHDnUa48P2QfhScTM4pJ92Ar+XGMSC9eAtMPz/Dqt0M4tFBPXWzvjjXuYHkT5y3v+JBNpwxaikS+RYtuLZs08AvIw6tmP/fxnPF8OBNOLNDxiXiM4ThtcnTD6p5Q1cbGDysWfHqjjQGMhYIXpFa0J0wtQ=="
+	ecdsaPublicKey   = "ecdsa-sha2-nistp256 AAAAE2VjZHNhLXNoYTItbmlzdHAyNTYAAAAIbmlzdHAyNTYAAABBBDbqZZJNRVkoHJzKW7/m5oYwQnN1gYpJ54nK5SEIlN8LcZIPNO0OHYKtPKMuAwYj8MyfWqjAov3gQO/L23h2FYg="
+
+	deployKeyBody = `{
+		"id": 1,
+		"key": "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIEOFPwM8hgsUCnEXmAcnYSjXUcvOuMSAWi31NjQexWIb",
+		"title": "mirror",
+		"read_only": true,
+		"verified": true,
+		"added_by": "octocat",
+		"url": "https://api.github.com/repos/octocat/Hello-World/keys/1",
+		"created_at": "2020-10-20T19:59:59Z",
+		"last_used": "2020-10-27T23:59:59Z"
+	}`
+
+	deployKeysBody = `[
+		{
+			"id": 1,
+			"key": "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIEOFPwM8hgsUCnEXmAcnYSjXUcvOuMSAWi31NjQexWIb",
+			"title": "mirror",
+			"read_only": true,
+			"verified": true,
+			"added_by": "octocat",
+			"url": "https://api.github.com/repos/octocat/Hello-World/keys/1",
+			"created_at": "2020-10-20T19:59:59Z",
+			"last_used": "2020-10-27T23:59:59Z"
+		}
+	]`
+)
+
+var deployKey = DeployKey{
+	ID:        1,
+	Key:       "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIEOFPwM8hgsUCnEXmAcnYSjXUcvOuMSAWi31NjQexWIb",
+	Title:     "mirror",
+	ReadOnly:  true,
+	Verified:  true,
+	AddedBy:   "octocat",
+	URL:       "https://api.github.com/repos/octocat/Hello-World/keys/1",
+	CreatedAt: parseGitHubTime("2020-10-20T19:59:59Z"),
+	LastUsed:  parseGitHubTimePtr("2020-10-27T23:59:59Z"),
+}
+
+func TestValidatePublicKey(t *testing.T) {
+	tests := []struct {
+		name          string
+		key           string
+		expectedError string
+	}{
+		{
+			name:          "Empty",
+			key:           "",
+			expectedError: "invalid public key: expected key type and key data",
+		},
+		{
+			name:          "MissingKeyData",
+			key:           "ssh-ed25519",
+			expectedError: "invalid public key: expected key type and key data",
+		},
+		{
+			name:          "UnsupportedKeyType",
+			key:           "ssh-foo AAAAC3NzaC1lZDI1NTE5AAAAIEOFPwM8hgsUCnEXmAcnYSjXUcvOuMSAWi31NjQexWIb",
+			expectedError: `invalid public key: unsupported key type "ssh-foo"`,
+		},
+		{
+			name:          "InvalidBase64",
+			key:           "ssh-ed25519 AAAA!!!!",
+			expectedError: "invalid public key: illegal base64 data at input byte 4",
+		},
+		{
+			name:          "KeyTypeMismatch",
+			key:           "ssh-rsa AAAAC3NzaC1lZDI1NTE5AAAAIEOFPwM8hgsUCnEXmAcnYSjXUcvOuMSAWi31NjQexWIb",
+			expectedError: `invalid public key: key type "ssh-rsa" does not match key data`,
+		},
+		{
+			name:          "TruncatedKeyData",
+			key:           "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIEOFPwM8hgsUCnEX",
+			expectedError: "invalid public key: truncated key data",
+		},
+		{
+			name:          "TrailingKeyData",
+			key:           "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIEOFPwM8hgsUCnEXmAcnYSjXUcvOuMSAWi31NjQexWIbAAA=",
+			expectedError: "invalid public key: unexpected trailing key data",
+		},
+		{
+			name: "ED25519",
+			key:  ed25519PublicKey,
+		},
+		{
+			name: "RSA",
+			key:  rsaPublicKey,
+		},
+		{
+			name: "ECDSA",
+			key:  ecdsaPublicKey,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := ValidatePublicKey(tc.key)
+
+			if tc.expectedError != "" {
+				assert.ErrorIs(t, err, ErrInvalidPublicKey)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestPublicKeyFingerprint(t *testing.T) {
+	tests := []struct {
+		name                string
+		key                 string
+		expectedFingerprint string
+		expectedError       string
+	}{
+		{
+			name:          "Invalid",
+			key:           "ssh-ed25519",
+			expectedError: "invalid public key: expected key type and key data",
+		},
+		{
+			name:                "ED25519",
+			key:                 ed25519PublicKey,
+			expectedFingerprint: "SHA256:dApStOW24yzaMwVCxNV9B0onBAKuqiHx7OSp8Re2Q8o",
+		},
+		{
+			name:                "RSA",
+			key:                 rsaPublicKey,
+			expectedFingerprint: "SHA256:uLFfE8zgw75dyEDOrtOyX+17AIuebeUrk+/+oY0h5fo",
+		},
+		{
+			name:                "ECDSA",
+			key:                 ecdsaPublicKey,
+			expectedFingerprint: "SHA256:U8bsZ/lGWkFrk4JtaonmvG4uvjzS8MLY3BnJ3np4OXk",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			fingerprint, err := PublicKeyFingerprint(tc.key)
+
+			if tc.expectedError != "" {
+				assert.Empty(t, fingerprint)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedFingerprint, fingerprint)
+			}
+		})
+	}
+}
+
+func TestRepoService_DeployKeys(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	tests := []struct {
+		name             string
+		mockResponses    []MockResponse
+		s                *RepoService
+		ctx              context.Context
+		pageSize         int
+		pageNo           int
+		expectedKeys     []DeployKey
+		expectedResponse *Response
+		expectedError    string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           nil,
+			pageSize:      10,
+			pageNo:        1,
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/keys", 401, http.Header{}, `{
+					"message": "Bad credentials"
+				}`},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			pageSize:      10,
+			pageNo:        1,
+			expectedError: `GET /repos/octocat/Hello-World/keys: 401 Bad credentials`,
+		},
+		{
+			name: "InvalidResponse",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/keys", 200, http.Header{}, `{`},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			pageSize:      10,
+			pageNo:        1,
+			expectedError: `unexpected EOF`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/keys", 200, header, deployKeysBody},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:          context.Background(),
+			pageSize:     10,
+			pageNo:       1,
+			expectedKeys: []DeployKey{deployKey},
+			expectedResponse: &Response{
+				Pages: expectedPages,
+				Rate:  expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			defer ts.Close()
+
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			keys, resp, err := tc.s.DeployKeys(tc.ctx, tc.pageSize, tc.pageNo)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, keys)
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedKeys, keys)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Pages, resp.Pages)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestRepoService_DeployKey(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	tests := []struct {
+		name             string
+		mockResponses    []MockResponse
+		s                *RepoService
+		ctx              context.Context
+		id               int
+		expectedKey      *DeployKey
+		expectedResponse *Response
+		expectedError    string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           nil,
+			id:            1,
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/keys/1", 401, http.Header{}, `{
+					"message": "Bad credentials"
+				}`},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			id:            1,
+			expectedError: `GET /repos/octocat/Hello-World/keys/1: 401 Bad credentials`,
+		},
+		{
+			name: "InvalidResponse",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/keys/1", 200, http.Header{}, `{`},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			id:            1,
+			expectedError: `unexpected EOF`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/keys/1", 200, header, deployKeyBody},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:         context.Background(),
+			id:          1,
+			expectedKey: &deployKey,
+			expectedResponse: &Response{
+				Rate: expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			defer ts.Close()
+
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			key, resp, err := tc.s.DeployKey(tc.ctx, tc.id)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, key)
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedKey, key)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestRepoService_CreateDeployKey(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	params := DeployKeyParams{
+		Title:    "mirror",
+		Key:      ed25519PublicKey,
+		ReadOnly: true,
+	}
+
+	tests := []struct {
+		name             string
+		mockResponses    []MockResponse
+		s                *RepoService
+		ctx              context.Context
+		params           DeployKeyParams
+		expectedKey      *DeployKey
+		expectedResponse *Response
+		expectedError    string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           nil,
+			params:        params,
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"POST", "/repos/octocat/Hello-World/keys", 401, http.Header{}, `{
+					"message": "Bad credentials"
+				}`},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			params:        params,
+			expectedError: `POST /repos/octocat/Hello-World/keys: 401 Bad credentials`,
+		},
+		{
+			name: "InvalidResponse",
+			mockResponses: []MockResponse{
+				{"POST", "/repos/octocat/Hello-World/keys", 201, http.Header{}, `{`},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			params:        params,
+			expectedError: `unexpected EOF`,
+		},
+		{
+			name:          "InvalidKey",
+			mockResponses: []MockResponse{},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx: context.Background(),
+			params: DeployKeyParams{
+				Title: "mirror",
+				Key:   "ssh-ed25519",
+			},
+			expectedError: `invalid public key: expected key type and key data`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"POST", "/repos/octocat/Hello-World/keys", 201, header, deployKeyBody},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:         context.Background(),
+			params:      params,
+			expectedKey: &deployKey,
+			expectedResponse: &Response{
+				Rate: expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			defer ts.Close()
+
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			key, resp, err := tc.s.CreateDeployKey(tc.ctx, tc.params)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, key)
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedKey, key)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestRepoService_DeleteDeployKey(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	tests := []struct {
+		name             string
+		mockResponses    []MockResponse
+		s                *RepoService
+		ctx              context.Context
+		id               int
+		expectedResponse *Response
+		expectedError    string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           nil,
+			id:            1,
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"DELETE", "/repos/octocat/Hello-World/keys/1", 401, http.Header{}, `{
+					"message": "Bad credentials"
+				}`},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			id:            1,
+			expectedError: `DELETE /repos/octocat/Hello-World/keys/1: 401 Bad credentials`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"DELETE", "/repos/octocat/Hello-World/keys/1", 204, header, ``},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx: context.Background(),
+			id:  1,
+			expectedResponse: &Response{
+				Rate: expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			defer ts.Close()
+
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			resp, err := tc.s.DeleteDeployKey(tc.ctx, tc.id)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
@@ -0,0 +1,491 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// DeploymentService provides GitHub APIs for deployments and environments in a repository.
+// See https://docs.github.com/en/rest/deployments
+type DeploymentService struct {
+	client      *Client
+	owner, repo string
+}
+
+// DeploymentState is the state of a GitHub deployment status.
+type DeploymentState string
+
+const (
+	// DeploymentStateError is the state of a deployment that has errored.
+	DeploymentStateError DeploymentState = "error"
+	// DeploymentStateFailure is the state of a failed deployment.
+	DeploymentStateFailure DeploymentState = "failure"
+	// DeploymentStateInactive is the state of a deployment that is no longer active.
+	DeploymentStateInactive DeploymentState = "inactive"
+	// DeploymentStateInProgress is the state of a deployment in progress.
+	DeploymentStateInProgress DeploymentState = "in_progress"
+	// DeploymentStateQueued is the state of a queued deployment.
+	DeploymentStateQueued DeploymentState = "queued"
+	// DeploymentStatePending is the state of a pending deployment.
+	DeploymentStatePending DeploymentState = "pending"
+	// DeploymentStateSuccess is the state of a successful deployment.
+	DeploymentStateSuccess DeploymentState = "success"
+)
+
+type (
+	// Deployment is a GitHub deployment object.
+	Deployment struct {
+		ID                    int             `json:"id"`
+		SHA                   string          `json:"sha"`
+		Ref                   string          `json:"ref"`
+		Task                  string          `json:"task"`
+		Payload               json.RawMessage `json:"payload"`
+		Environment           string          `json:"environment"`
+		OriginalEnvironment   string          `json:"original_environment"`
+		Description           string          `json:"description"`
+		Creator               User            `json:"creator"`
+		TransientEnvironment  bool            `json:"transient_environment"`
+		ProductionEnvironment bool            `json:"production_environment"`
+		URL                   string          `json:"url"`
+		StatusesURL           string          `json:"statuses_url"`
+		CreatedAt             time.Time       `json:"created_at"`
+		UpdatedAt             time.Time       `json:"updated_at"`
+	}
+
+	// DeploymentParams is used for creating a GitHub deployment.
+	// If AutoMerge is nil, the default branch is merged into the ref if it is behind.
+	// If RequiredContexts is nil, all unique contexts are verified before creating the deployment.
+	// An empty non-nil RequiredContexts bypasses checking contexts altogether.
+	DeploymentParams struct {
+		Ref                   string
+		Task                  string
+		AutoMerge             *bool
+		RequiredContexts      []string
+		Payload               interface{}
+		Environment           string
+		Description           string
+		TransientEnvironment  bool
+		ProductionEnvironment *bool
+	}
+
+	// DeploymentStatus is a GitHub deployment status object.
+	DeploymentStatus struct {
+		ID             int             `json:"id"`
+		State          DeploymentState `json:"state"`
+		Creator        User            `json:"creator"`
+		Description    string          `json:"description"`
+		Environment    string          `json:"environment"`
+		TargetURL      string          `json:"target_url"`
+		LogURL         string          `json:"log_url"`
+		EnvironmentURL string          `json:"environment_url"`
+		DeploymentURL  string          `json:"deployment_url"`
+		RepositoryURL  string          `json:"repository_url"`
+		URL            string          `json:"url"`
+		CreatedAt      time.Time       `json:"created_at"`
+		UpdatedAt      time.Time       `json:"updated_at"`
+	}
+
+	// DeploymentStatusParams is used for creating a GitHub deployment status.
+	// If AutoInactive is nil, all prior non-transient, non-production deployments in the same environment become inactive.
+	DeploymentStatusParams struct {
+		State          DeploymentState `json:"state"`
+		LogURL         string          `json:"log_url,omitempty"`
+		Description    string          `json:"description,omitempty"`
+		Environment    string          `json:"environment,omitempty"`
+		EnvironmentURL string          `json:"environment_url,omitempty"`
+		AutoInactive   *bool           `json:"auto_inactive,omitempty"`
+	}
+)
+
+// DeploymentsFilter are used for fetching Deployments.
+type DeploymentsFilter struct {
+	SHA         string
+	Ref         string
+	Task        string
+	Environment string
+}
+
+type (
+	// EnvironmentReviewer is a user or a team that can review deployments to an environment.
+	// Depending on Type, either User or Team is set.
+	EnvironmentReviewer struct {
+		Type string // Either User or Team
+		User *User
+		Team *Team
+	}
+
+	// ProtectionRule is a GitHub environment protection rule object.
+	ProtectionRule struct {
+		ID                int                   `json:"id"`
+		Type              string                `json:"type"` // Either required_reviewers, wait_timer, or branch_policy
+		WaitTimer         int                   `json:"wait_timer"`
+		PreventSelfReview bool                  `json:"prevent_self_review"`
+		Reviewers         []EnvironmentReviewer `json:"reviewers"`
+	}
+
+	// DeploymentBranchPolicy specifies which branches can deploy to an environment.
+	// Only one of ProtectedBranches and CustomBranchPolicies can be true.
+	DeploymentBranchPolicy struct {
+		ProtectedBranches    bool `json:"protected_branches"`
+		CustomBranchPolicies bool `json:"custom_branch_policies"`
+	}
+
+	// Environment is a GitHub deployment environment object.
+	Environment struct {
+		ID                     int                     `json:"id"`
+		Name                   string                  `json:"name"`
+		URL                    string                  `json:"url"`
+		HTMLURL                string                  `json:"html_url"`
+		ProtectionRules        []ProtectionRule        `json:"protection_rules"`
+		DeploymentBranchPolicy *DeploymentBranchPolicy `json:"deployment_branch_policy"`
+		CreatedAt              time.Time               `json:"created_at"`
+		UpdatedAt              time.Time               `json:"updated_at"`
+	}
+
+	// ReviewerParams is a user or a team that can review deployments to an environment.
+	ReviewerParams struct {
+		Type string `json:"type"` // Either User or Team
+		ID   int    `json:"id"`
+	}
+
+	// EnvironmentParams is used for creating or updating a GitHub environment.
+	// WaitTimer is in minutes (0 to 43200).
+	// A nil DeploymentBranchPolicy allows all branches to deploy to the environment.
+	EnvironmentParams struct {
+		WaitTimer              *int                    `json:"wait_timer,omitempty"`
+		PreventSelfReview      *bool                   `json:"prevent_self_review,omitempty"`
+		Reviewers              []ReviewerParams        `json:"reviewers,omitempty"`
+		DeploymentBranchPolicy *DeploymentBranchPolicy `json:"deployment_branch_policy"`
+	}
+
+	// PendingDeployment is a deployment of a workflow run waiting for a protection rule to be satisfied.
+	PendingDeployment struct {
+		Environment           Environment           `json:"environment"`
+		WaitTimer             int                   `json:"wait_timer"`
+		WaitTimerStartedAt    *time.Time            `json:"wait_timer_started_at"`
+		CurrentUserCanApprove bool                  `json:"current_user_can_approve"`
+		Reviewers             []EnvironmentReviewer `json:"reviewers"`
+	}
+)
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (r *EnvironmentReviewer) UnmarshalJSON(b []byte) error {
+	v := struct {
+		Type     string          `json:"type"`
+		Reviewer json.RawMessage `json:"reviewer"`
+	}{}
+
+	if err := json.Unmarshal(b, &v); err != nil {
+		return err
+	}
+
+	r.Type = v.Type
+	r.User, r.Team = nil, nil
+
+	switch v.Type {
+	case "User":
+		r.User = new(User)
+		return json.Unmarshal(v.Reviewer, r.User)
+	case "Team":
+		r.Team = new(Team)
+		return json.Unmarshal(v.Reviewer, r.Team)
+	}
+
+	return nil
+}
+
+// List retrieves all deployments in the repository page by page.
+// See https://docs.github.com/en/rest/deployments/deployments#list-deployments
+func (s *DeploymentService) List(ctx context.Context, pageSize, pageNo int, filter DeploymentsFilter) ([]Deployment, *Response, error) {
+	url := fmt.Sprintf("/repos/%s/%s/deployments", s.owner, s.repo)
+	req, err := s.client.NewPageRequest(ctx, "GET", url, pageSize, pageNo, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	q := req.URL.Query()
+	if filter.SHA != "" {
+		q.Add("sha", filter.SHA)
+	}
+	if filter.Ref != "" {
+		q.Add("ref", filter.Ref)
+	}
+	if filter.Task != "" {
+		q.Add("task", filter.Task)
+	}
+	if filter.Environment != "" {
+		q.Add("environment", filter.Environment)
+	}
+	req.URL.RawQuery = q.Encode()
+
+	deployments := []Deployment{}
+
+	resp, err := s.client.Do(req, &deployments)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return deployments, resp, nil
+}
+
+// Get retrieves a deployment by its id.
+// See https://docs.github.com/en/rest/deployments/deployments#get-a-deployment
+func (s *DeploymentService) Get(ctx context.Context, id int) (*Deployment, *Response, error) {
+	url := fmt.Sprintf("/repos/%s/%s/deployments/%d", s.owner, s.repo, id)
+	req, err := s.client.NewRequest(ctx, "GET", url, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	deployment := new(Deployment)
+
+	resp, err := s.client.Do(req, deployment)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return deployment, resp, nil
+}
+
+// Create creates a new deployment.
+// If the default branch is merged into the ref instead of creating a deployment, no deployment is returned.
+// See https://docs.github.com/en/rest/deployments/deployments#create-a-deployment
+func (s *DeploymentService) Create(ctx context.Context, params DeploymentParams) (*Deployment, *Response, error) {
+	url := fmt.Sprintf("/repos/%s/%s/deployments", s.owner, s.repo)
+	body := struct {
+		Ref                   string      `json:"ref"`
+		Task                  string      `json:"task,omitempty"`
+		AutoMerge             *bool       `json:"auto_merge,omitempty"`
+		RequiredContexts      *[]string   `json:"required_contexts,omitempty"`
+		Payload               interface{} `json:"payload,omitempty"`
+		Environment           string      `json:"environment,omitempty"`
+		Description           string      `json:"description,omitempty"`
+		TransientEnvironment  bool        `json:"transient_environment,omitempty"`
+		ProductionEnvironment *bool       `json:"production_environment,omitempty"`
+	}{
+		Ref:                   params.Ref,
+		Task:                  params.Task,
+		AutoMerge:             params.AutoMerge,
+		Payload:               params.Payload,
+		Environment:           params.Environment,
+		Description:           params.Description,
+		TransientEnvironment:  params.TransientEnvironment,
+		ProductionEnvironment: params.ProductionEnvironment,
+	}
+
+	if params.RequiredContexts != nil {
+		body.RequiredContexts = &params.RequiredContexts
+	}
+
+	req, err := s.client.NewRequest(ctx, "POST", url, body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	deployment := new(Deployment)
+
+	resp, err := s.client.Do(req, deployment)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if resp.StatusCode == http.StatusAccepted {
+		return nil, resp, nil
+	}
+
+	return deployment, resp, nil
+}
+
+// Delete deletes a deployment.
+// Only inactive deployments can be deleted unless the repository has a single deployment.
+// See https://docs.github.com/en/rest/deployments/deployments#delete-a-deployment
+func (s *DeploymentService) Delete(ctx context.Context, id int) (*Response, error) {
+	url := fmt.Sprintf("/repos/%s/%s/deployments/%d", s.owner, s.repo, id)
+	req, err := s.client.NewRequest(ctx, "DELETE", url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// Statuses retrieves all statuses of a deployment page by page.
+// See https://docs.github.com/en/rest/deployments/statuses#list-deployment-statuses
+func (s *DeploymentService) Statuses(ctx context.Context, id, pageSize, pageNo int) ([]DeploymentStatus, *Response, error) {
+	url := fmt.Sprintf("/repos/%s/%s/deployments/%d/statuses", s.owner, s.repo, id)
+	req, err := s.client.NewPageRequest(ctx, "GET", url, pageSize, pageNo, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	statuses := []DeploymentStatus{}
+
+	resp, err := s.client.Do(req, &statuses)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return statuses, resp, nil
+}
+
+// CreateStatus creates a new status for a deployment.
+// See https://docs.github.com/en/rest/deployments/statuses#create-a-deployment-status
+func (s *DeploymentService) CreateStatus(ctx context.Context, id int, params DeploymentStatusParams) (*DeploymentStatus, *Response, error) {
+	url := fmt.Sprintf("/repos/%s/%s/deployments/%d/statuses", s.owner, s.repo, id)
+	req, err := s.client.NewRequest(ctx, "POST", url, params)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	status := new(DeploymentStatus)
+
+	resp, err := s.client.Do(req, status)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return status, resp, nil
+}
+
+// Environments retrieves all environments in the repository page by page.
+// See https://docs.github.com/en/rest/deployments/environments#list-environments
+func (s *DeploymentService) Environments(ctx context.Context, pageSize, pageNo int) ([]Environment, *Response, error) {
+	url := fmt.Sprintf("/repos/%s/%s/environments", s.owner, s.repo)
+	req, err := s.client.NewPageRequest(ctx, "GET", url, pageSize, pageNo, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	body := new(struct {
+		TotalCount   int           `json:"total_count"`
+		Environments []Environment `json:"environments"`
+	})
+
+	resp, err := s.client.Do(req, body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return body.Environments, resp, nil
+}
+
+// Environment retrieves an environment by its name.
+// See https://docs.github.com/en/rest/deployments/environments#get-an-environment
+func (s *DeploymentService) Environment(ctx context.Context, name string) (*Environment, *Response, error) {
+	url := fmt.Sprintf("/repos/%s/%s/environments/%s", s.owner, s.repo, escapePath(name))
+	req, err := s.client.NewRequest(ctx, "GET", url, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	environment := new(Environment)
+
+	resp, err := s.client.Do(req, environment)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return environment, resp, nil
+}
+
+// UpdateEnvironment creates an environment or updates an existing environment with protection rules.
+// See https://docs.github.com/en/rest/deployments/environments#create-or-update-an-environment
+func (s *DeploymentService) UpdateEnvironment(ctx context.Context, name string, params EnvironmentParams) (*Environment, *Response, error) {
+	url := fmt.Sprintf("/repos/%s/%s/environments/%s", s.owner, s.repo, escapePath(name))
+	req, err := s.client.NewRequest(ctx, "PUT", url, params)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	environment := new(Environment)
+
+	resp, err := s.client.Do(req, environment)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return environment, resp, nil
+}
+
+// DeleteEnvironment deletes an environment.
+// See https://docs.github.com/en/rest/deployments/environments#delete-an-environment
+func (s *DeploymentService) DeleteEnvironment(ctx context.Context, name string) (*Response, error) {
+	url := fmt.Sprintf("/repos/%s/%s/environments/%s", s.owner, s.repo, escapePath(name))
+	req, err := s.client.NewRequest(ctx, "DELETE", url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// PendingDeployments retrieves all deployments of a workflow run waiting for protection rules to be satisfied.
+// See https://docs.github.com/en/rest/actions/workflow-runs#get-pending-deployments-for-a-workflow-run
+func (s *DeploymentService) PendingDeployments(ctx context.Context, runID int) ([]PendingDeployment, *Response, error) {
+	url := fmt.Sprintf("/repos/%s/%s/actions/runs/%d/pending_deployments", s.owner, s.repo, runID)
+	req, err := s.client.NewRequest(ctx, "GET", url, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	pending := []PendingDeployment{}
+
+	resp, err := s.client.Do(req, &pending)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return pending, resp, nil
+}
+
+// ApprovePendingDeployments approves the pending deployments of a workflow run to a set of environments.
+// See https://docs.github.com/en/rest/actions/workflow-runs#review-pending-deployments-for-a-workflow-run
+func (s *DeploymentService) ApprovePendingDeployments(ctx context.Context, runID int, environmentIDs []int, comment string) ([]Deployment, *Response, error) {
+	return s.reviewPendingDeployments(ctx, runID, environmentIDs, "approved", comment)
+}
+
+// RejectPendingDeployments rejects the pending deployments of a workflow run to a set of environments.
+// See https://docs.github.com/en/rest/actions/workflow-runs#review-pending-deployments-for-a-workflow-run
+func (s *DeploymentService) RejectPendingDeployments(ctx context.Context, runID int, environmentIDs []int, comment string) ([]Deployment, *Response, error) {
+	return s.reviewPendingDeployments(ctx, runID, environmentIDs, "rejected", comment)
+}
+
+func (s *DeploymentService) reviewPendingDeployments(ctx context.Context, runID int, environmentIDs []int, state, comment string) ([]Deployment, *Response, error) {
+	url := fmt.Sprintf("/repos/%s/%s/actions/runs/%d/pending_deployments", s.owner, s.repo, runID)
+	body := struct {
+		EnvironmentIDs []int  `json:"environment_ids"`
+		State          string `json:"state"`
+		Comment        string `json:"comment"`
+	}{
+		EnvironmentIDs: environmentIDs,
+		State:          state,
+		Comment:        comment,
+	}
+
+	req, err := s.client.NewRequest(ctx, "POST", url, body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	deployments := []Deployment{}
+
+	resp, err := s.client.Do(req, &deployments)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return deployments, resp, nil
+}
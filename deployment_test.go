@@ -0,0 +1,1803 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	deploymentBody = `{
+		"id": 1,
+		"sha": "a84d88e7554fc1fa21bcbc4efae3c782a70d2b9d",
+		"ref": "topic-branch",
+		"task": "deploy",
+		"payload": {"version": "v0.1.0"},
+		"environment": "production",
+		"original_environment": "staging",
+		"description": "Deploy request from hubot",
+		"creator": {
+			"login": "octocat",
+			"id": 1,
+			"type": "User"
+		},
+		"transient_environment": false,
+		"production_environment": true,
+		"url": "https://api.github.com/repos/octocat/Hello-World/deployments/1",
+		"statuses_url": "https://api.github.com/repos/octocat/Hello-World/deployments/1/statuses",
+		"created_at": "2020-10-20T19:59:59Z",
+		"updated_at": "2020-10-20T19:59:59Z"
+	}`
+
+	deploymentsBody = `[
+		{
+			"id": 1,
+			"sha": "a84d88e7554fc1fa21bcbc4efae3c782a70d2b9d",
+			"ref": "topic-branch",
+			"task": "deploy",
+			"payload": {"version": "v0.1.0"},
+			"environment": "production",
+			"original_environment": "staging",
+			"description": "Deploy request from hubot",
+			"creator": {
+				"login": "octocat",
+				"id": 1,
+				"type": "User"
+			},
+			"transient_environment": false,
+			"production_environment": true,
+			"url": "https://api.github.com/repos/octocat/Hello-World/deployments/1",
+			"statuses_url": "https://api.github.com/repos/octocat/Hello-World/deployments/1/statuses",
+			"created_at": "2020-10-20T19:59:59Z",
+			"updated_at": "2020-10-20T19:59:59Z"
+		}
+	]`
+
+	deploymentStatusBody = `{
+		"id": 1,
+		"state": "success",
+		"creator": {
+			"login": "octocat",
+			"id": 1,
+			"type": "User"
+		},
+		"description": "Deployment finished successfully.",
+		"environment": "production",
+		"target_url": "https://example.com/deployment/42/output",
+		"log_url": "https://example.com/deployment/42/output",
+		"environment_url": "https://app.example.com",
+		"deployment_url": "https://api.github.com/repos/octocat/Hello-World/deployments/1",
+		"repository_url": "https://api.github.com/repos/octocat/Hello-World",
+		"url": "https://api.github.com/repos/octocat/Hello-World/deployments/1/statuses/1",
+		"created_at": "2020-10-20T20:00:00Z",
+		"updated_at": "2020-10-20T20:00:00Z"
+	}`
+
+	deploymentStatusesBody = `[
+		{
+			"id": 1,
+			"state": "success",
+			"creator": {
+				"login": "octocat",
+				"id": 1,
+				"type": "User"
+			},
+			"description": "Deployment finished successfully.",
+			"environment": "production",
+			"target_url": "https://example.com/deployment/42/output",
+			"log_url": "https://example.com/deployment/42/output",
+			"environment_url": "https://app.example.com",
+			"deployment_url": "https://api.github.com/repos/octocat/Hello-World/deployments/1",
+			"repository_url": "https://api.github.com/repos/octocat/Hello-World",
+			"url": "https://api.github.com/repos/octocat/Hello-World/deployments/1/statuses/1",
+			"created_at": "2020-10-20T20:00:00Z",
+			"updated_at": "2020-10-20T20:00:00Z"
+		}
+	]`
+
+	environmentBody = `{
+		"id": 161088068,
+		"name": "production",
+		"url": "https://api.github.com/repos/octocat/Hello-World/environments/production",
+		"html_url": "https://github.com/octocat/Hello-World/deployments/activity_log?environments_filter=production",
+		"protection_rules": [
+			{
+				"id": 3736,
+				"type": "wait_timer",
+				"wait_timer": 30
+			},
+			{
+				"id": 3755,
+				"type": "required_reviewers",
+				"prevent_self_review": true,
+				"reviewers": [
+					{
+						"type": "User",
+						"reviewer": {
+							"login": "octocat",
+							"id": 1,
+							"type": "User"
+						}
+					},
+					{
+						"type": "Team",
+						"reviewer": {
+							"id": 1,
+							"name": "Justice League",
+							"slug": "justice-league"
+						}
+					}
+				]
+			},
+			{
+				"id": 3756,
+				"type": "branch_policy"
+			}
+		],
+		"deployment_branch_policy": {
+			"protected_branches": false,
+			"custom_branch_policies": true
+		},
+		"created_at": "2020-11-23T22:00:40Z",
+		"updated_at": "2020-11-23T22:00:40Z"
+	}`
+
+	environmentsBody = `{
+		"total_count": 1,
+		"environments": [
+			{
+				"id": 161088068,
+				"name": "production",
+				"url": "https://api.github.com/repos/octocat/Hello-World/environments/production",
+				"html_url": "https://github.com/octocat/Hello-World/deployments/activity_log?environments_filter=production",
+				"protection_rules": [
+					{
+						"id": 3736,
+						"type": "wait_timer",
+						"wait_timer": 30
+					},
+					{
+						"id": 3755,
+						"type": "required_reviewers",
+						"prevent_self_review": true,
+						"reviewers": [
+							{
+								"type": "User",
+								"reviewer": {
+									"login": "octocat",
+									"id": 1,
+									"type": "User"
+								}
+							},
+							{
+								"type": "Team",
+								"reviewer": {
+									"id": 1,
+									"name": "Justice League",
+									"slug": "justice-league"
+								}
+							}
+						]
+					},
+					{
+						"id": 3756,
+						"type": "branch_policy"
+					}
+				],
+				"deployment_branch_policy": {
+					"protected_branches": false,
+					"custom_branch_policies": true
+				},
+				"created_at": "2020-11-23T22:00:40Z",
+				"updated_at": "2020-11-23T22:00:40Z"
+			}
+		]
+	}`
+
+	pendingDeploymentsBody = `[
+		{
+			"environment": {
+				"id": 161088068,
+				"name": "production",
+				"url": "https://api.github.com/repos/octocat/Hello-World/environments/production",
+				"html_url": "https://github.com/octocat/Hello-World/deployments/activity_log?environments_filter=production"
+			},
+			"wait_timer": 30,
+			"wait_timer_started_at": "2020-11-23T22:00:40Z",
+			"current_user_can_approve": true,
+			"reviewers": [
+				{
+					"type": "User",
+					"reviewer": {
+						"login": "octocat",
+						"id": 1,
+						"type": "User"
+					}
+				}
+			]
+		}
+	]`
+)
+
+var (
+	deployment = Deployment{
+		ID:                  1,
+		SHA:                 "a84d88e7554fc1fa21bcbc4efae3c782a70d2b9d",
+		Ref:                 "topic-branch",
+		Task:                "deploy",
+		Payload:             json.RawMessage(`{"version": "v0.1.0"}`),
+		Environment:         "production",
+		OriginalEnvironment: "staging",
+		Description:         "Deploy request from hubot",
+		Creator: User{
+			ID:    1,
+			Login: "octocat",
+			Type:  "User",
+		},
+		TransientEnvironment:  false,
+		ProductionEnvironment: true,
+		URL:                   "https://api.github.com/repos/octocat/Hello-World/deployments/1",
+		StatusesURL:           "https://api.github.com/repos/octocat/Hello-World/deployments/1/statuses",
+		CreatedAt:             parseGitHubTime("2020-10-20T19:59:59Z"),
+		UpdatedAt:             parseGitHubTime("2020-10-20T19:59:59Z"),
+	}
+
+	deploymentStatus = DeploymentStatus{
+		ID:    1,
+		State: DeploymentStateSuccess,
+		Creator: User{
+			ID:    1,
+			Login: "octocat",
+			Type:  "User",
+		},
+		Description:    "Deployment finished successfully.",
+		Environment:    "production",
+		TargetURL:      "https://example.com/deployment/42/output",
+		LogURL:         "https://example.com/deployment/42/output",
+		EnvironmentURL: "https://app.example.com",
+		DeploymentURL:  "https://api.github.com/repos/octocat/Hello-World/deployments/1",
+		RepositoryURL:  "https://api.github.com/repos/octocat/Hello-World",
+		URL:            "https://api.github.com/repos/octocat/Hello-World/deployments/1/statuses/1",
+		CreatedAt:      parseGitHubTime("2020-10-20T20:00:00Z"),
+		UpdatedAt:      parseGitHubTime("2020-10-20T20:00:00Z"),
+	}
+
+	userReviewer = EnvironmentReviewer{
+		Type: "User",
+		User: &User{
+			ID:    1,
+			Login: "octocat",
+			Type:  "User",
+		},
+	}
+
+	teamReviewer = EnvironmentReviewer{
+		Type: "Team",
+		Team: &Team{
+			ID:   1,
+			Name: "Justice League",
+			Slug: "justice-league",
+		},
+	}
+
+	environment = Environment{
+		ID:      161088068,
+		Name:    "production",
+		URL:     "https://api.github.com/repos/octocat/Hello-World/environments/production",
+		HTMLURL: "https://github.com/octocat/Hello-World/deployments/activity_log?environments_filter=production",
+		ProtectionRules: []ProtectionRule{
+			{
+				ID:        3736,
+				Type:      "wait_timer",
+				WaitTimer: 30,
+			},
+			{
+				ID:                3755,
+				Type:              "required_reviewers",
+				PreventSelfReview: true,
+				Reviewers:         []EnvironmentReviewer{userReviewer, teamReviewer},
+			},
+			{
+				ID:   3756,
+				Type: "branch_policy",
+			},
+		},
+		DeploymentBranchPolicy: &DeploymentBranchPolicy{
+			ProtectedBranches:    false,
+			CustomBranchPolicies: true,
+		},
+		CreatedAt: parseGitHubTime("2020-11-23T22:00:40Z"),
+		UpdatedAt: parseGitHubTime("2020-11-23T22:00:40Z"),
+	}
+
+	pendingDeployment = PendingDeployment{
+		Environment: Environment{
+			ID:      161088068,
+			Name:    "production",
+			URL:     "https://api.github.com/repos/octocat/Hello-World/environments/production",
+			HTMLURL: "https://github.com/octocat/Hello-World/deployments/activity_log?environments_filter=production",
+		},
+		WaitTimer:             30,
+		WaitTimerStartedAt:    parseGitHubTimePtr("2020-11-23T22:00:40Z"),
+		CurrentUserCanApprove: true,
+		Reviewers:             []EnvironmentReviewer{userReviewer},
+	}
+)
+
+func TestEnvironmentReviewer_UnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		name             string
+		data             string
+		expectedReviewer EnvironmentReviewer
+		expectedError    string
+	}{
+		{
+			name:          "InvalidJSON",
+			data:          `{`,
+			expectedError: "unexpected end of JSON input",
+		},
+		{
+			name:          "InvalidReviewer",
+			data:          `{"type": "User", "reviewer": {"id": "1"}}`,
+			expectedError: "json: cannot unmarshal string into Go struct field User.id of type int",
+		},
+		{
+			name:             "User",
+			data:             `{"type": "User", "reviewer": {"login": "octocat", "id": 1, "type": "User"}}`,
+			expectedReviewer: userReviewer,
+		},
+		{
+			name:             "Team",
+			data:             `{"type": "Team", "reviewer": {"id": 1, "name": "Justice League", "slug": "justice-league"}}`,
+			expectedReviewer: teamReviewer,
+		},
+		{
+			name: "Unknown",
+			data: `{"type": "App", "reviewer": {"id": 1}}`,
+			expectedReviewer: EnvironmentReviewer{
+				Type: "App",
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var reviewer EnvironmentReviewer
+			err := json.Unmarshal([]byte(tc.data), &reviewer)
+
+			if tc.expectedError != "" {
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedReviewer, reviewer)
+			}
+		})
+	}
+}
+
+func TestDeploymentService_List(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	tests := []struct {
+		name                string
+		mockResponses       []MockResponse
+		s                   *DeploymentService
+		ctx                 context.Context
+		pageSize            int
+		pageNo              int
+		filter              DeploymentsFilter
+		expectedDeployments []Deployment
+		expectedResponse    *Response
+		expectedError       string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &DeploymentService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:      nil,
+			pageSize: 10,
+			pageNo:   1,
+			filter: DeploymentsFilter{
+				Ref:         "topic-branch",
+				Environment: "production",
+			},
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/deployments", 401, http.Header{}, `{
+					"message": "Bad credentials"
+				}`},
+			},
+			s: &DeploymentService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:      context.Background(),
+			pageSize: 10,
+			pageNo:   1,
+			filter: DeploymentsFilter{
+				Ref:         "topic-branch",
+				Environment: "production",
+			},
+			expectedError: `GET /repos/octocat/Hello-World/deployments: 401 Bad credentials`,
+		},
+		{
+			name: "InvalidResponse",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/deployments", 200, http.Header{}, `{`},
+			},
+			s: &DeploymentService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:      context.Background(),
+			pageSize: 10,
+			pageNo:   1,
+			filter: DeploymentsFilter{
+				Ref:         "topic-branch",
+				Environment: "production",
+			},
+			expectedError: `unexpected EOF`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/deployments", 200, header, deploymentsBody},
+			},
+			s: &DeploymentService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:      context.Background(),
+			pageSize: 10,
+			pageNo:   1,
+			filter: DeploymentsFilter{
+				Ref:         "topic-branch",
+				Environment: "production",
+			},
+			expectedDeployments: []Deployment{deployment},
+			expectedResponse: &Response{
+				Pages: expectedPages,
+				Rate:  expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			defer ts.Close()
+
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			deployments, resp, err := tc.s.List(tc.ctx, tc.pageSize, tc.pageNo, tc.filter)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, deployments)
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedDeployments, deployments)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Pages, resp.Pages)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestDeploymentService_Get(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	tests := []struct {
+		name               string
+		mockResponses      []MockResponse
+		s                  *DeploymentService
+		ctx                context.Context
+		id                 int
+		expectedDeployment *Deployment
+		expectedResponse   *Response
+		expectedError      string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &DeploymentService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           nil,
+			id:            1,
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/deployments/1", 401, http.Header{}, `{
+					"message": "Bad credentials"
+				}`},
+			},
+			s: &DeploymentService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			id:            1,
+			expectedError: `GET /repos/octocat/Hello-World/deployments/1: 401 Bad credentials`,
+		},
+		{
+			name: "InvalidResponse",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/deployments/1", 200, http.Header{}, `{`},
+			},
+			s: &DeploymentService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			id:            1,
+			expectedError: `unexpected EOF`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/deployments/1", 200, header, deploymentBody},
+			},
+			s: &DeploymentService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:                context.Background(),
+			id:                 1,
+			expectedDeployment: &deployment,
+			expectedResponse: &Response{
+				Rate: expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			defer ts.Close()
+
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			deployment, resp, err := tc.s.Get(tc.ctx, tc.id)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, deployment)
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedDeployment, deployment)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestDeploymentService_Create(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	params := DeploymentParams{
+		Ref:              "topic-branch",
+		Task:             "deploy",
+		RequiredContexts: []string{},
+		Payload: map[string]string{
+			"version": "v0.1.0",
+		},
+		Environment: "production",
+		Description: "Deploy request from hubot",
+	}
+
+	tests := []struct {
+		name               string
+		mockResponses      []MockResponse
+		s                  *DeploymentService
+		ctx                context.Context
+		params             DeploymentParams
+		expectedDeployment *Deployment
+		expectedResponse   *Response
+		expectedError      string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &DeploymentService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           nil,
+			params:        params,
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"POST", "/repos/octocat/Hello-World/deployments", 401, http.Header{}, `{
+					"message": "Bad credentials"
+				}`},
+			},
+			s: &DeploymentService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			params:        params,
+			expectedError: `POST /repos/octocat/Hello-World/deployments: 401 Bad credentials`,
+		},
+		{
+			name: "InvalidResponse",
+			mockResponses: []MockResponse{
+				{"POST", "/repos/octocat/Hello-World/deployments", 201, http.Header{}, `{`},
+			},
+			s: &DeploymentService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			params:        params,
+			expectedError: `unexpected EOF`,
+		},
+		{
+			name: "AutoMerged",
+			mockResponses: []MockResponse{
+				{"POST", "/repos/octocat/Hello-World/deployments", 202, header, `{
+					"message": "Auto-merged master into topic-branch on deployment."
+				}`},
+			},
+			s: &DeploymentService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:                context.Background(),
+			params:             params,
+			expectedDeployment: nil,
+			expectedResponse: &Response{
+				Rate: expectedRate,
+			},
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"POST", "/repos/octocat/Hello-World/deployments", 201, header, deploymentBody},
+			},
+			s: &DeploymentService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:                context.Background(),
+			params:             params,
+			expectedDeployment: &deployment,
+			expectedResponse: &Response{
+				Rate: expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			defer ts.Close()
+
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			deployment, resp, err := tc.s.Create(tc.ctx, tc.params)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, deployment)
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else if assert.NoError(t, err) {
+				assert.Equal(t, tc.expectedDeployment, deployment)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestDeploymentService_Delete(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	tests := []struct {
+		name             string
+		mockResponses    []MockResponse
+		s                *DeploymentService
+		ctx              context.Context
+		id               int
+		expectedResponse *Response
+		expectedError    string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &DeploymentService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           nil,
+			id:            1,
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"DELETE", "/repos/octocat/Hello-World/deployments/1", 401, http.Header{}, `{
+					"message": "Bad credentials"
+				}`},
+			},
+			s: &DeploymentService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			id:            1,
+			expectedError: `DELETE /repos/octocat/Hello-World/deployments/1: 401 Bad credentials`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"DELETE", "/repos/octocat/Hello-World/deployments/1", 204, header, ``},
+			},
+			s: &DeploymentService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx: context.Background(),
+			id:  1,
+			expectedResponse: &Response{
+				Rate: expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			defer ts.Close()
+
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			resp, err := tc.s.Delete(tc.ctx, tc.id)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestDeploymentService_Statuses(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	tests := []struct {
+		name             string
+		mockResponses    []MockResponse
+		s                *DeploymentService
+		ctx              context.Context
+		id               int
+		pageSize         int
+		pageNo           int
+		expectedStatuses []DeploymentStatus
+		expectedResponse *Response
+		expectedError    string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &DeploymentService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           nil,
+			id:            1,
+			pageSize:      10,
+			pageNo:        1,
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/deployments/1/statuses", 401, http.Header{}, `{
+					"message": "Bad credentials"
+				}`},
+			},
+			s: &DeploymentService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			id:            1,
+			pageSize:      10,
+			pageNo:        1,
+			expectedError: `GET /repos/octocat/Hello-World/deployments/1/statuses: 401 Bad credentials`,
+		},
+		{
+			name: "InvalidResponse",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/deployments/1/statuses", 200, http.Header{}, `{`},
+			},
+			s: &DeploymentService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			id:            1,
+			pageSize:      10,
+			pageNo:        1,
+			expectedError: `unexpected EOF`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/deployments/1/statuses", 200, header, deploymentStatusesBody},
+			},
+			s: &DeploymentService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:              context.Background(),
+			id:               1,
+			pageSize:         10,
+			pageNo:           1,
+			expectedStatuses: []DeploymentStatus{deploymentStatus},
+			expectedResponse: &Response{
+				Pages: expectedPages,
+				Rate:  expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			defer ts.Close()
+
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			statuses, resp, err := tc.s.Statuses(tc.ctx, tc.id, tc.pageSize, tc.pageNo)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, statuses)
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedStatuses, statuses)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Pages, resp.Pages)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestDeploymentService_CreateStatus(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	tests := []struct {
+		name             string
+		mockResponses    []MockResponse
+		s                *DeploymentService
+		ctx              context.Context
+		id               int
+		params           DeploymentStatusParams
+		expectedStatus   *DeploymentStatus
+		expectedResponse *Response
+		expectedError    string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &DeploymentService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx: nil,
+			id:  1,
+			params: DeploymentStatusParams{
+				State:          DeploymentStateSuccess,
+				LogURL:         "https://example.com/deployment/42/output",
+				EnvironmentURL: "https://app.example.com",
+			},
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"POST", "/repos/octocat/Hello-World/deployments/1/statuses", 401, http.Header{}, `{
+					"message": "Bad credentials"
+				}`},
+			},
+			s: &DeploymentService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx: context.Background(),
+			id:  1,
+			params: DeploymentStatusParams{
+				State:          DeploymentStateSuccess,
+				LogURL:         "https://example.com/deployment/42/output",
+				EnvironmentURL: "https://app.example.com",
+			},
+			expectedError: `POST /repos/octocat/Hello-World/deployments/1/statuses: 401 Bad credentials`,
+		},
+		{
+			name: "InvalidResponse",
+			mockResponses: []MockResponse{
+				{"POST", "/repos/octocat/Hello-World/deployments/1/statuses", 201, http.Header{}, `{`},
+			},
+			s: &DeploymentService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx: context.Background(),
+			id:  1,
+			params: DeploymentStatusParams{
+				State:          DeploymentStateSuccess,
+				LogURL:         "https://example.com/deployment/42/output",
+				EnvironmentURL: "https://app.example.com",
+			},
+			expectedError: `unexpected EOF`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"POST", "/repos/octocat/Hello-World/deployments/1/statuses", 201, header, deploymentStatusBody},
+			},
+			s: &DeploymentService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx: context.Background(),
+			id:  1,
+			params: DeploymentStatusParams{
+				State:          DeploymentStateSuccess,
+				LogURL:         "https://example.com/deployment/42/output",
+				EnvironmentURL: "https://app.example.com",
+			},
+			expectedStatus: &deploymentStatus,
+			expectedResponse: &Response{
+				Rate: expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			defer ts.Close()
+
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			status, resp, err := tc.s.CreateStatus(tc.ctx, tc.id, tc.params)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, status)
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedStatus, status)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestDeploymentService_Environments(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	tests := []struct {
+		name                 string
+		mockResponses        []MockResponse
+		s                    *DeploymentService
+		ctx                  context.Context
+		pageSize             int
+		pageNo               int
+		expectedEnvironments []Environment
+		expectedResponse     *Response
+		expectedError        string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &DeploymentService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           nil,
+			pageSize:      10,
+			pageNo:        1,
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/environments", 401, http.Header{}, `{
+					"message": "Bad credentials"
+				}`},
+			},
+			s: &DeploymentService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			pageSize:      10,
+			pageNo:        1,
+			expectedError: `GET /repos/octocat/Hello-World/environments: 401 Bad credentials`,
+		},
+		{
+			name: "InvalidResponse",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/environments", 200, http.Header{}, `{`},
+			},
+			s: &DeploymentService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			pageSize:      10,
+			pageNo:        1,
+			expectedError: `unexpected EOF`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/environments", 200, header, environmentsBody},
+			},
+			s: &DeploymentService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:                  context.Background(),
+			pageSize:             10,
+			pageNo:               1,
+			expectedEnvironments: []Environment{environment},
+			expectedResponse: &Response{
+				Pages: expectedPages,
+				Rate:  expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			defer ts.Close()
+
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			environments, resp, err := tc.s.Environments(tc.ctx, tc.pageSize, tc.pageNo)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, environments)
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedEnvironments, environments)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Pages, resp.Pages)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestDeploymentService_Environment(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	tests := []struct {
+		name                string
+		mockResponses       []MockResponse
+		s                   *DeploymentService
+		ctx                 context.Context
+		envName             string
+		expectedEnvironment *Environment
+		expectedResponse    *Response
+		expectedError       string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &DeploymentService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           nil,
+			envName:       "production",
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/environments/production", 401, http.Header{}, `{
+					"message": "Bad credentials"
+				}`},
+			},
+			s: &DeploymentService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			envName:       "production",
+			expectedError: `GET /repos/octocat/Hello-World/environments/production: 401 Bad credentials`,
+		},
+		{
+			name: "InvalidResponse",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/environments/production", 200, http.Header{}, `{`},
+			},
+			s: &DeploymentService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			envName:       "production",
+			expectedError: `unexpected EOF`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/environments/production", 200, header, environmentBody},
+			},
+			s: &DeploymentService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:                 context.Background(),
+			envName:             "production",
+			expectedEnvironment: &environment,
+			expectedResponse: &Response{
+				Rate: expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			defer ts.Close()
+
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			environment, resp, err := tc.s.Environment(tc.ctx, tc.envName)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, environment)
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedEnvironment, environment)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestDeploymentService_UpdateEnvironment(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	waitTimer := 30
+	preventSelfReview := true
+
+	params := EnvironmentParams{
+		WaitTimer:         &waitTimer,
+		PreventSelfReview: &preventSelfReview,
+		Reviewers: []ReviewerParams{
+			{Type: "User", ID: 1},
+			{Type: "Team", ID: 1},
+		},
+		DeploymentBranchPolicy: &DeploymentBranchPolicy{
+			CustomBranchPolicies: true,
+		},
+	}
+
+	tests := []struct {
+		name                string
+		mockResponses       []MockResponse
+		s                   *DeploymentService
+		ctx                 context.Context
+		envName             string
+		params              EnvironmentParams
+		expectedEnvironment *Environment
+		expectedResponse    *Response
+		expectedError       string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &DeploymentService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           nil,
+			envName:       "production",
+			params:        params,
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"PUT", "/repos/octocat/Hello-World/environments/production", 401, http.Header{}, `{
+					"message": "Bad credentials"
+				}`},
+			},
+			s: &DeploymentService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			envName:       "production",
+			params:        params,
+			expectedError: `PUT /repos/octocat/Hello-World/environments/production: 401 Bad credentials`,
+		},
+		{
+			name: "InvalidResponse",
+			mockResponses: []MockResponse{
+				{"PUT", "/repos/octocat/Hello-World/environments/production", 200, http.Header{}, `{`},
+			},
+			s: &DeploymentService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			envName:       "production",
+			params:        params,
+			expectedError: `unexpected EOF`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"PUT", "/repos/octocat/Hello-World/environments/production", 200, header, environmentBody},
+			},
+			s: &DeploymentService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:                 context.Background(),
+			envName:             "production",
+			params:              params,
+			expectedEnvironment: &environment,
+			expectedResponse: &Response{
+				Rate: expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			defer ts.Close()
+
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			environment, resp, err := tc.s.UpdateEnvironment(tc.ctx, tc.envName, tc.params)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, environment)
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedEnvironment, environment)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestDeploymentService_DeleteEnvironment(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	tests := []struct {
+		name             string
+		mockResponses    []MockResponse
+		s                *DeploymentService
+		ctx              context.Context
+		envName          string
+		expectedResponse *Response
+		expectedError    string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &DeploymentService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           nil,
+			envName:       "production",
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"DELETE", "/repos/octocat/Hello-World/environments/production", 401, http.Header{}, `{
+					"message": "Bad credentials"
+				}`},
+			},
+			s: &DeploymentService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			envName:       "production",
+			expectedError: `DELETE /repos/octocat/Hello-World/environments/production: 401 Bad credentials`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"DELETE", "/repos/octocat/Hello-World/environments/production", 204, header, ``},
+			},
+			s: &DeploymentService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:     context.Background(),
+			envName: "production",
+			expectedResponse: &Response{
+				Rate: expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			defer ts.Close()
+
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			resp, err := tc.s.DeleteEnvironment(tc.ctx, tc.envName)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestDeploymentService_PendingDeployments(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	tests := []struct {
+		name             string
+		mockResponses    []MockResponse
+		s                *DeploymentService
+		ctx              context.Context
+		runID            int
+		expectedPending  []PendingDeployment
+		expectedResponse *Response
+		expectedError    string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &DeploymentService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           nil,
+			runID:         30433642,
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/actions/runs/30433642/pending_deployments", 401, http.Header{}, `{
+					"message": "Bad credentials"
+				}`},
+			},
+			s: &DeploymentService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			runID:         30433642,
+			expectedError: `GET /repos/octocat/Hello-World/actions/runs/30433642/pending_deployments: 401 Bad credentials`,
+		},
+		{
+			name: "InvalidResponse",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/actions/runs/30433642/pending_deployments", 200, http.Header{}, `{`},
+			},
+			s: &DeploymentService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			runID:         30433642,
+			expectedError: `unexpected EOF`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/actions/runs/30433642/pending_deployments", 200, header, pendingDeploymentsBody},
+			},
+			s: &DeploymentService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:             context.Background(),
+			runID:           30433642,
+			expectedPending: []PendingDeployment{pendingDeployment},
+			expectedResponse: &Response{
+				Rate: expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			defer ts.Close()
+
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			pending, resp, err := tc.s.PendingDeployments(tc.ctx, tc.runID)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, pending)
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedPending, pending)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestDeploymentService_ApprovePendingDeployments(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	tests := []struct {
+		name                string
+		mockResponses       []MockResponse
+		s                   *DeploymentService
+		ctx                 context.Context
+		runID               int
+		environmentIDs      []int
+		comment             string
+		expectedDeployments []Deployment
+		expectedResponse    *Response
+		expectedError       string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &DeploymentService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:            nil,
+			runID:          30433642,
+			environmentIDs: []int{161088068},
+			comment:        "Ship it!",
+			expectedError:  `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"POST", "/repos/octocat/Hello-World/actions/runs/30433642/pending_deployments", 401, http.Header{}, `{
+					"message": "Bad credentials"
+				}`},
+			},
+			s: &DeploymentService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:            context.Background(),
+			runID:          30433642,
+			environmentIDs: []int{161088068},
+			comment:        "Ship it!",
+			expectedError:  `POST /repos/octocat/Hello-World/actions/runs/30433642/pending_deployments: 401 Bad credentials`,
+		},
+		{
+			name: "InvalidResponse",
+			mockResponses: []MockResponse{
+				{"POST", "/repos/octocat/Hello-World/actions/runs/30433642/pending_deployments", 200, http.Header{}, `{`},
+			},
+			s: &DeploymentService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:            context.Background(),
+			runID:          30433642,
+			environmentIDs: []int{161088068},
+			comment:        "Ship it!",
+			expectedError:  `unexpected EOF`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"POST", "/repos/octocat/Hello-World/actions/runs/30433642/pending_deployments", 200, header, deploymentsBody},
+			},
+			s: &DeploymentService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:                 context.Background(),
+			runID:               30433642,
+			environmentIDs:      []int{161088068},
+			comment:             "Ship it!",
+			expectedDeployments: []Deployment{deployment},
+			expectedResponse: &Response{
+				Rate: expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			defer ts.Close()
+
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			deployments, resp, err := tc.s.ApprovePendingDeployments(tc.ctx, tc.runID, tc.environmentIDs, tc.comment)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, deployments)
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedDeployments, deployments)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestDeploymentService_RejectPendingDeployments(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	tests := []struct {
+		name                string
+		mockResponses       []MockResponse
+		s                   *DeploymentService
+		ctx                 context.Context
+		runID               int
+		environmentIDs      []int
+		comment             string
+		expectedDeployments []Deployment
+		expectedResponse    *Response
+		expectedError       string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &DeploymentService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:            nil,
+			runID:          30433642,
+			environmentIDs: []int{161088068},
+			comment:        "Ship it!",
+			expectedError:  `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"POST", "/repos/octocat/Hello-World/actions/runs/30433642/pending_deployments", 401, http.Header{}, `{
+					"message": "Bad credentials"
+				}`},
+			},
+			s: &DeploymentService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:            context.Background(),
+			runID:          30433642,
+			environmentIDs: []int{161088068},
+			comment:        "Ship it!",
+			expectedError:  `POST /repos/octocat/Hello-World/actions/runs/30433642/pending_deployments: 401 Bad credentials`,
+		},
+		{
+			name: "InvalidResponse",
+			mockResponses: []MockResponse{
+				{"POST", "/repos/octocat/Hello-World/actions/runs/30433642/pending_deployments", 200, http.Header{}, `{`},
+			},
+			s: &DeploymentService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:            context.Background(),
+			runID:          30433642,
+			environmentIDs: []int{161088068},
+			comment:        "Ship it!",
+			expectedError:  `unexpected EOF`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"POST", "/repos/octocat/Hello-World/actions/runs/30433642/pending_deployments", 200, header, deploymentsBody},
+			},
+			s: &DeploymentService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:                 context.Background(),
+			runID:               30433642,
+			environmentIDs:      []int{161088068},
+			comment:             "Ship it!",
+			expectedDeployments: []Deployment{deployment},
+			expectedResponse: &Response{
+				Rate: expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			defer ts.Close()
+
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			deployments, resp, err := tc.s.RejectPendingDeployments(tc.ctx, tc.runID, tc.environmentIDs, tc.comment)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, deployments)
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedDeployments, deployments)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
@@ -39,6 +39,22 @@ func ExampleRepoService_Commits() {
 	}
 }
 
+func ExampleRepoService_CommitsWithFilter() {
+	client := github.NewClient("")
+	commits, resp, err := client.Repo("octocat", "Hello-World").CommitsWithFilter(context.Background(), 50, 1, github.CommitsFilter{
+		Path: "docs",
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Printf("Pages: %+v\n", resp.Pages)
+	fmt.Printf("Rate: %+v\n\n", resp.Rate)
+	for _, c := range commits {
+		fmt.Printf("SHA: %s\n", c.SHA)
+	}
+}
+
 func ExampleIssueService_List() {
 	client := github.NewClient("")
 	issues, resp, err := client.Repo("octocat", "Hello-World").Issues.List(context.Background(), 50, 1, github.IssuesFilter{})
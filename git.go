@@ -0,0 +1,584 @@
+package github
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+)
+
+// GitService provides GitHub APIs for the Git database of a repository.
+// See https://docs.github.com/en/rest/git
+type GitService struct {
+	client      *Client
+	owner, repo string
+}
+
+// TreeMode is the file mode of a Git tree entry.
+type TreeMode string
+
+const (
+	// TreeModeFile is the mode of a regular file.
+	TreeModeFile TreeMode = "100644"
+	// TreeModeExecutable is the mode of an executable file.
+	TreeModeExecutable TreeMode = "100755"
+	// TreeModeDir is the mode of a subdirectory (tree).
+	TreeModeDir TreeMode = "040000"
+	// TreeModeSubmodule is the mode of a submodule (commit).
+	TreeModeSubmodule TreeMode = "160000"
+	// TreeModeSymlink is the mode of a symbolic link.
+	TreeModeSymlink TreeMode = "120000"
+)
+
+type (
+	// Blob is a Git blob object.
+	Blob struct {
+		SHA      string `json:"sha"`
+		Size     int    `json:"size"`
+		Encoding string `json:"encoding"`
+		Content  string `json:"content"`
+		URL      string `json:"url"`
+
+		// Data is the decoded content of the blob.
+		Data []byte `json:"-"`
+	}
+
+	// TreeEntry is an entry in a Git tree object.
+	TreeEntry struct {
+		Path string   `json:"path"`
+		Mode TreeMode `json:"mode"`
+		Type string   `json:"type"` // Either blob, tree, or commit
+		Size int      `json:"size"`
+		SHA  string   `json:"sha"`
+		URL  string   `json:"url"`
+	}
+
+	// Tree is a Git tree object.
+	// If Truncated is true, the number of entries exceeded the maximum limit.
+	Tree struct {
+		SHA       string      `json:"sha"`
+		URL       string      `json:"url"`
+		Truncated bool        `json:"truncated"`
+		Entries   []TreeEntry `json:"tree"`
+	}
+
+	// TreeEntryParams is used for adding, updating, or deleting an entry when creating a Git tree.
+	// Either SHA or Content should be set, unless Delete is true.
+	// If Mode or Type are empty, a regular file (blob) is assumed.
+	TreeEntryParams struct {
+		Path    string
+		Mode    TreeMode
+		Type    string
+		SHA     string
+		Content string
+		Delete  bool
+	}
+
+	// CreateCommitParams is used for creating a Git commit.
+	// If Author or Committer is nil, the authenticated user will be used.
+	CreateCommitParams struct {
+		Message   string
+		Tree      string
+		Parents   []string
+		Author    *Signature
+		Committer *Signature
+	}
+
+	// RefObject is the object a Git reference points to.
+	RefObject struct {
+		Type string `json:"type"` // Either commit or tag
+		SHA  string `json:"sha"`
+		URL  string `json:"url"`
+	}
+
+	// Reference is a Git reference object.
+	Reference struct {
+		Ref    string    `json:"ref"`
+		URL    string    `json:"url"`
+		Object RefObject `json:"object"`
+	}
+
+	// GitTag is a Git annotated tag object.
+	GitTag struct {
+		SHA          string       `json:"sha"`
+		Tag          string       `json:"tag"`
+		Message      string       `json:"message"`
+		Tagger       Signature    `json:"tagger"`
+		Object       RefObject    `json:"object"`
+		Verification Verification `json:"verification"`
+		URL          string       `json:"url"`
+	}
+
+	// CreateTagParams is used for creating a Git annotated tag.
+	// If Type is empty, the object is assumed to be a commit.
+	// If Tagger is nil, the authenticated user will be used.
+	CreateTagParams struct {
+		Tag     string
+		Message string
+		Object  string
+		Type    string // Either commit, tree, or blob
+		Tagger  *Signature
+	}
+)
+
+// MarshalJSON implements the json.Marshaler interface.
+// A deleted entry is encoded with a null SHA.
+func (e TreeEntryParams) MarshalJSON() ([]byte, error) {
+	mode, typ := e.Mode, e.Type
+	if mode == "" {
+		mode = TreeModeFile
+	}
+	if typ == "" {
+		typ = "blob"
+	}
+
+	entry := map[string]interface{}{
+		"path": e.Path,
+		"mode": mode,
+		"type": typ,
+	}
+
+	switch {
+	case e.Delete:
+		entry["sha"] = nil
+	case e.SHA != "":
+		entry["sha"] = e.SHA
+	default:
+		entry["content"] = e.Content
+	}
+
+	return json.Marshal(entry)
+}
+
+// Blob retrieves a Git blob by its SHA.
+// The content of the blob is decoded and returned in the Data field.
+// See https://docs.github.com/en/rest/git/blobs#get-a-blob
+func (s *GitService) Blob(ctx context.Context, sha string) (*Blob, *Response, error) {
+	url := fmt.Sprintf("/repos/%s/%s/git/blobs/%s", s.owner, s.repo, sha)
+	req, err := s.client.NewRequest(ctx, "GET", url, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	blob := new(Blob)
+
+	resp, err := s.client.Do(req, blob)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if blob.Data, err = decodeContent(blob.Encoding, blob.Content); err != nil {
+		return nil, nil, err
+	}
+
+	return blob, resp, nil
+}
+
+// CreateBlob creates a new Git blob.
+// The content is base64-encoded, so it can be binary.
+// See https://docs.github.com/en/rest/git/blobs#create-a-blob
+func (s *GitService) CreateBlob(ctx context.Context, content []byte) (*Hash, *Response, error) {
+	url := fmt.Sprintf("/repos/%s/%s/git/blobs", s.owner, s.repo)
+	body := struct {
+		Content  string `json:"content"`
+		Encoding string `json:"encoding"`
+	}{
+		Content:  base64.StdEncoding.EncodeToString(content),
+		Encoding: "base64",
+	}
+
+	req, err := s.client.NewRequest(ctx, "POST", url, body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	hash := new(Hash)
+
+	resp, err := s.client.Do(req, hash)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return hash, resp, nil
+}
+
+// Tree retrieves a Git tree by its SHA or a reference.
+// If recursive is true, the entries of all subtrees are returned too.
+// See https://docs.github.com/en/rest/git/trees#get-a-tree
+func (s *GitService) Tree(ctx context.Context, sha string, recursive bool) (*Tree, *Response, error) {
+	url := fmt.Sprintf("/repos/%s/%s/git/trees/%s", s.owner, s.repo, escapePath(sha))
+	req, err := s.client.NewRequest(ctx, "GET", url, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if recursive {
+		q := req.URL.Query()
+		q.Add("recursive", "1")
+		req.URL.RawQuery = q.Encode()
+	}
+
+	tree := new(Tree)
+
+	resp, err := s.client.Do(req, tree)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return tree, resp, nil
+}
+
+// CreateTree creates a new Git tree.
+// If baseTree is not empty, the new tree is created on top of it; otherwise, the entries not specified are deleted.
+// See https://docs.github.com/en/rest/git/trees#create-a-tree
+func (s *GitService) CreateTree(ctx context.Context, baseTree string, entries []TreeEntryParams) (*Tree, *Response, error) {
+	url := fmt.Sprintf("/repos/%s/%s/git/trees", s.owner, s.repo)
+	body := struct {
+		BaseTree string            `json:"base_tree,omitempty"`
+		Tree     []TreeEntryParams `json:"tree"`
+	}{
+		BaseTree: baseTree,
+		Tree:     entries,
+	}
+
+	req, err := s.client.NewRequest(ctx, "POST", url, body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	tree := new(Tree)
+
+	resp, err := s.client.Do(req, tree)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return tree, resp, nil
+}
+
+// Commit retrieves a Git commit by its SHA.
+// See https://docs.github.com/en/rest/git/commits#get-a-commit-object
+func (s *GitService) Commit(ctx context.Context, sha string) (*RawCommit, *Response, error) {
+	url := fmt.Sprintf("/repos/%s/%s/git/commits/%s", s.owner, s.repo, sha)
+	req, err := s.client.NewRequest(ctx, "GET", url, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	commit := new(RawCommit)
+
+	resp, err := s.client.Do(req, commit)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return commit, resp, nil
+}
+
+// CreateCommit creates a new Git commit.
+// See https://docs.github.com/en/rest/git/commits#create-a-commit
+func (s *GitService) CreateCommit(ctx context.Context, params CreateCommitParams) (*RawCommit, *Response, error) {
+	url := fmt.Sprintf("/repos/%s/%s/git/commits", s.owner, s.repo)
+	body := struct {
+		Message   string    `json:"message"`
+		Tree      string    `json:"tree"`
+		Parents   []string  `json:"parents"`
+		Author    *identity `json:"author,omitempty"`
+		Committer *identity `json:"committer,omitempty"`
+	}{
+		Message:   params.Message,
+		Tree:      params.Tree,
+		Parents:   params.Parents,
+		Author:    newIdentity(params.Author),
+		Committer: newIdentity(params.Committer),
+	}
+
+	if body.Parents == nil {
+		body.Parents = []string{}
+	}
+
+	req, err := s.client.NewRequest(ctx, "POST", url, body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	commit := new(RawCommit)
+
+	resp, err := s.client.Do(req, commit)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return commit, resp, nil
+}
+
+// Ref retrieves a Git reference.
+// The reference should be fully qualified without the refs/ prefix (i.e. heads/main or tags/v0.1.0).
+// See https://docs.github.com/en/rest/git/refs#get-a-reference
+func (s *GitService) Ref(ctx context.Context, ref string) (*Reference, *Response, error) {
+	url := fmt.Sprintf("/repos/%s/%s/git/ref/%s", s.owner, s.repo, escapePath(ref))
+	req, err := s.client.NewRequest(ctx, "GET", url, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	reference := new(Reference)
+
+	resp, err := s.client.Do(req, reference)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return reference, resp, nil
+}
+
+// CreateRef creates a new Git reference.
+// The reference should be fully qualified without the refs/ prefix (i.e. heads/main or tags/v0.1.0).
+// See https://docs.github.com/en/rest/git/refs#create-a-reference
+func (s *GitService) CreateRef(ctx context.Context, ref, sha string) (*Reference, *Response, error) {
+	url := fmt.Sprintf("/repos/%s/%s/git/refs", s.owner, s.repo)
+	body := struct {
+		Ref string `json:"ref"`
+		SHA string `json:"sha"`
+	}{
+		Ref: "refs/" + ref,
+		SHA: sha,
+	}
+
+	req, err := s.client.NewRequest(ctx, "POST", url, body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	reference := new(Reference)
+
+	resp, err := s.client.Do(req, reference)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return reference, resp, nil
+}
+
+// UpdateRef updates a Git reference to point to a new SHA.
+// If force is false, the update is rejected unless it is a fast-forward.
+// See https://docs.github.com/en/rest/git/refs#update-a-reference
+func (s *GitService) UpdateRef(ctx context.Context, ref, sha string, force bool) (*Reference, *Response, error) {
+	url := fmt.Sprintf("/repos/%s/%s/git/refs/%s", s.owner, s.repo, escapePath(ref))
+	body := struct {
+		SHA   string `json:"sha"`
+		Force bool   `json:"force"`
+	}{
+		SHA:   sha,
+		Force: force,
+	}
+
+	req, err := s.client.NewRequest(ctx, "PATCH", url, body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	reference := new(Reference)
+
+	resp, err := s.client.Do(req, reference)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return reference, resp, nil
+}
+
+// DeleteRef deletes a Git reference.
+// See https://docs.github.com/en/rest/git/refs#delete-a-reference
+func (s *GitService) DeleteRef(ctx context.Context, ref string) (*Response, error) {
+	url := fmt.Sprintf("/repos/%s/%s/git/refs/%s", s.owner, s.repo, escapePath(ref))
+	req, err := s.client.NewRequest(ctx, "DELETE", url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// MatchingRefs retrieves all Git references starting with a prefix.
+// The prefix should be without the refs/ prefix (i.e. heads/feature- or tags/v1.).
+// See https://docs.github.com/en/rest/git/refs#list-matching-references
+func (s *GitService) MatchingRefs(ctx context.Context, prefix string) ([]Reference, *Response, error) {
+	url := fmt.Sprintf("/repos/%s/%s/git/matching-refs/%s", s.owner, s.repo, escapePath(prefix))
+	req, err := s.client.NewRequest(ctx, "GET", url, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	references := []Reference{}
+
+	resp, err := s.client.Do(req, &references)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return references, resp, nil
+}
+
+// Tag retrieves a Git annotated tag object by its SHA.
+// See https://docs.github.com/en/rest/git/tags#get-a-tag
+func (s *GitService) Tag(ctx context.Context, sha string) (*GitTag, *Response, error) {
+	url := fmt.Sprintf("/repos/%s/%s/git/tags/%s", s.owner, s.repo, sha)
+	req, err := s.client.NewRequest(ctx, "GET", url, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	tag := new(GitTag)
+
+	resp, err := s.client.Do(req, tag)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return tag, resp, nil
+}
+
+// CreateTag creates a new Git annotated tag object.
+// This does not create the tag reference; use CreateAnnotatedTag for creating both.
+// See https://docs.github.com/en/rest/git/tags#create-a-tag-object
+func (s *GitService) CreateTag(ctx context.Context, params CreateTagParams) (*GitTag, *Response, error) {
+	url := fmt.Sprintf("/repos/%s/%s/git/tags", s.owner, s.repo)
+	body := struct {
+		Tag     string    `json:"tag"`
+		Message string    `json:"message"`
+		Object  string    `json:"object"`
+		Type    string    `json:"type"`
+		Tagger  *identity `json:"tagger,omitempty"`
+	}{
+		Tag:     params.Tag,
+		Message: params.Message,
+		Object:  params.Object,
+		Type:    params.Type,
+		Tagger:  newIdentity(params.Tagger),
+	}
+
+	if body.Type == "" {
+		body.Type = "commit"
+	}
+
+	req, err := s.client.NewRequest(ctx, "POST", url, body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	tag := new(GitTag)
+
+	resp, err := s.client.Do(req, tag)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return tag, resp, nil
+}
+
+// CreateAnnotatedTag creates a Git annotated tag object and a tag reference pointing to it.
+func (s *GitService) CreateAnnotatedTag(ctx context.Context, params CreateTagParams) (*GitTag, error) {
+	tag, _, err := s.CreateTag(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, _, err := s.CreateRef(ctx, "tags/"+params.Tag, tag.SHA); err != nil {
+		return nil, err
+	}
+
+	return tag, nil
+}
+
+// Changeset is a set of file changes keyed by the file paths.
+// A nil content deletes the file, otherwise the file is created or replaced.
+type Changeset map[string][]byte
+
+// ErrNotFastForward occurs when a branch cannot be updated because the new commit is not a fast-forward.
+var ErrNotFastForward = errors.New("update is not a fast-forward")
+
+// ApplyChanges applies a changeset to a branch as a single commit.
+// The files are written as regular files (100644).
+// The branch is only fast-forwarded, so if the branch has moved since the changes were applied, ErrNotFastForward is returned.
+// If the changeset is empty, no commit is created and the current head commit of the branch is returned.
+func (s *GitService) ApplyChanges(ctx context.Context, branch, message string, changes Changeset) (*RawCommit, error) {
+	ref := "heads/" + branch
+
+	head, _, err := s.Ref(ctx, ref)
+	if err != nil {
+		return nil, err
+	}
+
+	parent, _, err := s.Commit(ctx, head.Object.SHA)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(changes) == 0 {
+		return parent, nil
+	}
+
+	// Sort paths for a deterministic order of API calls
+	paths := make([]string, 0, len(changes))
+	for path := range changes {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+
+	entries := make([]TreeEntryParams, 0, len(changes))
+	for _, path := range paths {
+		content := changes[path]
+		if content == nil {
+			entries = append(entries, TreeEntryParams{
+				Path:   path,
+				Delete: true,
+			})
+			continue
+		}
+
+		blob, _, err := s.CreateBlob(ctx, content)
+		if err != nil {
+			return nil, err
+		}
+
+		entries = append(entries, TreeEntryParams{
+			Path: path,
+			SHA:  blob.SHA,
+		})
+	}
+
+	tree, _, err := s.CreateTree(ctx, parent.Tree.SHA, entries)
+	if err != nil {
+		return nil, err
+	}
+
+	commit, _, err := s.CreateCommit(ctx, CreateCommitParams{
+		Message: message,
+		Tree:    tree.SHA,
+		Parents: []string{parent.SHA},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if _, _, err := s.UpdateRef(ctx, ref, commit.SHA, false); err != nil {
+		var respErr *ResponseError
+		if errors.As(err, &respErr) && respErr.Response.StatusCode == http.StatusUnprocessableEntity &&
+			strings.Contains(strings.ToLower(respErr.Message), "not a fast forward") {
+			return nil, fmt.Errorf("%s: %w", respErr.Message, ErrNotFastForward)
+		}
+		return nil, err
+	}
+
+	return commit, nil
+}
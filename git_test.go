@@ -0,0 +1,1983 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	blobBody = `{
+		"sha": "3a0f86fb8db8eea7ccbb9a95f325ddbedfb25e15",
+		"size": 12,
+		"encoding": "base64",
+		"content": "SGVsbG8sIFdvcmxkIQ==\n",
+		"url": "https://api.github.com/repos/octocat/Hello-World/git/blobs/3a0f86fb8db8eea7ccbb9a95f325ddbedfb25e15"
+	}`
+
+	blobHashBody = `{
+		"sha": "3a0f86fb8db8eea7ccbb9a95f325ddbedfb25e15",
+		"url": "https://api.github.com/repos/octocat/Hello-World/git/blobs/3a0f86fb8db8eea7ccbb9a95f325ddbedfb25e15"
+	}`
+
+	treeBody = `{
+		"sha": "cd8274d15fa3ae2ab983129fb037999f264ba9a7",
+		"url": "https://api.github.com/repos/octocat/Hello-World/trees/cd8274d15fa3ae2ab983129fb037999f264ba9a7",
+		"truncated": false,
+		"tree": [
+			{
+				"path": "README.md",
+				"mode": "100644",
+				"type": "blob",
+				"size": 12,
+				"sha": "3a0f86fb8db8eea7ccbb9a95f325ddbedfb25e15",
+				"url": "https://api.github.com/repos/octocat/Hello-World/git/blobs/3a0f86fb8db8eea7ccbb9a95f325ddbedfb25e15"
+			}
+		]
+	}`
+
+	gitCommitBody = `{
+		"sha": "7638417db6d59f3c431d3e1f261cc637155684cd",
+		"message": "Update files",
+		"author": {
+			"name": "The Octocat",
+			"email": "octocat@github.com",
+			"date": "2020-10-20T19:59:59Z"
+		},
+		"committer": {
+			"name": "The Octocat",
+			"email": "octocat@github.com",
+			"date": "2020-10-20T19:59:59Z"
+		},
+		"tree": {
+			"sha": "cd8274d15fa3ae2ab983129fb037999f264ba9a7",
+			"url": "https://api.github.com/repos/octocat/Hello-World/git/trees/cd8274d15fa3ae2ab983129fb037999f264ba9a7"
+		},
+		"parents": [
+			{
+				"sha": "6dcb09b5b57875f334f61aebed695e2e4193db5e",
+				"url": "https://api.github.com/repos/octocat/Hello-World/git/commits/6dcb09b5b57875f334f61aebed695e2e4193db5e"
+			}
+		],
+		"url": "https://api.github.com/repos/octocat/Hello-World/git/commits/7638417db6d59f3c431d3e1f261cc637155684cd",
+		"html_url": "https://github.com/octocat/Hello-World/commit/7638417db6d59f3c431d3e1f261cc637155684cd"
+	}`
+
+	parentCommitBody = `{
+		"sha": "6dcb09b5b57875f334f61aebed695e2e4193db5e",
+		"message": "Initial commit",
+		"tree": {
+			"sha": "691272480426f78a0138979dd3ce63b77f706feb",
+			"url": "https://api.github.com/repos/octocat/Hello-World/git/trees/691272480426f78a0138979dd3ce63b77f706feb"
+		}
+	}`
+
+	referenceBody = `{
+		"ref": "refs/heads/main",
+		"url": "https://api.github.com/repos/octocat/Hello-World/git/refs/heads/main",
+		"object": {
+			"type": "commit",
+			"sha": "6dcb09b5b57875f334f61aebed695e2e4193db5e",
+			"url": "https://api.github.com/repos/octocat/Hello-World/git/commits/6dcb09b5b57875f334f61aebed695e2e4193db5e"
+		}
+	}`
+
+	tagReferenceBody = `{
+		"ref": "refs/tags/v1.0.0",
+		"url": "https://api.github.com/repos/octocat/Hello-World/git/refs/tags/v1.0.0",
+		"object": {
+			"type": "commit",
+			"sha": "c3d0be41ecbe669545ee3e94d31ed9a4bc91ee3c",
+			"url": "https://api.github.com/repos/octocat/Hello-World/git/commits/c3d0be41ecbe669545ee3e94d31ed9a4bc91ee3c"
+		}
+	}`
+
+	matchingRefsBody = `[
+		{
+			"ref": "refs/tags/v1.0.0",
+			"url": "https://api.github.com/repos/octocat/Hello-World/git/refs/tags/v1.0.0",
+			"object": {
+				"type": "commit",
+				"sha": "c3d0be41ecbe669545ee3e94d31ed9a4bc91ee3c",
+				"url": "https://api.github.com/repos/octocat/Hello-World/git/commits/c3d0be41ecbe669545ee3e94d31ed9a4bc91ee3c"
+			}
+		}
+	]`
+
+	annotatedTagReferenceBody = `{
+		"ref": "refs/tags/v0.1.0",
+		"url": "https://api.github.com/repos/octocat/Hello-World/git/refs/tags/v0.1.0",
+		"object": {
+			"type": "tag",
+			"sha": "940bd336248efae0f9ee5bc7b2d5c985887b16ac",
+			"url": "https://api.github.com/repos/octocat/Hello-World/git/tags/940bd336248efae0f9ee5bc7b2d5c985887b16ac"
+		}
+	}`
+
+	gitTagBody = `{
+		"sha": "940bd336248efae0f9ee5bc7b2d5c985887b16ac",
+		"tag": "v0.1.0",
+		"message": "Release v0.1.0",
+		"tagger": {
+			"name": "The Octocat",
+			"email": "octocat@github.com",
+			"date": "2020-10-27T23:59:59Z"
+		},
+		"object": {
+			"type": "commit",
+			"sha": "c3d0be41ecbe669545ee3e94d31ed9a4bc91ee3c",
+			"url": "https://api.github.com/repos/octocat/Hello-World/git/commits/c3d0be41ecbe669545ee3e94d31ed9a4bc91ee3c"
+		},
+		"verification": {
+			"verified": false,
+			"reason": "unsigned",
+			"signature": null,
+			"payload": null
+		},
+		"url": "https://api.github.com/repos/octocat/Hello-World/git/tags/940bd336248efae0f9ee5bc7b2d5c985887b16ac"
+	}`
+
+	updatedReferenceBody = `{
+		"ref": "refs/heads/main",
+		"url": "https://api.github.com/repos/octocat/Hello-World/git/refs/heads/main",
+		"object": {
+			"type": "commit",
+			"sha": "7638417db6d59f3c431d3e1f261cc637155684cd",
+			"url": "https://api.github.com/repos/octocat/Hello-World/git/commits/7638417db6d59f3c431d3e1f261cc637155684cd"
+		}
+	}`
+)
+
+var (
+	blob = Blob{
+		SHA:      "3a0f86fb8db8eea7ccbb9a95f325ddbedfb25e15",
+		Size:     12,
+		Encoding: "base64",
+		Content:  "SGVsbG8sIFdvcmxkIQ==\n",
+		URL:      "https://api.github.com/repos/octocat/Hello-World/git/blobs/3a0f86fb8db8eea7ccbb9a95f325ddbedfb25e15",
+		Data:     []byte("Hello, World!"),
+	}
+
+	blobHash = Hash{
+		SHA: "3a0f86fb8db8eea7ccbb9a95f325ddbedfb25e15",
+		URL: "https://api.github.com/repos/octocat/Hello-World/git/blobs/3a0f86fb8db8eea7ccbb9a95f325ddbedfb25e15",
+	}
+
+	tree = Tree{
+		SHA:       "cd8274d15fa3ae2ab983129fb037999f264ba9a7",
+		URL:       "https://api.github.com/repos/octocat/Hello-World/trees/cd8274d15fa3ae2ab983129fb037999f264ba9a7",
+		Truncated: false,
+		Entries: []TreeEntry{
+			{
+				Path: "README.md",
+				Mode: TreeModeFile,
+				Type: "blob",
+				Size: 12,
+				SHA:  "3a0f86fb8db8eea7ccbb9a95f325ddbedfb25e15",
+				URL:  "https://api.github.com/repos/octocat/Hello-World/git/blobs/3a0f86fb8db8eea7ccbb9a95f325ddbedfb25e15",
+			},
+		},
+	}
+
+	gitCommit = RawCommit{
+		SHA:     "7638417db6d59f3c431d3e1f261cc637155684cd",
+		Message: "Update files",
+		Author: Signature{
+			Name:  "The Octocat",
+			Email: "octocat@github.com",
+			Time:  parseGitHubTime("2020-10-20T19:59:59Z"),
+		},
+		Committer: Signature{
+			Name:  "The Octocat",
+			Email: "octocat@github.com",
+			Time:  parseGitHubTime("2020-10-20T19:59:59Z"),
+		},
+		Tree: Hash{
+			SHA: "cd8274d15fa3ae2ab983129fb037999f264ba9a7",
+			URL: "https://api.github.com/repos/octocat/Hello-World/git/trees/cd8274d15fa3ae2ab983129fb037999f264ba9a7",
+		},
+		Parents: []Hash{
+			{
+				SHA: "6dcb09b5b57875f334f61aebed695e2e4193db5e",
+				URL: "https://api.github.com/repos/octocat/Hello-World/git/commits/6dcb09b5b57875f334f61aebed695e2e4193db5e",
+			},
+		},
+		URL:     "https://api.github.com/repos/octocat/Hello-World/git/commits/7638417db6d59f3c431d3e1f261cc637155684cd",
+		HTMLURL: "https://github.com/octocat/Hello-World/commit/7638417db6d59f3c431d3e1f261cc637155684cd",
+	}
+
+	reference = Reference{
+		Ref: "refs/heads/main",
+		URL: "https://api.github.com/repos/octocat/Hello-World/git/refs/heads/main",
+		Object: RefObject{
+			Type: "commit",
+			SHA:  "6dcb09b5b57875f334f61aebed695e2e4193db5e",
+			URL:  "https://api.github.com/repos/octocat/Hello-World/git/commits/6dcb09b5b57875f334f61aebed695e2e4193db5e",
+		},
+	}
+
+	tagReference = Reference{
+		Ref: "refs/tags/v1.0.0",
+		URL: "https://api.github.com/repos/octocat/Hello-World/git/refs/tags/v1.0.0",
+		Object: RefObject{
+			Type: "commit",
+			SHA:  "c3d0be41ecbe669545ee3e94d31ed9a4bc91ee3c",
+			URL:  "https://api.github.com/repos/octocat/Hello-World/git/commits/c3d0be41ecbe669545ee3e94d31ed9a4bc91ee3c",
+		},
+	}
+
+	gitTag = GitTag{
+		SHA:     "940bd336248efae0f9ee5bc7b2d5c985887b16ac",
+		Tag:     "v0.1.0",
+		Message: "Release v0.1.0",
+		Tagger: Signature{
+			Name:  "The Octocat",
+			Email: "octocat@github.com",
+			Time:  parseGitHubTime("2020-10-27T23:59:59Z"),
+		},
+		Object: RefObject{
+			Type: "commit",
+			SHA:  "c3d0be41ecbe669545ee3e94d31ed9a4bc91ee3c",
+			URL:  "https://api.github.com/repos/octocat/Hello-World/git/commits/c3d0be41ecbe669545ee3e94d31ed9a4bc91ee3c",
+		},
+		Verification: Verification{
+			Verified: false,
+			Reason:   "unsigned",
+		},
+		URL: "https://api.github.com/repos/octocat/Hello-World/git/tags/940bd336248efae0f9ee5bc7b2d5c985887b16ac",
+	}
+
+	updatedReference = Reference{
+		Ref: "refs/heads/main",
+		URL: "https://api.github.com/repos/octocat/Hello-World/git/refs/heads/main",
+		Object: RefObject{
+			Type: "commit",
+			SHA:  "7638417db6d59f3c431d3e1f261cc637155684cd",
+			URL:  "https://api.github.com/repos/octocat/Hello-World/git/commits/7638417db6d59f3c431d3e1f261cc637155684cd",
+		},
+	}
+)
+
+func TestTreeEntryParams_MarshalJSON(t *testing.T) {
+	tests := []struct {
+		name         string
+		e            TreeEntryParams
+		expectedJSON string
+	}{
+		{
+			name: "WithSHA",
+			e: TreeEntryParams{
+				Path: "README.md",
+				SHA:  "3a0f86fb8db8eea7ccbb9a95f325ddbedfb25e15",
+			},
+			expectedJSON: `{"mode":"100644","path":"README.md","sha":"3a0f86fb8db8eea7ccbb9a95f325ddbedfb25e15","type":"blob"}`,
+		},
+		{
+			name: "WithContent",
+			e: TreeEntryParams{
+				Path:    "bin/run.sh",
+				Mode:    TreeModeExecutable,
+				Content: "#!/bin/sh",
+			},
+			expectedJSON: `{"content":"#!/bin/sh","mode":"100755","path":"bin/run.sh","type":"blob"}`,
+		},
+		{
+			name: "Delete",
+			e: TreeEntryParams{
+				Path:   "docs",
+				Mode:   TreeModeDir,
+				Type:   "tree",
+				Delete: true,
+			},
+			expectedJSON: `{"mode":"040000","path":"docs","sha":null,"type":"tree"}`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			b, err := json.Marshal(tc.e)
+
+			assert.NoError(t, err)
+			assert.JSONEq(t, tc.expectedJSON, string(b))
+		})
+	}
+}
+
+func TestGitService_Blob(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	tests := []struct {
+		name             string
+		mockResponses    []MockResponse
+		s                *GitService
+		ctx              context.Context
+		sha              string
+		expectedBlob     *Blob
+		expectedResponse *Response
+		expectedError    string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &GitService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           nil,
+			sha:           "3a0f86fb8db8eea7ccbb9a95f325ddbedfb25e15",
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/git/blobs/3a0f86fb8db8eea7ccbb9a95f325ddbedfb25e15", 401, http.Header{}, `{
+					"message": "Bad credentials"
+				}`},
+			},
+			s: &GitService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			sha:           "3a0f86fb8db8eea7ccbb9a95f325ddbedfb25e15",
+			expectedError: `GET /repos/octocat/Hello-World/git/blobs/3a0f86fb8db8eea7ccbb9a95f325ddbedfb25e15: 401 Bad credentials`,
+		},
+		{
+			name: "InvalidResponse",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/git/blobs/3a0f86fb8db8eea7ccbb9a95f325ddbedfb25e15", 200, http.Header{}, `{`},
+			},
+			s: &GitService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			sha:           "3a0f86fb8db8eea7ccbb9a95f325ddbedfb25e15",
+			expectedError: `unexpected EOF`,
+		},
+		{
+			name: "InvalidContent",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/git/blobs/3a0f86fb8db8eea7ccbb9a95f325ddbedfb25e15", 200, http.Header{}, `{
+					"encoding": "base64",
+					"content": "!"
+				}`},
+			},
+			s: &GitService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			sha:           "3a0f86fb8db8eea7ccbb9a95f325ddbedfb25e15",
+			expectedError: `illegal base64 data at input byte 0`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/git/blobs/3a0f86fb8db8eea7ccbb9a95f325ddbedfb25e15", 200, header, blobBody},
+			},
+			s: &GitService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:          context.Background(),
+			sha:          "3a0f86fb8db8eea7ccbb9a95f325ddbedfb25e15",
+			expectedBlob: &blob,
+			expectedResponse: &Response{
+				Rate: expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			defer ts.Close()
+
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			blob, resp, err := tc.s.Blob(tc.ctx, tc.sha)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, blob)
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedBlob, blob)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestGitService_CreateBlob(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	tests := []struct {
+		name             string
+		mockResponses    []MockResponse
+		s                *GitService
+		ctx              context.Context
+		content          []byte
+		expectedHash     *Hash
+		expectedResponse *Response
+		expectedError    string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &GitService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           nil,
+			content:       []byte("Hello, World!"),
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"POST", "/repos/octocat/Hello-World/git/blobs", 401, http.Header{}, `{
+					"message": "Bad credentials"
+				}`},
+			},
+			s: &GitService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			content:       []byte("Hello, World!"),
+			expectedError: `POST /repos/octocat/Hello-World/git/blobs: 401 Bad credentials`,
+		},
+		{
+			name: "InvalidResponse",
+			mockResponses: []MockResponse{
+				{"POST", "/repos/octocat/Hello-World/git/blobs", 201, http.Header{}, `{`},
+			},
+			s: &GitService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			content:       []byte("Hello, World!"),
+			expectedError: `unexpected EOF`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"POST", "/repos/octocat/Hello-World/git/blobs", 201, header, blobHashBody},
+			},
+			s: &GitService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:          context.Background(),
+			content:      []byte("Hello, World!"),
+			expectedHash: &blobHash,
+			expectedResponse: &Response{
+				Rate: expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			defer ts.Close()
+
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			hash, resp, err := tc.s.CreateBlob(tc.ctx, tc.content)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, hash)
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedHash, hash)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestGitService_Tree(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	tests := []struct {
+		name             string
+		mockResponses    []MockResponse
+		s                *GitService
+		ctx              context.Context
+		sha              string
+		recursive        bool
+		expectedTree     *Tree
+		expectedResponse *Response
+		expectedError    string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &GitService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           nil,
+			sha:           "main",
+			recursive:     true,
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/git/trees/main", 401, http.Header{}, `{
+					"message": "Bad credentials"
+				}`},
+			},
+			s: &GitService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			sha:           "main",
+			recursive:     true,
+			expectedError: `GET /repos/octocat/Hello-World/git/trees/main: 401 Bad credentials`,
+		},
+		{
+			name: "InvalidResponse",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/git/trees/main", 200, http.Header{}, `{`},
+			},
+			s: &GitService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			sha:           "main",
+			recursive:     true,
+			expectedError: `unexpected EOF`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/git/trees/main", 200, header, treeBody},
+			},
+			s: &GitService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:          context.Background(),
+			sha:          "main",
+			recursive:    true,
+			expectedTree: &tree,
+			expectedResponse: &Response{
+				Rate: expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			defer ts.Close()
+
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			tree, resp, err := tc.s.Tree(tc.ctx, tc.sha, tc.recursive)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, tree)
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedTree, tree)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestGitService_CreateTree(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	entries := []TreeEntryParams{
+		{
+			Path: "README.md",
+			SHA:  "3a0f86fb8db8eea7ccbb9a95f325ddbedfb25e15",
+		},
+		{
+			Path:   "LICENSE",
+			Delete: true,
+		},
+	}
+
+	tests := []struct {
+		name             string
+		mockResponses    []MockResponse
+		s                *GitService
+		ctx              context.Context
+		baseTree         string
+		entries          []TreeEntryParams
+		expectedTree     *Tree
+		expectedResponse *Response
+		expectedError    string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &GitService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           nil,
+			baseTree:      "691272480426f78a0138979dd3ce63b77f706feb",
+			entries:       entries,
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"POST", "/repos/octocat/Hello-World/git/trees", 422, http.Header{}, `{
+					"message": "Invalid tree info"
+				}`},
+			},
+			s: &GitService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			baseTree:      "691272480426f78a0138979dd3ce63b77f706feb",
+			entries:       entries,
+			expectedError: `POST /repos/octocat/Hello-World/git/trees: 422 Invalid tree info`,
+		},
+		{
+			name: "InvalidResponse",
+			mockResponses: []MockResponse{
+				{"POST", "/repos/octocat/Hello-World/git/trees", 201, http.Header{}, `{`},
+			},
+			s: &GitService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			baseTree:      "691272480426f78a0138979dd3ce63b77f706feb",
+			entries:       entries,
+			expectedError: `unexpected EOF`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"POST", "/repos/octocat/Hello-World/git/trees", 201, header, treeBody},
+			},
+			s: &GitService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:          context.Background(),
+			baseTree:     "691272480426f78a0138979dd3ce63b77f706feb",
+			entries:      entries,
+			expectedTree: &tree,
+			expectedResponse: &Response{
+				Rate: expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			defer ts.Close()
+
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			tree, resp, err := tc.s.CreateTree(tc.ctx, tc.baseTree, tc.entries)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, tree)
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedTree, tree)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestGitService_Commit(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	tests := []struct {
+		name             string
+		mockResponses    []MockResponse
+		s                *GitService
+		ctx              context.Context
+		sha              string
+		expectedCommit   *RawCommit
+		expectedResponse *Response
+		expectedError    string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &GitService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           nil,
+			sha:           "7638417db6d59f3c431d3e1f261cc637155684cd",
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/git/commits/7638417db6d59f3c431d3e1f261cc637155684cd", 401, http.Header{}, `{
+					"message": "Bad credentials"
+				}`},
+			},
+			s: &GitService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			sha:           "7638417db6d59f3c431d3e1f261cc637155684cd",
+			expectedError: `GET /repos/octocat/Hello-World/git/commits/7638417db6d59f3c431d3e1f261cc637155684cd: 401 Bad credentials`,
+		},
+		{
+			name: "InvalidResponse",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/git/commits/7638417db6d59f3c431d3e1f261cc637155684cd", 200, http.Header{}, `{`},
+			},
+			s: &GitService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			sha:           "7638417db6d59f3c431d3e1f261cc637155684cd",
+			expectedError: `unexpected EOF`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/git/commits/7638417db6d59f3c431d3e1f261cc637155684cd", 200, header, gitCommitBody},
+			},
+			s: &GitService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:            context.Background(),
+			sha:            "7638417db6d59f3c431d3e1f261cc637155684cd",
+			expectedCommit: &gitCommit,
+			expectedResponse: &Response{
+				Rate: expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			defer ts.Close()
+
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			commit, resp, err := tc.s.Commit(tc.ctx, tc.sha)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, commit)
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedCommit, commit)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestGitService_CreateCommit(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	params := CreateCommitParams{
+		Message: "Update files",
+		Tree:    "cd8274d15fa3ae2ab983129fb037999f264ba9a7",
+		Parents: []string{"6dcb09b5b57875f334f61aebed695e2e4193db5e"},
+		Author: &Signature{
+			Name:  "The Octocat",
+			Email: "octocat@github.com",
+		},
+	}
+
+	tests := []struct {
+		name             string
+		mockResponses    []MockResponse
+		s                *GitService
+		ctx              context.Context
+		params           CreateCommitParams
+		expectedCommit   *RawCommit
+		expectedResponse *Response
+		expectedError    string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &GitService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           nil,
+			params:        params,
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"POST", "/repos/octocat/Hello-World/git/commits", 422, http.Header{}, `{
+					"message": "Tree SHA does not exist"
+				}`},
+			},
+			s: &GitService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			params:        params,
+			expectedError: `POST /repos/octocat/Hello-World/git/commits: 422 Tree SHA does not exist`,
+		},
+		{
+			name: "InvalidResponse",
+			mockResponses: []MockResponse{
+				{"POST", "/repos/octocat/Hello-World/git/commits", 201, http.Header{}, `{`},
+			},
+			s: &GitService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			params:        params,
+			expectedError: `unexpected EOF`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"POST", "/repos/octocat/Hello-World/git/commits", 201, header, gitCommitBody},
+			},
+			s: &GitService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:            context.Background(),
+			params:         params,
+			expectedCommit: &gitCommit,
+			expectedResponse: &Response{
+				Rate: expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			defer ts.Close()
+
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			commit, resp, err := tc.s.CreateCommit(tc.ctx, tc.params)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, commit)
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedCommit, commit)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestGitService_Ref(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	tests := []struct {
+		name              string
+		mockResponses     []MockResponse
+		s                 *GitService
+		ctx               context.Context
+		ref               string
+		expectedReference *Reference
+		expectedResponse  *Response
+		expectedError     string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &GitService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           nil,
+			ref:           "heads/main",
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/git/ref/heads/main", 401, http.Header{}, `{
+					"message": "Bad credentials"
+				}`},
+			},
+			s: &GitService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			ref:           "heads/main",
+			expectedError: `GET /repos/octocat/Hello-World/git/ref/heads/main: 401 Bad credentials`,
+		},
+		{
+			name: "InvalidResponse",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/git/ref/heads/main", 200, http.Header{}, `{`},
+			},
+			s: &GitService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			ref:           "heads/main",
+			expectedError: `unexpected EOF`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/git/ref/heads/main", 200, header, referenceBody},
+			},
+			s: &GitService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:               context.Background(),
+			ref:               "heads/main",
+			expectedReference: &reference,
+			expectedResponse: &Response{
+				Rate: expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			defer ts.Close()
+
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			reference, resp, err := tc.s.Ref(tc.ctx, tc.ref)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, reference)
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedReference, reference)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestGitService_CreateRef(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	tests := []struct {
+		name              string
+		mockResponses     []MockResponse
+		s                 *GitService
+		ctx               context.Context
+		ref               string
+		sha               string
+		expectedReference *Reference
+		expectedResponse  *Response
+		expectedError     string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &GitService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           nil,
+			ref:           "heads/main",
+			sha:           "6dcb09b5b57875f334f61aebed695e2e4193db5e",
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"POST", "/repos/octocat/Hello-World/git/refs", 422, http.Header{}, `{
+					"message": "Reference already exists"
+				}`},
+			},
+			s: &GitService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			ref:           "heads/main",
+			sha:           "6dcb09b5b57875f334f61aebed695e2e4193db5e",
+			expectedError: `POST /repos/octocat/Hello-World/git/refs: 422 Reference already exists`,
+		},
+		{
+			name: "InvalidResponse",
+			mockResponses: []MockResponse{
+				{"POST", "/repos/octocat/Hello-World/git/refs", 201, http.Header{}, `{`},
+			},
+			s: &GitService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			ref:           "heads/main",
+			sha:           "6dcb09b5b57875f334f61aebed695e2e4193db5e",
+			expectedError: `unexpected EOF`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"POST", "/repos/octocat/Hello-World/git/refs", 201, header, referenceBody},
+			},
+			s: &GitService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:               context.Background(),
+			ref:               "heads/main",
+			sha:               "6dcb09b5b57875f334f61aebed695e2e4193db5e",
+			expectedReference: &reference,
+			expectedResponse: &Response{
+				Rate: expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			defer ts.Close()
+
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			reference, resp, err := tc.s.CreateRef(tc.ctx, tc.ref, tc.sha)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, reference)
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedReference, reference)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestGitService_UpdateRef(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	tests := []struct {
+		name              string
+		mockResponses     []MockResponse
+		s                 *GitService
+		ctx               context.Context
+		ref               string
+		sha               string
+		force             bool
+		expectedReference *Reference
+		expectedResponse  *Response
+		expectedError     string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &GitService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           nil,
+			ref:           "heads/main",
+			sha:           "7638417db6d59f3c431d3e1f261cc637155684cd",
+			force:         false,
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"PATCH", "/repos/octocat/Hello-World/git/refs/heads/main", 422, http.Header{}, `{
+					"message": "Update is not a fast forward"
+				}`},
+			},
+			s: &GitService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			ref:           "heads/main",
+			sha:           "7638417db6d59f3c431d3e1f261cc637155684cd",
+			force:         false,
+			expectedError: `PATCH /repos/octocat/Hello-World/git/refs/heads/main: 422 Update is not a fast forward`,
+		},
+		{
+			name: "InvalidResponse",
+			mockResponses: []MockResponse{
+				{"PATCH", "/repos/octocat/Hello-World/git/refs/heads/main", 200, http.Header{}, `{`},
+			},
+			s: &GitService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			ref:           "heads/main",
+			sha:           "7638417db6d59f3c431d3e1f261cc637155684cd",
+			force:         true,
+			expectedError: `unexpected EOF`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"PATCH", "/repos/octocat/Hello-World/git/refs/heads/main", 200, header, updatedReferenceBody},
+			},
+			s: &GitService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:               context.Background(),
+			ref:               "heads/main",
+			sha:               "7638417db6d59f3c431d3e1f261cc637155684cd",
+			force:             true,
+			expectedReference: &updatedReference,
+			expectedResponse: &Response{
+				Rate: expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			defer ts.Close()
+
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			reference, resp, err := tc.s.UpdateRef(tc.ctx, tc.ref, tc.sha, tc.force)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, reference)
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedReference, reference)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestGitService_DeleteRef(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	tests := []struct {
+		name             string
+		mockResponses    []MockResponse
+		s                *GitService
+		ctx              context.Context
+		ref              string
+		expectedResponse *Response
+		expectedError    string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &GitService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           nil,
+			ref:           "heads/feature",
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"DELETE", "/repos/octocat/Hello-World/git/refs/heads/feature", 422, http.Header{}, `{
+					"message": "Reference does not exist"
+				}`},
+			},
+			s: &GitService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			ref:           "heads/feature",
+			expectedError: `DELETE /repos/octocat/Hello-World/git/refs/heads/feature: 422 Reference does not exist`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"DELETE", "/repos/octocat/Hello-World/git/refs/heads/feature", 204, header, ``},
+			},
+			s: &GitService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx: context.Background(),
+			ref: "heads/feature",
+			expectedResponse: &Response{
+				Rate: expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			defer ts.Close()
+
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			resp, err := tc.s.DeleteRef(tc.ctx, tc.ref)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestGitService_MatchingRefs(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	tests := []struct {
+		name               string
+		mockResponses      []MockResponse
+		s                  *GitService
+		ctx                context.Context
+		prefix             string
+		expectedReferences []Reference
+		expectedResponse   *Response
+		expectedError      string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &GitService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           nil,
+			prefix:        "tags/v1.",
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/git/matching-refs/tags/v1.", 401, http.Header{}, `{
+					"message": "Bad credentials"
+				}`},
+			},
+			s: &GitService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			prefix:        "tags/v1.",
+			expectedError: `GET /repos/octocat/Hello-World/git/matching-refs/tags/v1.: 401 Bad credentials`,
+		},
+		{
+			name: "InvalidResponse",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/git/matching-refs/tags/v1.", 200, http.Header{}, `{`},
+			},
+			s: &GitService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			prefix:        "tags/v1.",
+			expectedError: `unexpected EOF`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/git/matching-refs/tags/v1.", 200, header, matchingRefsBody},
+			},
+			s: &GitService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:                context.Background(),
+			prefix:             "tags/v1.",
+			expectedReferences: []Reference{tagReference},
+			expectedResponse: &Response{
+				Rate: expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			defer ts.Close()
+
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			references, resp, err := tc.s.MatchingRefs(tc.ctx, tc.prefix)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, references)
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedReferences, references)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestGitService_Tag(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	tests := []struct {
+		name             string
+		mockResponses    []MockResponse
+		s                *GitService
+		ctx              context.Context
+		sha              string
+		expectedTag      *GitTag
+		expectedResponse *Response
+		expectedError    string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &GitService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           nil,
+			sha:           "940bd336248efae0f9ee5bc7b2d5c985887b16ac",
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/git/tags/940bd336248efae0f9ee5bc7b2d5c985887b16ac", 401, http.Header{}, `{
+					"message": "Bad credentials"
+				}`},
+			},
+			s: &GitService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			sha:           "940bd336248efae0f9ee5bc7b2d5c985887b16ac",
+			expectedError: `GET /repos/octocat/Hello-World/git/tags/940bd336248efae0f9ee5bc7b2d5c985887b16ac: 401 Bad credentials`,
+		},
+		{
+			name: "InvalidResponse",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/git/tags/940bd336248efae0f9ee5bc7b2d5c985887b16ac", 200, http.Header{}, `{`},
+			},
+			s: &GitService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			sha:           "940bd336248efae0f9ee5bc7b2d5c985887b16ac",
+			expectedError: `unexpected EOF`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/git/tags/940bd336248efae0f9ee5bc7b2d5c985887b16ac", 200, header, gitTagBody},
+			},
+			s: &GitService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:         context.Background(),
+			sha:         "940bd336248efae0f9ee5bc7b2d5c985887b16ac",
+			expectedTag: &gitTag,
+			expectedResponse: &Response{
+				Rate: expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			defer ts.Close()
+
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			tag, resp, err := tc.s.Tag(tc.ctx, tc.sha)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, tag)
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedTag, tag)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestGitService_CreateTag(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	tagParams := CreateTagParams{
+		Tag:     "v0.1.0",
+		Message: "Release v0.1.0",
+		Object:  "c3d0be41ecbe669545ee3e94d31ed9a4bc91ee3c",
+		Tagger: &Signature{
+			Name:  "The Octocat",
+			Email: "octocat@github.com",
+			Time:  parseGitHubTime("2020-10-27T23:59:59Z"),
+		},
+	}
+
+	tests := []struct {
+		name             string
+		mockResponses    []MockResponse
+		s                *GitService
+		ctx              context.Context
+		params           CreateTagParams
+		expectedTag      *GitTag
+		expectedResponse *Response
+		expectedError    string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &GitService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           nil,
+			params:        tagParams,
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"POST", "/repos/octocat/Hello-World/git/tags", 401, http.Header{}, `{
+					"message": "Bad credentials"
+				}`},
+			},
+			s: &GitService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			params:        tagParams,
+			expectedError: `POST /repos/octocat/Hello-World/git/tags: 401 Bad credentials`,
+		},
+		{
+			name: "InvalidResponse",
+			mockResponses: []MockResponse{
+				{"POST", "/repos/octocat/Hello-World/git/tags", 201, http.Header{}, `{`},
+			},
+			s: &GitService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			params:        tagParams,
+			expectedError: `unexpected EOF`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"POST", "/repos/octocat/Hello-World/git/tags", 201, header, gitTagBody},
+			},
+			s: &GitService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:         context.Background(),
+			params:      tagParams,
+			expectedTag: &gitTag,
+			expectedResponse: &Response{
+				Rate: expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			defer ts.Close()
+
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			tag, resp, err := tc.s.CreateTag(tc.ctx, tc.params)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, tag)
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedTag, tag)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestGitService_CreateAnnotatedTag(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	tagParams := CreateTagParams{
+		Tag:     "v0.1.0",
+		Message: "Release v0.1.0",
+		Object:  "c3d0be41ecbe669545ee3e94d31ed9a4bc91ee3c",
+	}
+
+	tests := []struct {
+		name          string
+		mockResponses []MockResponse
+		s             *GitService
+		ctx           context.Context
+		params        CreateTagParams
+		expectedTag   *GitTag
+		expectedError string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &GitService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           nil,
+			params:        tagParams,
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "CreateTagFails",
+			mockResponses: []MockResponse{
+				{"POST", "/repos/octocat/Hello-World/git/tags", 401, http.Header{}, `{
+					"message": "Bad credentials"
+				}`},
+			},
+			s: &GitService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			params:        tagParams,
+			expectedError: `POST /repos/octocat/Hello-World/git/tags: 401 Bad credentials`,
+		},
+		{
+			name: "CreateRefFails",
+			mockResponses: []MockResponse{
+				{"POST", "/repos/octocat/Hello-World/git/tags", 201, header, gitTagBody},
+				{"POST", "/repos/octocat/Hello-World/git/refs", 422, http.Header{}, `{
+					"message": "Reference already exists"
+				}`},
+			},
+			s: &GitService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			params:        tagParams,
+			expectedError: `POST /repos/octocat/Hello-World/git/refs: 422 Reference already exists`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"POST", "/repos/octocat/Hello-World/git/tags", 201, header, gitTagBody},
+				{"POST", "/repos/octocat/Hello-World/git/refs", 201, header, annotatedTagReferenceBody},
+			},
+			s: &GitService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:         context.Background(),
+			params:      tagParams,
+			expectedTag: &gitTag,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			defer ts.Close()
+
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			tag, err := tc.s.CreateAnnotatedTag(tc.ctx, tc.params)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, tag)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedTag, tag)
+			}
+		})
+	}
+}
+
+func TestGitService_ApplyChanges(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	changes := Changeset{
+		"README.md": []byte("Hello, World!"),
+		"LICENSE":   nil,
+	}
+
+	tests := []struct {
+		name           string
+		mockResponses  []MockResponse
+		s              *GitService
+		ctx            context.Context
+		branch         string
+		message        string
+		changes        Changeset
+		expectedCommit *RawCommit
+		expectedError  string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &GitService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           nil,
+			branch:        "main",
+			message:       "Update files",
+			changes:       changes,
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "CommitFails",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/git/ref/heads/main", 200, header, referenceBody},
+				{"GET", "/repos/octocat/Hello-World/git/commits/6dcb09b5b57875f334f61aebed695e2e4193db5e", 404, http.Header{}, `{
+					"message": "Not Found"
+				}`},
+			},
+			s: &GitService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			branch:        "main",
+			message:       "Update files",
+			changes:       changes,
+			expectedError: `GET /repos/octocat/Hello-World/git/commits/6dcb09b5b57875f334f61aebed695e2e4193db5e: 404 Not Found`,
+		},
+		{
+			name: "CreateBlobFails",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/git/ref/heads/main", 200, header, referenceBody},
+				{"GET", "/repos/octocat/Hello-World/git/commits/6dcb09b5b57875f334f61aebed695e2e4193db5e", 200, header, parentCommitBody},
+				{"POST", "/repos/octocat/Hello-World/git/blobs", 403, http.Header{}, `{
+					"message": "Resource not accessible by integration"
+				}`},
+			},
+			s: &GitService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			branch:        "main",
+			message:       "Update files",
+			changes:       changes,
+			expectedError: `POST /repos/octocat/Hello-World/git/blobs: 403 Resource not accessible by integration`,
+		},
+		{
+			name: "UpdateRefFails",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/git/ref/heads/main", 200, header, referenceBody},
+				{"GET", "/repos/octocat/Hello-World/git/commits/6dcb09b5b57875f334f61aebed695e2e4193db5e", 200, header, parentCommitBody},
+				{"POST", "/repos/octocat/Hello-World/git/blobs", 201, header, blobHashBody},
+				{"POST", "/repos/octocat/Hello-World/git/trees", 201, header, treeBody},
+				{"POST", "/repos/octocat/Hello-World/git/commits", 201, header, gitCommitBody},
+				{"PATCH", "/repos/octocat/Hello-World/git/refs/heads/main", 422, http.Header{}, `{
+					"message": "Validation Failed"
+				}`},
+			},
+			s: &GitService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			branch:        "main",
+			message:       "Update files",
+			changes:       changes,
+			expectedError: `PATCH /repos/octocat/Hello-World/git/refs/heads/main: 422 Validation Failed`,
+		},
+		{
+			name: "NotFastForward",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/git/ref/heads/main", 200, header, referenceBody},
+				{"GET", "/repos/octocat/Hello-World/git/commits/6dcb09b5b57875f334f61aebed695e2e4193db5e", 200, header, parentCommitBody},
+				{"POST", "/repos/octocat/Hello-World/git/blobs", 201, header, blobHashBody},
+				{"POST", "/repos/octocat/Hello-World/git/trees", 201, header, treeBody},
+				{"POST", "/repos/octocat/Hello-World/git/commits", 201, header, gitCommitBody},
+				{"PATCH", "/repos/octocat/Hello-World/git/refs/heads/main", 422, http.Header{}, `{
+					"message": "Update is not a fast forward"
+				}`},
+			},
+			s: &GitService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			branch:        "main",
+			message:       "Update files",
+			changes:       changes,
+			expectedError: `Update is not a fast forward: update is not a fast-forward`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/git/ref/heads/main", 200, header, referenceBody},
+				{"GET", "/repos/octocat/Hello-World/git/commits/6dcb09b5b57875f334f61aebed695e2e4193db5e", 200, header, parentCommitBody},
+				{"POST", "/repos/octocat/Hello-World/git/blobs", 201, header, blobHashBody},
+				{"POST", "/repos/octocat/Hello-World/git/trees", 201, header, treeBody},
+				{"POST", "/repos/octocat/Hello-World/git/commits", 201, header, gitCommitBody},
+				{"PATCH", "/repos/octocat/Hello-World/git/refs/heads/main", 200, header, updatedReferenceBody},
+			},
+			s: &GitService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:            context.Background(),
+			branch:         "main",
+			message:        "Update files",
+			changes:        changes,
+			expectedCommit: &gitCommit,
+		},
+		{
+			name: "NoChanges",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/git/ref/heads/main", 200, header, referenceBody},
+				{"GET", "/repos/octocat/Hello-World/git/commits/6dcb09b5b57875f334f61aebed695e2e4193db5e", 200, header, parentCommitBody},
+			},
+			s: &GitService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:     context.Background(),
+			branch:  "main",
+			message: "Update files",
+			changes: Changeset{},
+			expectedCommit: &RawCommit{
+				SHA:     "6dcb09b5b57875f334f61aebed695e2e4193db5e",
+				Message: "Initial commit",
+				Tree: Hash{
+					SHA: "691272480426f78a0138979dd3ce63b77f706feb",
+					URL: "https://api.github.com/repos/octocat/Hello-World/git/trees/691272480426f78a0138979dd3ce63b77f706feb",
+				},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			defer ts.Close()
+
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			commit, err := tc.s.ApplyChanges(tc.ctx, tc.branch, tc.message, tc.changes)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, commit)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedCommit, commit)
+			}
+		})
+	}
+}
@@ -15,6 +15,8 @@ var (
 	relPrevRE  = regexp.MustCompile(`<https://api.github.com/[^>]+[?&]page=(\d+)[^>]*>; rel="prev"`)
 	relNextRE  = regexp.MustCompile(`<https://api.github.com/[^>]+[?&]page=(\d+)[^>]*>; rel="next"`)
 	relLastRE  = regexp.MustCompile(`<https://api.github.com/[^>]+[?&]page=(\d+)[^>]*>; rel="last"`)
+
+	relNextCursorRE = regexp.MustCompile(`<https://api.github.com/[^>]+[?&]cursor=([^&>]+)[^>]*>; rel="next"`)
 )
 
 const (
@@ -113,6 +115,9 @@ type Pages struct {
 	Prev  int
 	Next  int
 	Last  int
+
+	// Cursor is the cursor for the next page of the endpoints using cursor-based pagination.
+	Cursor string
 }
 
 // Epoch is a Unix timestamp.
@@ -173,6 +178,10 @@ func newResponse(resp *http.Response) *Response {
 		if m := relLastRE.FindStringSubmatch(link); len(m) == 2 {
 			r.Pages.Last, _ = strconv.Atoi(m[1])
 		}
+
+		if m := relNextCursorRE.FindStringSubmatch(link); len(m) == 2 {
+			r.Pages.Cursor, _ = url.QueryUnescape(m[1])
+		}
 	}
 
 	r.Rate.Resource = h.Get(headerRateResource)
@@ -204,6 +213,8 @@ const (
 	rateGroupCore    = rateGroup("core")
 	rateGroupSearch  = rateGroup("search")
 	rateGroupGraphQL = rateGroup("graphql")
+
+	rateGroupActionsRunnerRegistration = rateGroup("actions_runner_registration")
 )
 
 func getRateGroup(u *url.URL) rateGroup {
@@ -212,7 +223,19 @@ func getRateGroup(u *url.URL) rateGroup {
 		return rateGroupSearch
 	case strings.HasPrefix(u.Path, "/graphql"):
 		return rateGroupGraphQL
+	case strings.HasSuffix(u.Path, "/actions/runners/registration-token"):
+		return rateGroupActionsRunnerRegistration
 	default:
 		return rateGroupCore
 	}
 }
+
+// escapePath escapes every segment of a slash-separated path, so it can be safely used in a URL path.
+func escapePath(p string) string {
+	segments := strings.Split(p, "/")
+	for i, segment := range segments {
+		segments[i] = url.PathEscape(segment)
+	}
+
+	return strings.Join(segments, "/")
+}
@@ -110,6 +110,29 @@ func TestResponse(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "WithCursor",
+			respHeader: http.Header{
+				headerLink:          {`<https://api.github.com/repos/octocat/Hello-World/hooks/1/deliveries?per_page=30&cursor=v1_12077215967>; rel="next"`},
+				headerRateResource:  {"core"},
+				headerRateLimit:     {"5000"},
+				headerRateUsed:      {"10"},
+				headerRateRemaining: {"4990"},
+				headerRateReset:     {"1605083281"},
+			},
+			expectedResponse: &Response{
+				Pages: Pages{
+					Cursor: "v1_12077215967",
+				},
+				Rate: Rate{
+					Resource:  "core",
+					Limit:     5000,
+					Used:      10,
+					Remaining: 4990,
+					Reset:     Epoch(1605083281),
+				},
+			},
+		},
 	}
 
 	for _, tc := range tests {
@@ -137,6 +160,7 @@ func TestGetRateGroup(t *testing.T) {
 	u1, _ := url.Parse("https://api.github.com/users/octocat")
 	u2, _ := url.Parse("https://api.github.com/search/code")
 	u3, _ := url.Parse("https://api.github.com/graphql")
+	u4, _ := url.Parse("https://api.github.com/orgs/octo-org/actions/runners/registration-token")
 
 	tests := []struct {
 		name              string
@@ -158,6 +182,11 @@ func TestGetRateGroup(t *testing.T) {
 			u:                 u3,
 			expectedRateGroup: rateGroupGraphQL,
 		},
+		{
+			name:              "ActionsRunnerRegistration",
+			u:                 u4,
+			expectedRateGroup: rateGroupActionsRunnerRegistration,
+		},
 	}
 
 	for _, tc := range tests {
@@ -0,0 +1,339 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// HookService provides GitHub APIs for webhooks in a repository or an organization.
+// See https://docs.github.com/en/rest/webhooks/repos
+// See https://docs.github.com/en/rest/orgs/webhooks
+type HookService struct {
+	client   *Client
+	basePath string
+}
+
+type (
+	// HookConfig is the configuration of a GitHub webhook.
+	HookConfig struct {
+		URL         string `json:"url"`
+		ContentType string `json:"content_type,omitempty"` // Either json or form
+		Secret      string `json:"secret,omitempty"`
+		InsecureSSL string `json:"insecure_ssl,omitempty"` // Either 0 or 1
+	}
+
+	// HookParams is used for creating or updating a GitHub webhook.
+	HookParams struct {
+		Name   string     `json:"name,omitempty"` // Must be web
+		Active bool       `json:"active"`
+		Events []string   `json:"events,omitempty"`
+		Config HookConfig `json:"config"`
+	}
+
+	// HookLastResponse is the last response received by a GitHub webhook.
+	HookLastResponse struct {
+		Code    *int   `json:"code"`
+		Status  string `json:"status"`
+		Message string `json:"message"`
+	}
+
+	// Hook is a GitHub webhook object.
+	Hook struct {
+		ID            int              `json:"id"`
+		Type          string           `json:"type"`
+		Name          string           `json:"name"`
+		Active        bool             `json:"active"`
+		Events        []string         `json:"events"`
+		Config        HookConfig       `json:"config"`
+		LastResponse  HookLastResponse `json:"last_response"`
+		URL           string           `json:"url"`
+		TestURL       string           `json:"test_url"`
+		PingURL       string           `json:"ping_url"`
+		DeliveriesURL string           `json:"deliveries_url"`
+		CreatedAt     time.Time        `json:"created_at"`
+		UpdatedAt     time.Time        `json:"updated_at"`
+	}
+)
+
+type (
+	// HookDeliveryRequest is the request sent for a GitHub webhook delivery.
+	HookDeliveryRequest struct {
+		Headers map[string]string `json:"headers"`
+		Payload json.RawMessage   `json:"payload"`
+	}
+
+	// HookDeliveryResponse is the response received for a GitHub webhook delivery.
+	HookDeliveryResponse struct {
+		Headers map[string]string `json:"headers"`
+		Payload string            `json:"payload"`
+	}
+
+	// HookDelivery is a GitHub webhook delivery object.
+	// Request and Response are only available when retrieving a single delivery.
+	HookDelivery struct {
+		ID             int                   `json:"id"`
+		GUID           string                `json:"guid"`
+		Event          string                `json:"event"`
+		Action         string                `json:"action"`
+		Status         string                `json:"status"`
+		StatusCode     int                   `json:"status_code"`
+		Redelivery     bool                  `json:"redelivery"`
+		Duration       float64               `json:"duration"`
+		InstallationID *int                  `json:"installation_id"`
+		RepositoryID   *int                  `json:"repository_id"`
+		URL            string                `json:"url"`
+		Request        *HookDeliveryRequest  `json:"request"`
+		Response       *HookDeliveryResponse `json:"response"`
+		DeliveredAt    time.Time             `json:"delivered_at"`
+	}
+)
+
+// Failed determines whether or not a webhook delivery has not received a successful response.
+func (d HookDelivery) Failed() bool {
+	return d.StatusCode < 200 || d.StatusCode > 299
+}
+
+// List retrieves all webhooks page by page.
+// See https://docs.github.com/en/rest/webhooks/repos#list-repository-webhooks
+// See https://docs.github.com/en/rest/orgs/webhooks#list-organization-webhooks
+func (s *HookService) List(ctx context.Context, pageSize, pageNo int) ([]Hook, *Response, error) {
+	url := fmt.Sprintf("%s/hooks", s.basePath)
+	req, err := s.client.NewPageRequest(ctx, "GET", url, pageSize, pageNo, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	hooks := []Hook{}
+
+	resp, err := s.client.Do(req, &hooks)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return hooks, resp, nil
+}
+
+// Get retrieves a webhook by its id.
+// See https://docs.github.com/en/rest/webhooks/repos#get-a-repository-webhook
+// See https://docs.github.com/en/rest/orgs/webhooks#get-an-organization-webhook
+func (s *HookService) Get(ctx context.Context, id int) (*Hook, *Response, error) {
+	url := fmt.Sprintf("%s/hooks/%d", s.basePath, id)
+	req, err := s.client.NewRequest(ctx, "GET", url, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	hook := new(Hook)
+
+	resp, err := s.client.Do(req, hook)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return hook, resp, nil
+}
+
+// Create creates a new webhook.
+// See https://docs.github.com/en/rest/webhooks/repos#create-a-repository-webhook
+// See https://docs.github.com/en/rest/orgs/webhooks#create-an-organization-webhook
+func (s *HookService) Create(ctx context.Context, params HookParams) (*Hook, *Response, error) {
+	url := fmt.Sprintf("%s/hooks", s.basePath)
+	req, err := s.client.NewRequest(ctx, "POST", url, params)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	hook := new(Hook)
+
+	resp, err := s.client.Do(req, hook)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return hook, resp, nil
+}
+
+// Update updates an existing webhook.
+// See https://docs.github.com/en/rest/webhooks/repos#update-a-repository-webhook
+// See https://docs.github.com/en/rest/orgs/webhooks#update-an-organization-webhook
+func (s *HookService) Update(ctx context.Context, id int, params HookParams) (*Hook, *Response, error) {
+	url := fmt.Sprintf("%s/hooks/%d", s.basePath, id)
+	req, err := s.client.NewRequest(ctx, "PATCH", url, params)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	hook := new(Hook)
+
+	resp, err := s.client.Do(req, hook)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return hook, resp, nil
+}
+
+// Delete deletes a webhook by its id.
+// See https://docs.github.com/en/rest/webhooks/repos#delete-a-repository-webhook
+// See https://docs.github.com/en/rest/orgs/webhooks#delete-an-organization-webhook
+func (s *HookService) Delete(ctx context.Context, id int) (*Response, error) {
+	url := fmt.Sprintf("%s/hooks/%d", s.basePath, id)
+	req, err := s.client.NewRequest(ctx, "DELETE", url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// Ping triggers a ping event to be sent to a webhook.
+// See https://docs.github.com/en/rest/webhooks/repos#ping-a-repository-webhook
+// See https://docs.github.com/en/rest/orgs/webhooks#ping-an-organization-webhook
+func (s *HookService) Ping(ctx context.Context, id int) (*Response, error) {
+	url := fmt.Sprintf("%s/hooks/%d/pings", s.basePath, id)
+	req, err := s.client.NewRequest(ctx, "POST", url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// Test triggers a push event for the latest push to be sent to a webhook.
+// This is only available for repository webhooks.
+// See https://docs.github.com/en/rest/webhooks/repos#test-the-push-repository-webhook
+func (s *HookService) Test(ctx context.Context, id int) (*Response, error) {
+	url := fmt.Sprintf("%s/hooks/%d/tests", s.basePath, id)
+	req, err := s.client.NewRequest(ctx, "POST", url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// Deliveries retrieves the deliveries for a webhook page by page.
+// This endpoint uses cursor-based pagination; the cursor for the next page is returned in Response.Pages.Cursor.
+// See https://docs.github.com/en/rest/webhooks/repo-deliveries#list-deliveries-for-a-repository-webhook
+// See https://docs.github.com/en/rest/orgs/webhooks#list-deliveries-for-an-organization-webhook
+func (s *HookService) Deliveries(ctx context.Context, id, pageSize int, cursor string) ([]HookDelivery, *Response, error) {
+	url := fmt.Sprintf("%s/hooks/%d/deliveries", s.basePath, id)
+	req, err := s.client.NewPageRequest(ctx, "GET", url, pageSize, 0, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	q := req.URL.Query()
+	if cursor != "" {
+		q.Add("cursor", cursor)
+	}
+	req.URL.RawQuery = q.Encode()
+
+	deliveries := []HookDelivery{}
+
+	resp, err := s.client.Do(req, &deliveries)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return deliveries, resp, nil
+}
+
+// Delivery retrieves a delivery for a webhook including its request and response.
+// See https://docs.github.com/en/rest/webhooks/repo-deliveries#get-a-delivery-for-a-repository-webhook
+// See https://docs.github.com/en/rest/orgs/webhooks#get-a-webhook-delivery-for-an-organization-webhook
+func (s *HookService) Delivery(ctx context.Context, id, deliveryID int) (*HookDelivery, *Response, error) {
+	url := fmt.Sprintf("%s/hooks/%d/deliveries/%d", s.basePath, id, deliveryID)
+	req, err := s.client.NewRequest(ctx, "GET", url, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	delivery := new(HookDelivery)
+
+	resp, err := s.client.Do(req, delivery)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return delivery, resp, nil
+}
+
+// Redeliver redelivers a delivery for a webhook.
+// See https://docs.github.com/en/rest/webhooks/repo-deliveries#redeliver-a-delivery-for-a-repository-webhook
+// See https://docs.github.com/en/rest/orgs/webhooks#redeliver-a-delivery-for-an-organization-webhook
+func (s *HookService) Redeliver(ctx context.Context, id, deliveryID int) (*Response, error) {
+	url := fmt.Sprintf("%s/hooks/%d/deliveries/%d/attempts", s.basePath, id, deliveryID)
+	req, err := s.client.NewRequest(ctx, "POST", url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// RedeliverFailed redelivers every delivery for a webhook that has failed since a given time.
+// Deliveries are grouped by their GUID, so an event is only redelivered if its most recent attempt has failed.
+// It returns the deliveries that have been redelivered.
+func (s *HookService) RedeliverFailed(ctx context.Context, id int, since time.Time) ([]HookDelivery, error) {
+	seen := map[string]bool{}
+	failed := []HookDelivery{}
+
+	for cursor, done := "", false; !done; {
+		deliveries, resp, err := s.Deliveries(ctx, id, 100, cursor)
+		if err != nil {
+			return nil, err
+		}
+
+		// Deliveries are returned from the most recent to the least recent.
+		for _, d := range deliveries {
+			if d.DeliveredAt.Before(since) {
+				done = true
+				break
+			}
+
+			if !seen[d.GUID] {
+				seen[d.GUID] = true
+				if d.Failed() {
+					failed = append(failed, d)
+				}
+			}
+		}
+
+		if cursor = resp.Pages.Cursor; cursor == "" {
+			done = true
+		}
+	}
+
+	redelivered := []HookDelivery{}
+	for _, d := range failed {
+		if _, err := s.Redeliver(ctx, id, d.ID); err != nil {
+			return redelivered, err
+		}
+		redelivered = append(redelivered, d)
+	}
+
+	return redelivered, nil
+}
@@ -0,0 +1,1073 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	hookBody = `{
+		"type": "Repository",
+		"id": 12345678,
+		"name": "web",
+		"active": true,
+		"events": [
+			"push",
+			"pull_request"
+		],
+		"config": {
+			"content_type": "json",
+			"insecure_ssl": "0",
+			"url": "https://example.com/webhook"
+		},
+		"updated_at": "2020-10-31T14:00:00Z",
+		"created_at": "2020-10-20T19:59:59Z",
+		"url": "https://api.github.com/repos/octocat/Hello-World/hooks/12345678",
+		"test_url": "https://api.github.com/repos/octocat/Hello-World/hooks/12345678/test",
+		"ping_url": "https://api.github.com/repos/octocat/Hello-World/hooks/12345678/pings",
+		"deliveries_url": "https://api.github.com/repos/octocat/Hello-World/hooks/12345678/deliveries",
+		"last_response": {
+			"code": null,
+			"status": "unused",
+			"message": null
+		}
+	}`
+
+	hooksBody = `[` + hookBody + `]`
+
+	deliveriesBody = `[
+		{
+			"id": 12345678,
+			"guid": "0b989ba4-242f-11e5-81e1-c7b6966d2516",
+			"delivered_at": "2020-10-31T14:00:00Z",
+			"redelivery": false,
+			"duration": 0.27,
+			"status": "Internal Server Error",
+			"status_code": 500,
+			"event": "push",
+			"action": null,
+			"installation_id": null,
+			"repository_id": 1296269
+		},
+		{
+			"id": 123456789,
+			"guid": "0b989ba4-242f-11e5-81e1-c7b6966d2516",
+			"delivered_at": "2020-10-30T14:00:00Z",
+			"redelivery": false,
+			"duration": 0.27,
+			"status": "OK",
+			"status_code": 200,
+			"event": "push",
+			"action": null,
+			"installation_id": null,
+			"repository_id": 1296269
+		}
+	]`
+
+	deliveryBody = `{
+		"id": 12345678,
+		"guid": "0b989ba4-242f-11e5-81e1-c7b6966d2516",
+		"delivered_at": "2020-10-31T14:00:00Z",
+		"redelivery": false,
+		"duration": 0.27,
+		"status": "Internal Server Error",
+		"status_code": 500,
+		"event": "push",
+		"action": null,
+		"installation_id": null,
+		"repository_id": 1296269,
+		"url": "https://www.example.com",
+		"request": {
+			"headers": {
+				"X-GitHub-Event": "push"
+			},
+			"payload": {
+				"ref": "refs/heads/main"
+			}
+		},
+		"response": {
+			"headers": {
+				"Content-Type": "text/plain"
+			},
+			"payload": "oops"
+		}
+	}`
+)
+
+var (
+	repositoryID = 1296269
+
+	hook = Hook{
+		ID:     12345678,
+		Type:   "Repository",
+		Name:   "web",
+		Active: true,
+		Events: []string{"push", "pull_request"},
+		Config: HookConfig{
+			URL:         "https://example.com/webhook",
+			ContentType: "json",
+			InsecureSSL: "0",
+		},
+		LastResponse: HookLastResponse{
+			Status: "unused",
+		},
+		URL:           "https://api.github.com/repos/octocat/Hello-World/hooks/12345678",
+		TestURL:       "https://api.github.com/repos/octocat/Hello-World/hooks/12345678/test",
+		PingURL:       "https://api.github.com/repos/octocat/Hello-World/hooks/12345678/pings",
+		DeliveriesURL: "https://api.github.com/repos/octocat/Hello-World/hooks/12345678/deliveries",
+		CreatedAt:     parseGitHubTime("2020-10-20T19:59:59Z"),
+		UpdatedAt:     parseGitHubTime("2020-10-31T14:00:00Z"),
+	}
+
+	hookParams = HookParams{
+		Name:   "web",
+		Active: true,
+		Events: []string{"push", "pull_request"},
+		Config: HookConfig{
+			URL:         "https://example.com/webhook",
+			ContentType: "json",
+		},
+	}
+
+	delivery1 = HookDelivery{
+		ID:           12345678,
+		GUID:         "0b989ba4-242f-11e5-81e1-c7b6966d2516",
+		Event:        "push",
+		Status:       "Internal Server Error",
+		StatusCode:   500,
+		Duration:     0.27,
+		RepositoryID: &repositoryID,
+		DeliveredAt:  parseGitHubTime("2020-10-31T14:00:00Z"),
+	}
+
+	delivery2 = HookDelivery{
+		ID:           123456789,
+		GUID:         "0b989ba4-242f-11e5-81e1-c7b6966d2516",
+		Event:        "push",
+		Status:       "OK",
+		StatusCode:   200,
+		Duration:     0.27,
+		RepositoryID: &repositoryID,
+		DeliveredAt:  parseGitHubTime("2020-10-30T14:00:00Z"),
+	}
+
+	delivery = HookDelivery{
+		ID:           12345678,
+		GUID:         "0b989ba4-242f-11e5-81e1-c7b6966d2516",
+		Event:        "push",
+		Status:       "Internal Server Error",
+		StatusCode:   500,
+		Duration:     0.27,
+		RepositoryID: &repositoryID,
+		URL:          "https://www.example.com",
+		Request: &HookDeliveryRequest{
+			Headers: map[string]string{"X-GitHub-Event": "push"},
+			Payload: json.RawMessage(`{
+				"ref": "refs/heads/main"
+			}`),
+		},
+		Response: &HookDeliveryResponse{
+			Headers: map[string]string{"Content-Type": "text/plain"},
+			Payload: "oops",
+		},
+		DeliveredAt: parseGitHubTime("2020-10-31T14:00:00Z"),
+	}
+)
+
+func TestHookDelivery_Failed(t *testing.T) {
+	tests := []struct {
+		name           string
+		d              HookDelivery
+		expectedFailed bool
+	}{
+		{"Timeout", HookDelivery{StatusCode: 0}, true},
+		{"OK", HookDelivery{StatusCode: 200}, false},
+		{"NoContent", HookDelivery{StatusCode: 204}, false},
+		{"Redirect", HookDelivery{StatusCode: 302}, true},
+		{"InternalServerError", HookDelivery{StatusCode: 500}, true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expectedFailed, tc.d.Failed())
+		})
+	}
+}
+
+func TestHookService_List(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	tests := []struct {
+		name             string
+		mockResponses    []MockResponse
+		s                *HookService
+		ctx              context.Context
+		pageSize         int
+		pageNo           int
+		expectedHooks    []Hook
+		expectedResponse *Response
+		expectedError    string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &HookService{
+				client:   c,
+				basePath: "/repos/octocat/Hello-World",
+			},
+			ctx:           nil,
+			pageSize:      10,
+			pageNo:        1,
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/hooks", 401, http.Header{}, `{
+					"message": "Bad credentials"
+				}`},
+			},
+			s: &HookService{
+				client:   c,
+				basePath: "/repos/octocat/Hello-World",
+			},
+			ctx:           context.Background(),
+			pageSize:      10,
+			pageNo:        1,
+			expectedError: `GET /repos/octocat/Hello-World/hooks: 401 Bad credentials`,
+		},
+		{
+			name: "InvalidResponse",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/hooks", 200, http.Header{}, `[`},
+			},
+			s: &HookService{
+				client:   c,
+				basePath: "/repos/octocat/Hello-World",
+			},
+			ctx:           context.Background(),
+			pageSize:      10,
+			pageNo:        1,
+			expectedError: `unexpected EOF`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"GET", "/orgs/octo-org/hooks", 200, header, hooksBody},
+			},
+			s: &HookService{
+				client:   c,
+				basePath: "/orgs/octo-org",
+			},
+			ctx:           context.Background(),
+			pageSize:      10,
+			pageNo:        1,
+			expectedHooks: []Hook{hook},
+			expectedResponse: &Response{
+				Pages: expectedPages,
+				Rate:  expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			defer ts.Close()
+
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			hooks, resp, err := tc.s.List(tc.ctx, tc.pageSize, tc.pageNo)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, hooks)
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedHooks, hooks)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Pages, resp.Pages)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestHookService_Get(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	tests := []struct {
+		name             string
+		mockResponses    []MockResponse
+		s                *HookService
+		ctx              context.Context
+		id               int
+		expectedHook     *Hook
+		expectedResponse *Response
+		expectedError    string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &HookService{
+				client:   c,
+				basePath: "/repos/octocat/Hello-World",
+			},
+			ctx:           nil,
+			id:            12345678,
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/hooks/12345678", 401, http.Header{}, `{
+					"message": "Bad credentials"
+				}`},
+			},
+			s: &HookService{
+				client:   c,
+				basePath: "/repos/octocat/Hello-World",
+			},
+			ctx:           context.Background(),
+			id:            12345678,
+			expectedError: `GET /repos/octocat/Hello-World/hooks/12345678: 401 Bad credentials`,
+		},
+		{
+			name: "InvalidResponse",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/hooks/12345678", 200, http.Header{}, `{`},
+			},
+			s: &HookService{
+				client:   c,
+				basePath: "/repos/octocat/Hello-World",
+			},
+			ctx:           context.Background(),
+			id:            12345678,
+			expectedError: `unexpected EOF`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/hooks/12345678", 200, header, hookBody},
+			},
+			s: &HookService{
+				client:   c,
+				basePath: "/repos/octocat/Hello-World",
+			},
+			ctx:          context.Background(),
+			id:           12345678,
+			expectedHook: &hook,
+			expectedResponse: &Response{
+				Rate: expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			defer ts.Close()
+
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			hook, resp, err := tc.s.Get(tc.ctx, tc.id)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, hook)
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedHook, hook)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestHookService_Create(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	tests := []struct {
+		name             string
+		mockResponses    []MockResponse
+		s                *HookService
+		ctx              context.Context
+		params           HookParams
+		expectedHook     *Hook
+		expectedResponse *Response
+		expectedError    string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &HookService{
+				client:   c,
+				basePath: "/repos/octocat/Hello-World",
+			},
+			ctx:           nil,
+			params:        hookParams,
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"POST", "/repos/octocat/Hello-World/hooks", 401, http.Header{}, `{
+					"message": "Bad credentials"
+				}`},
+			},
+			s: &HookService{
+				client:   c,
+				basePath: "/repos/octocat/Hello-World",
+			},
+			ctx:           context.Background(),
+			params:        hookParams,
+			expectedError: `POST /repos/octocat/Hello-World/hooks: 401 Bad credentials`,
+		},
+		{
+			name: "InvalidResponse",
+			mockResponses: []MockResponse{
+				{"POST", "/repos/octocat/Hello-World/hooks", 201, http.Header{}, `{`},
+			},
+			s: &HookService{
+				client:   c,
+				basePath: "/repos/octocat/Hello-World",
+			},
+			ctx:           context.Background(),
+			params:        hookParams,
+			expectedError: `unexpected EOF`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"POST", "/repos/octocat/Hello-World/hooks", 201, header, hookBody},
+			},
+			s: &HookService{
+				client:   c,
+				basePath: "/repos/octocat/Hello-World",
+			},
+			ctx:          context.Background(),
+			params:       hookParams,
+			expectedHook: &hook,
+			expectedResponse: &Response{
+				Rate: expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			defer ts.Close()
+
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			hook, resp, err := tc.s.Create(tc.ctx, tc.params)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, hook)
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedHook, hook)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestHookService_Update(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	tests := []struct {
+		name             string
+		mockResponses    []MockResponse
+		s                *HookService
+		ctx              context.Context
+		id               int
+		params           HookParams
+		expectedHook     *Hook
+		expectedResponse *Response
+		expectedError    string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &HookService{
+				client:   c,
+				basePath: "/repos/octocat/Hello-World",
+			},
+			ctx:           nil,
+			id:            12345678,
+			params:        hookParams,
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"PATCH", "/repos/octocat/Hello-World/hooks/12345678", 401, http.Header{}, `{
+					"message": "Bad credentials"
+				}`},
+			},
+			s: &HookService{
+				client:   c,
+				basePath: "/repos/octocat/Hello-World",
+			},
+			ctx:           context.Background(),
+			id:            12345678,
+			params:        hookParams,
+			expectedError: `PATCH /repos/octocat/Hello-World/hooks/12345678: 401 Bad credentials`,
+		},
+		{
+			name: "InvalidResponse",
+			mockResponses: []MockResponse{
+				{"PATCH", "/repos/octocat/Hello-World/hooks/12345678", 200, http.Header{}, `{`},
+			},
+			s: &HookService{
+				client:   c,
+				basePath: "/repos/octocat/Hello-World",
+			},
+			ctx:           context.Background(),
+			id:            12345678,
+			params:        hookParams,
+			expectedError: `unexpected EOF`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"PATCH", "/repos/octocat/Hello-World/hooks/12345678", 200, header, hookBody},
+			},
+			s: &HookService{
+				client:   c,
+				basePath: "/repos/octocat/Hello-World",
+			},
+			ctx:          context.Background(),
+			id:           12345678,
+			params:       hookParams,
+			expectedHook: &hook,
+			expectedResponse: &Response{
+				Rate: expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			defer ts.Close()
+
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			hook, resp, err := tc.s.Update(tc.ctx, tc.id, tc.params)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, hook)
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedHook, hook)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestHookService_Actions(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	tests := []struct {
+		name             string
+		mockResponses    []MockResponse
+		s                *HookService
+		call             func(*HookService) (*Response, error)
+		expectedResponse *Response
+		expectedError    string
+	}{
+		{
+			name: "Delete_InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"DELETE", "/repos/octocat/Hello-World/hooks/12345678", 401, http.Header{}, `{
+					"message": "Bad credentials"
+				}`},
+			},
+			s: &HookService{
+				client:   c,
+				basePath: "/repos/octocat/Hello-World",
+			},
+			call: func(s *HookService) (*Response, error) {
+				return s.Delete(context.Background(), 12345678)
+			},
+			expectedError: `DELETE /repos/octocat/Hello-World/hooks/12345678: 401 Bad credentials`,
+		},
+		{
+			name: "Delete_Success",
+			mockResponses: []MockResponse{
+				{"DELETE", "/repos/octocat/Hello-World/hooks/12345678", 204, header, ``},
+			},
+			s: &HookService{
+				client:   c,
+				basePath: "/repos/octocat/Hello-World",
+			},
+			call: func(s *HookService) (*Response, error) {
+				return s.Delete(context.Background(), 12345678)
+			},
+			expectedResponse: &Response{
+				Rate: expectedRate,
+			},
+		},
+		{
+			name: "Ping_Success",
+			mockResponses: []MockResponse{
+				{"POST", "/orgs/octo-org/hooks/12345678/pings", 204, header, ``},
+			},
+			s: &HookService{
+				client:   c,
+				basePath: "/orgs/octo-org",
+			},
+			call: func(s *HookService) (*Response, error) {
+				return s.Ping(context.Background(), 12345678)
+			},
+			expectedResponse: &Response{
+				Rate: expectedRate,
+			},
+		},
+		{
+			name: "Test_InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"POST", "/repos/octocat/Hello-World/hooks/12345678/tests", 404, http.Header{}, `{
+					"message": "Not Found"
+				}`},
+			},
+			s: &HookService{
+				client:   c,
+				basePath: "/repos/octocat/Hello-World",
+			},
+			call: func(s *HookService) (*Response, error) {
+				return s.Test(context.Background(), 12345678)
+			},
+			expectedError: `POST /repos/octocat/Hello-World/hooks/12345678/tests: 404 Not Found`,
+		},
+		{
+			name: "Test_Success",
+			mockResponses: []MockResponse{
+				{"POST", "/repos/octocat/Hello-World/hooks/12345678/tests", 204, header, ``},
+			},
+			s: &HookService{
+				client:   c,
+				basePath: "/repos/octocat/Hello-World",
+			},
+			call: func(s *HookService) (*Response, error) {
+				return s.Test(context.Background(), 12345678)
+			},
+			expectedResponse: &Response{
+				Rate: expectedRate,
+			},
+		},
+		{
+			name: "Redeliver_InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"POST", "/repos/octocat/Hello-World/hooks/12345678/deliveries/12345678/attempts", 401, http.Header{}, `{
+					"message": "Bad credentials"
+				}`},
+			},
+			s: &HookService{
+				client:   c,
+				basePath: "/repos/octocat/Hello-World",
+			},
+			call: func(s *HookService) (*Response, error) {
+				return s.Redeliver(context.Background(), 12345678, 12345678)
+			},
+			expectedError: `POST /repos/octocat/Hello-World/hooks/12345678/deliveries/12345678/attempts: 401 Bad credentials`,
+		},
+		{
+			name: "Redeliver_Success",
+			mockResponses: []MockResponse{
+				{"POST", "/repos/octocat/Hello-World/hooks/12345678/deliveries/12345678/attempts", 202, header, `{}`},
+			},
+			s: &HookService{
+				client:   c,
+				basePath: "/repos/octocat/Hello-World",
+			},
+			call: func(s *HookService) (*Response, error) {
+				return s.Redeliver(context.Background(), 12345678, 12345678)
+			},
+			expectedResponse: &Response{
+				Rate: expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			defer ts.Close()
+
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			resp, err := tc.call(tc.s)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestHookService_Deliveries(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	tests := []struct {
+		name               string
+		mockResponses      []MockResponse
+		s                  *HookService
+		ctx                context.Context
+		id                 int
+		pageSize           int
+		cursor             string
+		expectedDeliveries []HookDelivery
+		expectedResponse   *Response
+		expectedError      string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &HookService{
+				client:   c,
+				basePath: "/repos/octocat/Hello-World",
+			},
+			ctx:           nil,
+			id:            12345678,
+			pageSize:      10,
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/hooks/12345678/deliveries", 401, http.Header{}, `{
+					"message": "Bad credentials"
+				}`},
+			},
+			s: &HookService{
+				client:   c,
+				basePath: "/repos/octocat/Hello-World",
+			},
+			ctx:           context.Background(),
+			id:            12345678,
+			pageSize:      10,
+			expectedError: `GET /repos/octocat/Hello-World/hooks/12345678/deliveries: 401 Bad credentials`,
+		},
+		{
+			name: "InvalidResponse",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/hooks/12345678/deliveries", 200, http.Header{}, `[`},
+			},
+			s: &HookService{
+				client:   c,
+				basePath: "/repos/octocat/Hello-World",
+			},
+			ctx:           context.Background(),
+			id:            12345678,
+			pageSize:      10,
+			expectedError: `unexpected EOF`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/hooks/12345678/deliveries", 200, http.Header{
+					headerLink: {`<https://api.github.com/repos/octocat/Hello-World/hooks/12345678/deliveries?per_page=10&cursor=v1_123>; rel="next"`},
+				}, deliveriesBody},
+			},
+			s: &HookService{
+				client:   c,
+				basePath: "/repos/octocat/Hello-World",
+			},
+			ctx:                context.Background(),
+			id:                 12345678,
+			pageSize:           10,
+			cursor:             "v1_456",
+			expectedDeliveries: []HookDelivery{delivery1, delivery2},
+			expectedResponse: &Response{
+				Pages: Pages{
+					Cursor: "v1_123",
+				},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			defer ts.Close()
+
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			deliveries, resp, err := tc.s.Deliveries(tc.ctx, tc.id, tc.pageSize, tc.cursor)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, deliveries)
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedDeliveries, deliveries)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Pages, resp.Pages)
+			}
+		})
+	}
+}
+
+func TestHookService_Delivery(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	tests := []struct {
+		name             string
+		mockResponses    []MockResponse
+		s                *HookService
+		ctx              context.Context
+		id               int
+		deliveryID       int
+		expectedDelivery *HookDelivery
+		expectedResponse *Response
+		expectedError    string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &HookService{
+				client:   c,
+				basePath: "/repos/octocat/Hello-World",
+			},
+			ctx:           nil,
+			id:            12345678,
+			deliveryID:    12345678,
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/hooks/12345678/deliveries/12345678", 401, http.Header{}, `{
+					"message": "Bad credentials"
+				}`},
+			},
+			s: &HookService{
+				client:   c,
+				basePath: "/repos/octocat/Hello-World",
+			},
+			ctx:           context.Background(),
+			id:            12345678,
+			deliveryID:    12345678,
+			expectedError: `GET /repos/octocat/Hello-World/hooks/12345678/deliveries/12345678: 401 Bad credentials`,
+		},
+		{
+			name: "InvalidResponse",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/hooks/12345678/deliveries/12345678", 200, http.Header{}, `{`},
+			},
+			s: &HookService{
+				client:   c,
+				basePath: "/repos/octocat/Hello-World",
+			},
+			ctx:           context.Background(),
+			id:            12345678,
+			deliveryID:    12345678,
+			expectedError: `unexpected EOF`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/hooks/12345678/deliveries/12345678", 200, header, deliveryBody},
+			},
+			s: &HookService{
+				client:   c,
+				basePath: "/repos/octocat/Hello-World",
+			},
+			ctx:              context.Background(),
+			id:               12345678,
+			deliveryID:       12345678,
+			expectedDelivery: &delivery,
+			expectedResponse: &Response{
+				Rate: expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			defer ts.Close()
+
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			delivery, resp, err := tc.s.Delivery(tc.ctx, tc.id, tc.deliveryID)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, delivery)
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedDelivery.ID, delivery.ID)
+				assert.Equal(t, tc.expectedDelivery.Request.Headers, delivery.Request.Headers)
+				assert.JSONEq(t, string(tc.expectedDelivery.Request.Payload), string(delivery.Request.Payload))
+				assert.Equal(t, tc.expectedDelivery.Response, delivery.Response)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestHookService_RedeliverFailed(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	tests := []struct {
+		name                string
+		mockResponses       []MockResponse
+		s                   *HookService
+		ctx                 context.Context
+		id                  int
+		since               time.Time
+		expectedRedelivered []HookDelivery
+		expectedError       string
+	}{
+		{
+			name: "DeliveriesFails",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/hooks/12345678/deliveries", 401, http.Header{}, `{
+					"message": "Bad credentials"
+				}`},
+			},
+			s: &HookService{
+				client:   c,
+				basePath: "/repos/octocat/Hello-World",
+			},
+			ctx:           context.Background(),
+			id:            12345678,
+			since:         parseGitHubTime("2020-10-01T00:00:00Z"),
+			expectedError: `GET /repos/octocat/Hello-World/hooks/12345678/deliveries: 401 Bad credentials`,
+		},
+		{
+			name: "RedeliverFails",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/hooks/12345678/deliveries", 200, header, deliveriesBody},
+				{"POST", "/repos/octocat/Hello-World/hooks/12345678/deliveries/12345678/attempts", 422, http.Header{}, `{
+					"message": "Validation Failed"
+				}`},
+			},
+			s: &HookService{
+				client:   c,
+				basePath: "/repos/octocat/Hello-World",
+			},
+			ctx:                 context.Background(),
+			id:                  12345678,
+			since:               parseGitHubTime("2020-10-01T00:00:00Z"),
+			expectedRedelivered: []HookDelivery{},
+			expectedError:       `POST /repos/octocat/Hello-World/hooks/12345678/deliveries/12345678/attempts: 422 Validation Failed`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/hooks/12345678/deliveries", 200, header, deliveriesBody},
+				{"POST", "/repos/octocat/Hello-World/hooks/12345678/deliveries/12345678/attempts", 202, header, `{}`},
+			},
+			s: &HookService{
+				client:   c,
+				basePath: "/repos/octocat/Hello-World",
+			},
+			ctx:                 context.Background(),
+			id:                  12345678,
+			since:               parseGitHubTime("2020-10-01T00:00:00Z"),
+			expectedRedelivered: []HookDelivery{delivery1},
+		},
+		{
+			name: "Success_NothingSince",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/hooks/12345678/deliveries", 200, header, deliveriesBody},
+			},
+			s: &HookService{
+				client:   c,
+				basePath: "/repos/octocat/Hello-World",
+			},
+			ctx:                 context.Background(),
+			id:                  12345678,
+			since:               parseGitHubTime("2020-11-01T00:00:00Z"),
+			expectedRedelivered: []HookDelivery{},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			defer ts.Close()
+
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			redelivered, err := tc.s.RedeliverFailed(tc.ctx, tc.id, tc.since)
+
+			if tc.expectedError != "" {
+				assert.Equal(t, tc.expectedRedelivered, redelivered)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedRedelivered, redelivered)
+			}
+		})
+	}
+}
@@ -0,0 +1,438 @@
+package github
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// statsMaxAttempts is the maximum number of times a statistics endpoint is requested while GitHub is computing the statistics.
+var statsMaxAttempts = 10
+
+// ErrStatsNotReady occurs when GitHub is still computing the statistics after the maximum number of attempts.
+var ErrStatsNotReady = errors.New("statistics are not ready yet")
+
+// InsightsService provides GitHub APIs for traffic and statistics of a repository.
+// See https://docs.github.com/en/rest/metrics
+type InsightsService struct {
+	client      *Client
+	owner, repo string
+}
+
+// Contributor is a GitHub repository contributor object.
+type Contributor struct {
+	User
+	Contributions int `json:"contributions"`
+}
+
+type (
+	// TrafficData is the traffic of a repository in a time period.
+	TrafficData struct {
+		Timestamp time.Time `json:"timestamp"`
+		Count     int       `json:"count"`
+		Uniques   int       `json:"uniques"`
+	}
+
+	// TrafficViews is the total number of views of a repository in the last 14 days.
+	TrafficViews struct {
+		Count   int           `json:"count"`
+		Uniques int           `json:"uniques"`
+		Views   []TrafficData `json:"views"`
+	}
+
+	// TrafficClones is the total number of clones of a repository in the last 14 days.
+	TrafficClones struct {
+		Count   int           `json:"count"`
+		Uniques int           `json:"uniques"`
+		Clones  []TrafficData `json:"clones"`
+	}
+
+	// TrafficReferrer is a top referral source of a repository in the last 14 days.
+	TrafficReferrer struct {
+		Referrer string `json:"referrer"`
+		Count    int    `json:"count"`
+		Uniques  int    `json:"uniques"`
+	}
+
+	// TrafficPath is a popular content of a repository in the last 14 days.
+	TrafficPath struct {
+		Path    string `json:"path"`
+		Title   string `json:"title"`
+		Count   int    `json:"count"`
+		Uniques int    `json:"uniques"`
+	}
+)
+
+type (
+	// WeeklyContribution is the contributions of a contributor in a week.
+	WeeklyContribution struct {
+		Week      Epoch `json:"w"`
+		Additions int   `json:"a"`
+		Deletions int   `json:"d"`
+		Commits   int   `json:"c"`
+	}
+
+	// ContributorStats is the contribution activity of a contributor.
+	ContributorStats struct {
+		Author User                 `json:"author"`
+		Total  int                  `json:"total"`
+		Weeks  []WeeklyContribution `json:"weeks"`
+	}
+
+	// WeeklyCommitActivity is the commit activity of a repository in a week.
+	// Days is the number of commits per day starting on Sunday.
+	WeeklyCommitActivity struct {
+		Week  Epoch `json:"week"`
+		Total int   `json:"total"`
+		Days  []int `json:"days"`
+	}
+
+	// WeeklyCodeFrequency is the number of additions and deletions of a repository in a week.
+	// Deletions is a negative number.
+	WeeklyCodeFrequency struct {
+		Week      Epoch
+		Additions int
+		Deletions int
+	}
+
+	// Participation is the weekly commit count of a repository for the last 52 weeks.
+	// All is the commit count for everyone and Owner is the commit count for the repository owner.
+	Participation struct {
+		All   []int `json:"all"`
+		Owner []int `json:"owner"`
+	}
+
+	// PunchCardHour is the number of commits of a repository in an hour of a day.
+	// Day is from 0 (Sunday) to 6 (Saturday) and Hour is from 0 to 23.
+	PunchCardHour struct {
+		Day     int
+		Hour    int
+		Commits int
+	}
+)
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// A code frequency is encoded as an array of week, additions, and deletions.
+func (f *WeeklyCodeFrequency) UnmarshalJSON(b []byte) error {
+	var v [3]int64
+	if err := json.Unmarshal(b, &v); err != nil {
+		return err
+	}
+
+	f.Week, f.Additions, f.Deletions = Epoch(v[0]), int(v[1]), int(v[2])
+
+	return nil
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// A punch card hour is encoded as an array of day, hour, and number of commits.
+func (h *PunchCardHour) UnmarshalJSON(b []byte) error {
+	var v [3]int
+	if err := json.Unmarshal(b, &v); err != nil {
+		return err
+	}
+
+	h.Day, h.Hour, h.Commits = v[0], v[1], v[2]
+
+	return nil
+}
+
+// Languages returns the languages of the repository and the number of bytes of code written in each language.
+// See https://docs.github.com/en/rest/repos/repos#list-repository-languages
+func (s *RepoService) Languages(ctx context.Context) (map[string]int, *Response, error) {
+	url := fmt.Sprintf("/repos/%s/%s/languages", s.owner, s.repo)
+	req, err := s.client.NewRequest(ctx, "GET", url, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	languages := map[string]int{}
+
+	resp, err := s.client.Do(req, &languages)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return languages, resp, nil
+}
+
+// Contributors retrieves all contributors of the repository page by page.
+// If anon is true, anonymous contributors are also included.
+// See https://docs.github.com/en/rest/repos/repos#list-repository-contributors
+func (s *RepoService) Contributors(ctx context.Context, pageSize, pageNo int, anon bool) ([]Contributor, *Response, error) {
+	url := fmt.Sprintf("/repos/%s/%s/contributors", s.owner, s.repo)
+	req, err := s.client.NewPageRequest(ctx, "GET", url, pageSize, pageNo, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if anon {
+		q := req.URL.Query()
+		q.Add("anon", "true")
+		req.URL.RawQuery = q.Encode()
+	}
+
+	contributors := []Contributor{}
+
+	resp, err := s.client.Do(req, &contributors)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return contributors, resp, nil
+}
+
+// Topics returns the topics of the repository.
+// See https://docs.github.com/en/rest/repos/repos#get-all-repository-topics
+func (s *RepoService) Topics(ctx context.Context) ([]string, *Response, error) {
+	url := fmt.Sprintf("/repos/%s/%s/topics", s.owner, s.repo)
+	req, err := s.client.NewRequest(ctx, "GET", url, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	body := new(struct {
+		Names []string `json:"names"`
+	})
+
+	resp, err := s.client.Do(req, body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return body.Names, resp, nil
+}
+
+// ReplaceTopics replaces all topics of the repository.
+// An empty list of topics clears all topics.
+// See https://docs.github.com/en/rest/repos/repos#replace-all-repository-topics
+func (s *RepoService) ReplaceTopics(ctx context.Context, topics []string) ([]string, *Response, error) {
+	url := fmt.Sprintf("/repos/%s/%s/topics", s.owner, s.repo)
+	body := struct {
+		Names []string `json:"names"`
+	}{
+		Names: topics,
+	}
+
+	if body.Names == nil {
+		body.Names = []string{}
+	}
+
+	req, err := s.client.NewRequest(ctx, "PUT", url, body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	result := new(struct {
+		Names []string `json:"names"`
+	})
+
+	resp, err := s.client.Do(req, result)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return result.Names, resp, nil
+}
+
+// Views returns the total number of views of the repository in the last 14 days per day or week.
+// See https://docs.github.com/en/rest/metrics/traffic#get-page-views
+func (s *InsightsService) Views(ctx context.Context, per string) (*TrafficViews, *Response, error) {
+	url := fmt.Sprintf("/repos/%s/%s/traffic/views", s.owner, s.repo)
+	req, err := s.client.NewRequest(ctx, "GET", url, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if per != "" {
+		q := req.URL.Query()
+		q.Add("per", per)
+		req.URL.RawQuery = q.Encode()
+	}
+
+	views := new(TrafficViews)
+
+	resp, err := s.client.Do(req, views)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return views, resp, nil
+}
+
+// Clones returns the total number of clones of the repository in the last 14 days per day or week.
+// See https://docs.github.com/en/rest/metrics/traffic#get-repository-clones
+func (s *InsightsService) Clones(ctx context.Context, per string) (*TrafficClones, *Response, error) {
+	url := fmt.Sprintf("/repos/%s/%s/traffic/clones", s.owner, s.repo)
+	req, err := s.client.NewRequest(ctx, "GET", url, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if per != "" {
+		q := req.URL.Query()
+		q.Add("per", per)
+		req.URL.RawQuery = q.Encode()
+	}
+
+	clones := new(TrafficClones)
+
+	resp, err := s.client.Do(req, clones)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return clones, resp, nil
+}
+
+// Referrers returns the top 10 referrers of the repository in the last 14 days.
+// See https://docs.github.com/en/rest/metrics/traffic#get-top-referral-sources
+func (s *InsightsService) Referrers(ctx context.Context) ([]TrafficReferrer, *Response, error) {
+	url := fmt.Sprintf("/repos/%s/%s/traffic/popular/referrers", s.owner, s.repo)
+	req, err := s.client.NewRequest(ctx, "GET", url, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	referrers := []TrafficReferrer{}
+
+	resp, err := s.client.Do(req, &referrers)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return referrers, resp, nil
+}
+
+// Paths returns the top 10 popular contents of the repository in the last 14 days.
+// See https://docs.github.com/en/rest/metrics/traffic#get-top-referral-paths
+func (s *InsightsService) Paths(ctx context.Context) ([]TrafficPath, *Response, error) {
+	url := fmt.Sprintf("/repos/%s/%s/traffic/popular/paths", s.owner, s.repo)
+	req, err := s.client.NewRequest(ctx, "GET", url, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	paths := []TrafficPath{}
+
+	resp, err := s.client.Do(req, &paths)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return paths, resp, nil
+}
+
+// stats retrieves a statistics endpoint and decodes the result into v.
+// GitHub responds with 202 Accepted while computing the statistics,
+// so the endpoint is polled with an exponential backoff until the statistics are ready.
+// If the statistics are still not ready after statsMaxAttempts requests, ErrStatsNotReady is returned.
+// If the repository has no statistics (204 No Content), v is left unchanged.
+func (s *InsightsService) stats(ctx context.Context, stat string, v interface{}) (*Response, error) {
+	url := fmt.Sprintf("/repos/%s/%s/stats/%s", s.owner, s.repo, stat)
+
+	var resp *Response
+	buf := new(bytes.Buffer)
+	attempts := 0
+
+	err := poll(ctx, pollInitialInterval, pollMaxInterval, func() (bool, error) {
+		if attempts++; attempts > statsMaxAttempts {
+			return false, ErrStatsNotReady
+		}
+
+		req, err := s.client.NewRequest(ctx, "GET", url, nil)
+		if err != nil {
+			return false, err
+		}
+
+		buf.Reset()
+		if resp, err = s.client.Do(req, buf); err != nil {
+			return false, err
+		}
+
+		return resp.StatusCode != http.StatusAccepted, nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode == http.StatusNoContent || buf.Len() == 0 {
+		return resp, nil
+	}
+
+	if err := json.Unmarshal(buf.Bytes(), v); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// ContributorStats returns the contribution activity of all contributors of the repository.
+// See https://docs.github.com/en/rest/metrics/statistics#get-all-contributor-commit-activity
+func (s *InsightsService) ContributorStats(ctx context.Context) ([]ContributorStats, *Response, error) {
+	stats := []ContributorStats{}
+
+	resp, err := s.stats(ctx, "contributors", &stats)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return stats, resp, nil
+}
+
+// CommitActivity returns the commit activity of the repository per week for the last year.
+// See https://docs.github.com/en/rest/metrics/statistics#get-the-last-year-of-commit-activity
+func (s *InsightsService) CommitActivity(ctx context.Context) ([]WeeklyCommitActivity, *Response, error) {
+	activity := []WeeklyCommitActivity{}
+
+	resp, err := s.stats(ctx, "commit_activity", &activity)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return activity, resp, nil
+}
+
+// CodeFrequency returns the number of additions and deletions of the repository per week.
+// See https://docs.github.com/en/rest/metrics/statistics#get-the-weekly-commit-activity
+func (s *InsightsService) CodeFrequency(ctx context.Context) ([]WeeklyCodeFrequency, *Response, error) {
+	frequency := []WeeklyCodeFrequency{}
+
+	resp, err := s.stats(ctx, "code_frequency", &frequency)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return frequency, resp, nil
+}
+
+// Participation returns the weekly commit count of the repository for the last 52 weeks.
+// See https://docs.github.com/en/rest/metrics/statistics#get-the-weekly-commit-count
+func (s *InsightsService) Participation(ctx context.Context) (*Participation, *Response, error) {
+	participation := new(Participation)
+
+	resp, err := s.stats(ctx, "participation", participation)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return participation, resp, nil
+}
+
+// PunchCard returns the number of commits of the repository per hour in each day.
+// See https://docs.github.com/en/rest/metrics/statistics#get-the-hourly-commit-count-for-each-day
+func (s *InsightsService) PunchCard(ctx context.Context) ([]PunchCardHour, *Response, error) {
+	punchCard := []PunchCardHour{}
+
+	resp, err := s.stats(ctx, "punch_card", &punchCard)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return punchCard, resp, nil
+}
@@ -0,0 +1,1769 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	languagesBody = `{
+		"Go": 30203,
+		"Makefile": 1024
+	}`
+
+	contributorsBody = `[
+		{
+			"login": "octocat",
+			"id": 1,
+			"type": "User",
+			"contributions": 32
+		}
+	]`
+
+	topicsBody = `{
+		"names": [
+			"octocat",
+			"api"
+		]
+	}`
+
+	trafficViewsBody = `{
+		"count": 14850,
+		"uniques": 3782,
+		"views": [
+			{
+				"timestamp": "2016-10-10T00:00:00Z",
+				"count": 440,
+				"uniques": 143
+			}
+		]
+	}`
+
+	trafficClonesBody = `{
+		"count": 173,
+		"uniques": 128,
+		"clones": [
+			{
+				"timestamp": "2016-10-10T00:00:00Z",
+				"count": 2,
+				"uniques": 1
+			}
+		]
+	}`
+
+	trafficReferrersBody = `[
+		{
+			"referrer": "Google",
+			"count": 4,
+			"uniques": 3
+		}
+	]`
+
+	trafficPathsBody = `[
+		{
+			"path": "/github/hubot",
+			"title": "github/hubot: A customizable life embetterment robot.",
+			"count": 3542,
+			"uniques": 2225
+		}
+	]`
+
+	contributorStatsBody = `[
+		{
+			"author": {
+				"login": "octocat",
+				"id": 1,
+				"type": "User"
+			},
+			"total": 135,
+			"weeks": [
+				{
+					"w": 1367712000,
+					"a": 6898,
+					"d": 77,
+					"c": 10
+				}
+			]
+		}
+	]`
+
+	commitActivityBody = `[
+		{
+			"days": [0, 3, 26, 20, 39, 1, 0],
+			"total": 89,
+			"week": 1336280400
+		}
+	]`
+
+	codeFrequencyBody = `[
+		[1302998400, 1124, -435]
+	]`
+
+	participationBody = `{
+		"all": [11, 21, 15],
+		"owner": [3, 2, 3]
+	}`
+
+	punchCardBody = `[
+		[0, 0, 5],
+		[0, 1, 43]
+	]`
+)
+
+var (
+	contributor = Contributor{
+		User: User{
+			ID:    1,
+			Login: "octocat",
+			Type:  "User",
+		},
+		Contributions: 32,
+	}
+
+	trafficViews = TrafficViews{
+		Count:   14850,
+		Uniques: 3782,
+		Views: []TrafficData{
+			{Timestamp: parseGitHubTime("2016-10-10T00:00:00Z"), Count: 440, Uniques: 143},
+		},
+	}
+
+	trafficClones = TrafficClones{
+		Count:   173,
+		Uniques: 128,
+		Clones: []TrafficData{
+			{Timestamp: parseGitHubTime("2016-10-10T00:00:00Z"), Count: 2, Uniques: 1},
+		},
+	}
+
+	trafficReferrer = TrafficReferrer{
+		Referrer: "Google",
+		Count:    4,
+		Uniques:  3,
+	}
+
+	trafficPath = TrafficPath{
+		Path:    "/github/hubot",
+		Title:   "github/hubot: A customizable life embetterment robot.",
+		Count:   3542,
+		Uniques: 2225,
+	}
+
+	contributorStats = ContributorStats{
+		Author: User{
+			ID:    1,
+			Login: "octocat",
+			Type:  "User",
+		},
+		Total: 135,
+		Weeks: []WeeklyContribution{
+			{Week: 1367712000, Additions: 6898, Deletions: 77, Commits: 10},
+		},
+	}
+
+	weeklyCommitActivity = WeeklyCommitActivity{
+		Week:  1336280400,
+		Total: 89,
+		Days:  []int{0, 3, 26, 20, 39, 1, 0},
+	}
+
+	weeklyCodeFrequency = WeeklyCodeFrequency{
+		Week:      1302998400,
+		Additions: 1124,
+		Deletions: -435,
+	}
+
+	participation = Participation{
+		All:   []int{11, 21, 15},
+		Owner: []int{3, 2, 3},
+	}
+)
+
+func TestWeeklyCodeFrequency_UnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		name              string
+		data              string
+		expectedFrequency WeeklyCodeFrequency
+		expectedError     string
+	}{
+		{
+			name:          "InvalidJSON",
+			data:          `{}`,
+			expectedError: "json: cannot unmarshal object into Go value of type [3]int64",
+		},
+		{
+			name:              "Success",
+			data:              `[1302998400, 1124, -435]`,
+			expectedFrequency: weeklyCodeFrequency,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var frequency WeeklyCodeFrequency
+			err := json.Unmarshal([]byte(tc.data), &frequency)
+
+			if tc.expectedError != "" {
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedFrequency, frequency)
+			}
+		})
+	}
+}
+
+func TestPunchCardHour_UnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		name          string
+		data          string
+		expectedHour  PunchCardHour
+		expectedError string
+	}{
+		{
+			name:          "InvalidJSON",
+			data:          `{}`,
+			expectedError: "json: cannot unmarshal object into Go value of type [3]int",
+		},
+		{
+			name:         "Success",
+			data:         `[0, 1, 43]`,
+			expectedHour: PunchCardHour{Day: 0, Hour: 1, Commits: 43},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var hour PunchCardHour
+			err := json.Unmarshal([]byte(tc.data), &hour)
+
+			if tc.expectedError != "" {
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedHour, hour)
+			}
+		})
+	}
+}
+
+func TestRepoService_Languages(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	tests := []struct {
+		name              string
+		mockResponses     []MockResponse
+		s                 *RepoService
+		ctx               context.Context
+		expectedLanguages map[string]int
+		expectedResponse  *Response
+		expectedError     string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           nil,
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/languages", 401, http.Header{}, `{
+					"message": "Bad credentials"
+				}`},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			expectedError: `GET /repos/octocat/Hello-World/languages: 401 Bad credentials`,
+		},
+		{
+			name: "InvalidResponse",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/languages", 200, http.Header{}, `{`},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			expectedError: `unexpected EOF`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/languages", 200, header, languagesBody},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx: context.Background(),
+			expectedLanguages: map[string]int{
+				"Go":       30203,
+				"Makefile": 1024,
+			},
+			expectedResponse: &Response{
+				Rate: expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			defer ts.Close()
+
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			languages, resp, err := tc.s.Languages(tc.ctx)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, languages)
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedLanguages, languages)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestRepoService_Contributors(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	tests := []struct {
+		name                 string
+		mockResponses        []MockResponse
+		s                    *RepoService
+		ctx                  context.Context
+		pageSize             int
+		pageNo               int
+		anon                 bool
+		expectedContributors []Contributor
+		expectedResponse     *Response
+		expectedError        string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           nil,
+			pageSize:      10,
+			pageNo:        1,
+			anon:          true,
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/contributors", 401, http.Header{}, `{
+					"message": "Bad credentials"
+				}`},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			pageSize:      10,
+			pageNo:        1,
+			anon:          true,
+			expectedError: `GET /repos/octocat/Hello-World/contributors: 401 Bad credentials`,
+		},
+		{
+			name: "InvalidResponse",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/contributors", 200, http.Header{}, `{`},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			pageSize:      10,
+			pageNo:        1,
+			anon:          true,
+			expectedError: `unexpected EOF`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/contributors", 200, header, contributorsBody},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:                  context.Background(),
+			pageSize:             10,
+			pageNo:               1,
+			anon:                 true,
+			expectedContributors: []Contributor{contributor},
+			expectedResponse: &Response{
+				Pages: expectedPages,
+				Rate:  expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			defer ts.Close()
+
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			contributors, resp, err := tc.s.Contributors(tc.ctx, tc.pageSize, tc.pageNo, tc.anon)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, contributors)
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedContributors, contributors)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Pages, resp.Pages)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestRepoService_Topics(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	tests := []struct {
+		name             string
+		mockResponses    []MockResponse
+		s                *RepoService
+		ctx              context.Context
+		expectedTopics   []string
+		expectedResponse *Response
+		expectedError    string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           nil,
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/topics", 401, http.Header{}, `{
+					"message": "Bad credentials"
+				}`},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			expectedError: `GET /repos/octocat/Hello-World/topics: 401 Bad credentials`,
+		},
+		{
+			name: "InvalidResponse",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/topics", 200, http.Header{}, `{`},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			expectedError: `unexpected EOF`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/topics", 200, header, topicsBody},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:            context.Background(),
+			expectedTopics: []string{"octocat", "api"},
+			expectedResponse: &Response{
+				Rate: expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			defer ts.Close()
+
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			topics, resp, err := tc.s.Topics(tc.ctx)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, topics)
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedTopics, topics)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestRepoService_ReplaceTopics(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	tests := []struct {
+		name             string
+		mockResponses    []MockResponse
+		s                *RepoService
+		ctx              context.Context
+		topics           []string
+		expectedTopics   []string
+		expectedResponse *Response
+		expectedError    string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           nil,
+			topics:        []string{"octocat", "api"},
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"PUT", "/repos/octocat/Hello-World/topics", 401, http.Header{}, `{
+					"message": "Bad credentials"
+				}`},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			topics:        []string{"octocat", "api"},
+			expectedError: `PUT /repos/octocat/Hello-World/topics: 401 Bad credentials`,
+		},
+		{
+			name: "InvalidResponse",
+			mockResponses: []MockResponse{
+				{"PUT", "/repos/octocat/Hello-World/topics", 200, http.Header{}, `{`},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			topics:        []string{"octocat", "api"},
+			expectedError: `unexpected EOF`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"PUT", "/repos/octocat/Hello-World/topics", 200, header, topicsBody},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:            context.Background(),
+			topics:         []string{"octocat", "api"},
+			expectedTopics: []string{"octocat", "api"},
+			expectedResponse: &Response{
+				Rate: expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			defer ts.Close()
+
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			names, resp, err := tc.s.ReplaceTopics(tc.ctx, tc.topics)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, names)
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedTopics, names)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestInsightsService_Views(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	tests := []struct {
+		name             string
+		mockResponses    []MockResponse
+		s                *InsightsService
+		ctx              context.Context
+		per              string
+		expectedViews    *TrafficViews
+		expectedResponse *Response
+		expectedError    string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &InsightsService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           nil,
+			per:           "day",
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/traffic/views", 401, http.Header{}, `{
+					"message": "Bad credentials"
+				}`},
+			},
+			s: &InsightsService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			per:           "day",
+			expectedError: `GET /repos/octocat/Hello-World/traffic/views: 401 Bad credentials`,
+		},
+		{
+			name: "InvalidResponse",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/traffic/views", 200, http.Header{}, `{`},
+			},
+			s: &InsightsService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			per:           "day",
+			expectedError: `unexpected EOF`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/traffic/views", 200, header, trafficViewsBody},
+			},
+			s: &InsightsService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			per:           "day",
+			expectedViews: &trafficViews,
+			expectedResponse: &Response{
+				Rate: expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			defer ts.Close()
+
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			views, resp, err := tc.s.Views(tc.ctx, tc.per)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, views)
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedViews, views)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestInsightsService_Clones(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	tests := []struct {
+		name             string
+		mockResponses    []MockResponse
+		s                *InsightsService
+		ctx              context.Context
+		per              string
+		expectedClones   *TrafficClones
+		expectedResponse *Response
+		expectedError    string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &InsightsService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           nil,
+			per:           "week",
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/traffic/clones", 401, http.Header{}, `{
+					"message": "Bad credentials"
+				}`},
+			},
+			s: &InsightsService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			per:           "week",
+			expectedError: `GET /repos/octocat/Hello-World/traffic/clones: 401 Bad credentials`,
+		},
+		{
+			name: "InvalidResponse",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/traffic/clones", 200, http.Header{}, `{`},
+			},
+			s: &InsightsService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			per:           "week",
+			expectedError: `unexpected EOF`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/traffic/clones", 200, header, trafficClonesBody},
+			},
+			s: &InsightsService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:            context.Background(),
+			per:            "week",
+			expectedClones: &trafficClones,
+			expectedResponse: &Response{
+				Rate: expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			defer ts.Close()
+
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			clones, resp, err := tc.s.Clones(tc.ctx, tc.per)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, clones)
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedClones, clones)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestInsightsService_Referrers(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	tests := []struct {
+		name              string
+		mockResponses     []MockResponse
+		s                 *InsightsService
+		ctx               context.Context
+		expectedReferrers []TrafficReferrer
+		expectedResponse  *Response
+		expectedError     string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &InsightsService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           nil,
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/traffic/popular/referrers", 401, http.Header{}, `{
+					"message": "Bad credentials"
+				}`},
+			},
+			s: &InsightsService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			expectedError: `GET /repos/octocat/Hello-World/traffic/popular/referrers: 401 Bad credentials`,
+		},
+		{
+			name: "InvalidResponse",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/traffic/popular/referrers", 200, http.Header{}, `{`},
+			},
+			s: &InsightsService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			expectedError: `unexpected EOF`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/traffic/popular/referrers", 200, header, trafficReferrersBody},
+			},
+			s: &InsightsService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:               context.Background(),
+			expectedReferrers: []TrafficReferrer{trafficReferrer},
+			expectedResponse: &Response{
+				Rate: expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			defer ts.Close()
+
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			referrers, resp, err := tc.s.Referrers(tc.ctx)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, referrers)
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedReferrers, referrers)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestInsightsService_Paths(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	tests := []struct {
+		name             string
+		mockResponses    []MockResponse
+		s                *InsightsService
+		ctx              context.Context
+		expectedPaths    []TrafficPath
+		expectedResponse *Response
+		expectedError    string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &InsightsService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           nil,
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/traffic/popular/paths", 401, http.Header{}, `{
+					"message": "Bad credentials"
+				}`},
+			},
+			s: &InsightsService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			expectedError: `GET /repos/octocat/Hello-World/traffic/popular/paths: 401 Bad credentials`,
+		},
+		{
+			name: "InvalidResponse",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/traffic/popular/paths", 200, http.Header{}, `{`},
+			},
+			s: &InsightsService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			expectedError: `unexpected EOF`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/traffic/popular/paths", 200, header, trafficPathsBody},
+			},
+			s: &InsightsService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			expectedPaths: []TrafficPath{trafficPath},
+			expectedResponse: &Response{
+				Rate: expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			defer ts.Close()
+
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			paths, resp, err := tc.s.Paths(tc.ctx)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, paths)
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedPaths, paths)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestInsightsService_ContributorStats(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	initialInterval, maxInterval := pollInitialInterval, pollMaxInterval
+	pollInitialInterval, pollMaxInterval = time.Millisecond, time.Millisecond
+	t.Cleanup(func() {
+		pollInitialInterval, pollMaxInterval = initialInterval, maxInterval
+	})
+
+	tests := []struct {
+		name             string
+		mockResponses    []MockResponse
+		s                *InsightsService
+		ctx              context.Context
+		expectedStats    []ContributorStats
+		expectedResponse *Response
+		expectedError    string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &InsightsService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           nil,
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/stats/contributors", 401, http.Header{}, `{
+					"message": "Bad credentials"
+				}`},
+			},
+			s: &InsightsService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			expectedError: `GET /repos/octocat/Hello-World/stats/contributors: 401 Bad credentials`,
+		},
+		{
+			name: "InvalidResponse",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/stats/contributors", 200, http.Header{}, `[`},
+			},
+			s: &InsightsService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			expectedError: `unexpected end of JSON input`,
+		},
+		{
+			name: "NoContent",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/stats/contributors", 204, header, ``},
+			},
+			s: &InsightsService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			expectedStats: []ContributorStats{},
+			expectedResponse: &Response{
+				Rate: expectedRate,
+			},
+		},
+		{
+			name: "Accepted",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/stats/contributors", 202, header, `{}`},
+				{"GET", "/repos/octocat/Hello-World/stats/contributors", 202, header, `{}`},
+				{"GET", "/repos/octocat/Hello-World/stats/contributors", 200, header, contributorStatsBody},
+			},
+			s: &InsightsService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			expectedStats: []ContributorStats{contributorStats},
+			expectedResponse: &Response{
+				Rate: expectedRate,
+			},
+		},
+		{
+			name: "NotReady",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/stats/contributors", 202, header, `{}`},
+			},
+			s: &InsightsService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			expectedError: `statistics are not ready yet`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/stats/contributors", 200, header, contributorStatsBody},
+			},
+			s: &InsightsService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			expectedStats: []ContributorStats{contributorStats},
+			expectedResponse: &Response{
+				Rate: expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			defer ts.Close()
+
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			stats, resp, err := tc.s.ContributorStats(tc.ctx)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, stats)
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedStats, stats)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestInsightsService_CommitActivity(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	initialInterval, maxInterval := pollInitialInterval, pollMaxInterval
+	pollInitialInterval, pollMaxInterval = time.Millisecond, time.Millisecond
+	t.Cleanup(func() {
+		pollInitialInterval, pollMaxInterval = initialInterval, maxInterval
+	})
+
+	tests := []struct {
+		name             string
+		mockResponses    []MockResponse
+		s                *InsightsService
+		ctx              context.Context
+		expectedActivity []WeeklyCommitActivity
+		expectedResponse *Response
+		expectedError    string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &InsightsService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           nil,
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/stats/commit_activity", 401, http.Header{}, `{
+					"message": "Bad credentials"
+				}`},
+			},
+			s: &InsightsService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			expectedError: `GET /repos/octocat/Hello-World/stats/commit_activity: 401 Bad credentials`,
+		},
+		{
+			name: "InvalidResponse",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/stats/commit_activity", 200, http.Header{}, `[`},
+			},
+			s: &InsightsService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			expectedError: `unexpected end of JSON input`,
+		},
+		{
+			name: "NoContent",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/stats/commit_activity", 204, header, ``},
+			},
+			s: &InsightsService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:              context.Background(),
+			expectedActivity: []WeeklyCommitActivity{},
+			expectedResponse: &Response{
+				Rate: expectedRate,
+			},
+		},
+		{
+			name: "Accepted",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/stats/commit_activity", 202, header, `{}`},
+				{"GET", "/repos/octocat/Hello-World/stats/commit_activity", 202, header, `{}`},
+				{"GET", "/repos/octocat/Hello-World/stats/commit_activity", 200, header, commitActivityBody},
+			},
+			s: &InsightsService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:              context.Background(),
+			expectedActivity: []WeeklyCommitActivity{weeklyCommitActivity},
+			expectedResponse: &Response{
+				Rate: expectedRate,
+			},
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/stats/commit_activity", 200, header, commitActivityBody},
+			},
+			s: &InsightsService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:              context.Background(),
+			expectedActivity: []WeeklyCommitActivity{weeklyCommitActivity},
+			expectedResponse: &Response{
+				Rate: expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			defer ts.Close()
+
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			activity, resp, err := tc.s.CommitActivity(tc.ctx)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, activity)
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedActivity, activity)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestInsightsService_CodeFrequency(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	initialInterval, maxInterval := pollInitialInterval, pollMaxInterval
+	pollInitialInterval, pollMaxInterval = time.Millisecond, time.Millisecond
+	t.Cleanup(func() {
+		pollInitialInterval, pollMaxInterval = initialInterval, maxInterval
+	})
+
+	tests := []struct {
+		name              string
+		mockResponses     []MockResponse
+		s                 *InsightsService
+		ctx               context.Context
+		expectedFrequency []WeeklyCodeFrequency
+		expectedResponse  *Response
+		expectedError     string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &InsightsService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           nil,
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/stats/code_frequency", 401, http.Header{}, `{
+					"message": "Bad credentials"
+				}`},
+			},
+			s: &InsightsService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			expectedError: `GET /repos/octocat/Hello-World/stats/code_frequency: 401 Bad credentials`,
+		},
+		{
+			name: "InvalidResponse",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/stats/code_frequency", 200, http.Header{}, `[`},
+			},
+			s: &InsightsService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			expectedError: `unexpected end of JSON input`,
+		},
+		{
+			name: "NoContent",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/stats/code_frequency", 204, header, ``},
+			},
+			s: &InsightsService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:               context.Background(),
+			expectedFrequency: []WeeklyCodeFrequency{},
+			expectedResponse: &Response{
+				Rate: expectedRate,
+			},
+		},
+		{
+			name: "Accepted",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/stats/code_frequency", 202, header, `{}`},
+				{"GET", "/repos/octocat/Hello-World/stats/code_frequency", 202, header, `{}`},
+				{"GET", "/repos/octocat/Hello-World/stats/code_frequency", 200, header, codeFrequencyBody},
+			},
+			s: &InsightsService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:               context.Background(),
+			expectedFrequency: []WeeklyCodeFrequency{weeklyCodeFrequency},
+			expectedResponse: &Response{
+				Rate: expectedRate,
+			},
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/stats/code_frequency", 200, header, codeFrequencyBody},
+			},
+			s: &InsightsService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:               context.Background(),
+			expectedFrequency: []WeeklyCodeFrequency{weeklyCodeFrequency},
+			expectedResponse: &Response{
+				Rate: expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			defer ts.Close()
+
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			frequency, resp, err := tc.s.CodeFrequency(tc.ctx)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, frequency)
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedFrequency, frequency)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestInsightsService_Participation(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	initialInterval, maxInterval := pollInitialInterval, pollMaxInterval
+	pollInitialInterval, pollMaxInterval = time.Millisecond, time.Millisecond
+	t.Cleanup(func() {
+		pollInitialInterval, pollMaxInterval = initialInterval, maxInterval
+	})
+
+	tests := []struct {
+		name                  string
+		mockResponses         []MockResponse
+		s                     *InsightsService
+		ctx                   context.Context
+		expectedParticipation *Participation
+		expectedResponse      *Response
+		expectedError         string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &InsightsService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           nil,
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/stats/participation", 401, http.Header{}, `{
+					"message": "Bad credentials"
+				}`},
+			},
+			s: &InsightsService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			expectedError: `GET /repos/octocat/Hello-World/stats/participation: 401 Bad credentials`,
+		},
+		{
+			name: "InvalidResponse",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/stats/participation", 200, http.Header{}, `[`},
+			},
+			s: &InsightsService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			expectedError: `unexpected end of JSON input`,
+		},
+		{
+			name: "NoContent",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/stats/participation", 204, header, ``},
+			},
+			s: &InsightsService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:                   context.Background(),
+			expectedParticipation: &Participation{},
+			expectedResponse: &Response{
+				Rate: expectedRate,
+			},
+		},
+		{
+			name: "Accepted",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/stats/participation", 202, header, `{}`},
+				{"GET", "/repos/octocat/Hello-World/stats/participation", 202, header, `{}`},
+				{"GET", "/repos/octocat/Hello-World/stats/participation", 200, header, participationBody},
+			},
+			s: &InsightsService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:                   context.Background(),
+			expectedParticipation: &participation,
+			expectedResponse: &Response{
+				Rate: expectedRate,
+			},
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/stats/participation", 200, header, participationBody},
+			},
+			s: &InsightsService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:                   context.Background(),
+			expectedParticipation: &participation,
+			expectedResponse: &Response{
+				Rate: expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			defer ts.Close()
+
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			participation, resp, err := tc.s.Participation(tc.ctx)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, participation)
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedParticipation, participation)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestInsightsService_PunchCard(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	initialInterval, maxInterval := pollInitialInterval, pollMaxInterval
+	pollInitialInterval, pollMaxInterval = time.Millisecond, time.Millisecond
+	t.Cleanup(func() {
+		pollInitialInterval, pollMaxInterval = initialInterval, maxInterval
+	})
+
+	tests := []struct {
+		name              string
+		mockResponses     []MockResponse
+		s                 *InsightsService
+		ctx               context.Context
+		expectedPunchCard []PunchCardHour
+		expectedResponse  *Response
+		expectedError     string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &InsightsService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           nil,
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/stats/punch_card", 401, http.Header{}, `{
+					"message": "Bad credentials"
+				}`},
+			},
+			s: &InsightsService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			expectedError: `GET /repos/octocat/Hello-World/stats/punch_card: 401 Bad credentials`,
+		},
+		{
+			name: "InvalidResponse",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/stats/punch_card", 200, http.Header{}, `[`},
+			},
+			s: &InsightsService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			expectedError: `unexpected end of JSON input`,
+		},
+		{
+			name: "NoContent",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/stats/punch_card", 204, header, ``},
+			},
+			s: &InsightsService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:               context.Background(),
+			expectedPunchCard: []PunchCardHour{},
+			expectedResponse: &Response{
+				Rate: expectedRate,
+			},
+		},
+		{
+			name: "Accepted",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/stats/punch_card", 202, header, `{}`},
+				{"GET", "/repos/octocat/Hello-World/stats/punch_card", 202, header, `{}`},
+				{"GET", "/repos/octocat/Hello-World/stats/punch_card", 200, header, punchCardBody},
+			},
+			s: &InsightsService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx: context.Background(),
+			expectedPunchCard: []PunchCardHour{
+				{Day: 0, Hour: 0, Commits: 5},
+				{Day: 0, Hour: 1, Commits: 43},
+			},
+			expectedResponse: &Response{
+				Rate: expectedRate,
+			},
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/stats/punch_card", 200, header, punchCardBody},
+			},
+			s: &InsightsService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx: context.Background(),
+			expectedPunchCard: []PunchCardHour{
+				{Day: 0, Hour: 0, Commits: 5},
+				{Day: 0, Hour: 1, Commits: 43},
+			},
+			expectedResponse: &Response{
+				Rate: expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			defer ts.Close()
+
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			punchCard, resp, err := tc.s.PunchCard(tc.ctx)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, punchCard)
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedPunchCard, punchCard)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
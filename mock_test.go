@@ -35,11 +35,33 @@ type MockResponse struct {
 	ResponseBody       string
 }
 
+// newHTTPTestServer creates a test server responding with the given mock responses.
+// If there are multiple mock responses for the same method and path, they are returned in order for consecutive calls,
+// and the last one is repeated after that.
 func newHTTPTestServer(mocks ...MockResponse) *httptest.Server {
-	r := mux.NewRouter()
+	type route struct{ method, path string }
+
+	routes := []route{}
+	responses := map[route][]MockResponse{}
 	for _, m := range mocks {
-		m := m
-		r.Methods(m.Method).Path(m.Path).HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		rt := route{m.Method, m.Path}
+		if _, ok := responses[rt]; !ok {
+			routes = append(routes, rt)
+		}
+		responses[rt] = append(responses[rt], m)
+	}
+
+	r := mux.NewRouter()
+	for _, rt := range routes {
+		calls := 0
+		ms := responses[rt]
+		r.Methods(rt.method).Path(rt.path).HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			m := ms[len(ms)-1]
+			if calls < len(ms) {
+				m = ms[calls]
+			}
+			calls++
+
 			for k, vals := range m.ResponseHeader {
 				for _, v := range vals {
 					w.Header().Add(k, v)
@@ -0,0 +1,65 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// OrgService provides GitHub APIs for an organization.
+// See https://docs.github.com/en/rest/reference/orgs
+type OrgService struct {
+	client *Client
+	org    string
+
+	// Services
+	Hooks        *HookService
+	Rulesets     *RulesetService
+	Secrets      *SecretsService
+	Variables    *VariablesService
+	Runners      *RunnersService
+	RunnerGroups *RunnerGroupsService
+}
+
+// Team is a GitHub team object.
+type Team struct {
+	ID          int    `json:"id"`
+	Name        string `json:"name"`
+	Slug        string `json:"slug"`
+	Description string `json:"description"`
+	Privacy     string `json:"privacy"`
+	Permission  string `json:"permission"`
+	URL         string `json:"url"`
+	HTMLURL     string `json:"html_url"`
+}
+
+// App is a GitHub App object.
+type App struct {
+	ID          int       `json:"id"`
+	Slug        string    `json:"slug"`
+	Name        string    `json:"name"`
+	Description string    `json:"description"`
+	Owner       User      `json:"owner"`
+	HTMLURL     string    `json:"html_url"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+// CreateRepo creates a new repository in the organization.
+// See https://docs.github.com/en/rest/repos/repos#create-an-organization-repository
+func (s *OrgService) CreateRepo(ctx context.Context, params CreateRepoParams) (*Repository, *Response, error) {
+	url := fmt.Sprintf("/orgs/%s/repos", s.org)
+	req, err := s.client.NewRequest(ctx, "POST", url, params)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	repository := new(Repository)
+
+	resp, err := s.client.Do(req, repository)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return repository, resp, nil
+}
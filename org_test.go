@@ -0,0 +1,114 @@
+package github
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOrgService_CreateRepo(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	params := CreateRepoParams{
+		Name:       "Hello-World",
+		Visibility: VisibilityInternal,
+		TeamID:     1,
+	}
+
+	tests := []struct {
+		name               string
+		mockResponses      []MockResponse
+		s                  *OrgService
+		ctx                context.Context
+		params             CreateRepoParams
+		expectedRepository *Repository
+		expectedResponse   *Response
+		expectedError      string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &OrgService{
+				client: c,
+				org:    "octo-org",
+			},
+			ctx:           nil,
+			params:        params,
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"POST", "/orgs/octo-org/repos", 401, http.Header{}, `{
+					"message": "Bad credentials"
+				}`},
+			},
+			s: &OrgService{
+				client: c,
+				org:    "octo-org",
+			},
+			ctx:           context.Background(),
+			params:        params,
+			expectedError: `POST /orgs/octo-org/repos: 401 Bad credentials`,
+		},
+		{
+			name: "InvalidResponse",
+			mockResponses: []MockResponse{
+				{"POST", "/orgs/octo-org/repos", 201, http.Header{}, `{`},
+			},
+			s: &OrgService{
+				client: c,
+				org:    "octo-org",
+			},
+			ctx:           context.Background(),
+			params:        params,
+			expectedError: `unexpected EOF`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"POST", "/orgs/octo-org/repos", 201, header, repositoryBody},
+			},
+			s: &OrgService{
+				client: c,
+				org:    "octo-org",
+			},
+			ctx:                context.Background(),
+			params:             params,
+			expectedRepository: &repository,
+			expectedResponse: &Response{
+				Rate: expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			defer ts.Close()
+
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			repository, resp, err := tc.s.CreateRepo(tc.ctx, tc.params)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, repository)
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedRepository, repository)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
@@ -0,0 +1,41 @@
+package github
+
+import (
+	"context"
+	"time"
+)
+
+var (
+	// pollInitialInterval is the interval before polling for the second time.
+	pollInitialInterval = time.Second
+	// pollMaxInterval is the maximum interval between two consecutive polls.
+	pollMaxInterval = 30 * time.Second
+)
+
+// poll calls a function repeatedly with an exponential backoff until it is done, it fails, or the context is cancelled.
+func poll(ctx context.Context, initial, max time.Duration, f func() (bool, error)) error {
+	interval := initial
+
+	for {
+		done, err := f()
+		if err != nil {
+			return err
+		}
+
+		if done {
+			return nil
+		}
+
+		timer := time.NewTimer(interval)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+
+		if interval *= 2; interval > max {
+			interval = max
+		}
+	}
+}
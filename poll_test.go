@@ -0,0 +1,73 @@
+package github
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPoll(t *testing.T) {
+	canceledCtx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	tests := []struct {
+		name          string
+		ctx           context.Context
+		initial       time.Duration
+		max           time.Duration
+		results       []bool
+		err           error
+		expectedCalls int
+		expectedError string
+	}{
+		{
+			name:          "Error",
+			ctx:           context.Background(),
+			initial:       time.Millisecond,
+			max:           4 * time.Millisecond,
+			results:       []bool{false},
+			err:           errors.New("error on polling"),
+			expectedCalls: 1,
+			expectedError: "error on polling",
+		},
+		{
+			name:          "ContextCanceled",
+			ctx:           canceledCtx,
+			initial:       time.Millisecond,
+			max:           4 * time.Millisecond,
+			results:       []bool{false, false},
+			expectedCalls: 1,
+			expectedError: "context canceled",
+		},
+		{
+			name:          "Success",
+			ctx:           context.Background(),
+			initial:       time.Millisecond,
+			max:           2 * time.Millisecond,
+			results:       []bool{false, false, false, true},
+			expectedCalls: 4,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			calls := 0
+			err := poll(tc.ctx, tc.initial, tc.max, func() (bool, error) {
+				done := tc.results[calls]
+				calls++
+				return done, tc.err
+			})
+
+			assert.Equal(t, tc.expectedCalls, calls)
+
+			if tc.expectedError != "" {
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
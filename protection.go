@@ -0,0 +1,414 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+type (
+	// ProtectionSetting is a setting that can be enabled or disabled in a GitHub branch protection.
+	ProtectionSetting struct {
+		Enabled bool `json:"enabled"`
+	}
+
+	// StatusCheck is a required status check in a GitHub branch protection.
+	// If AppID is nil, the app that has recently set the check is selected; -1 allows any app.
+	StatusCheck struct {
+		Context string `json:"context"`
+		AppID   *int   `json:"app_id,omitempty"`
+	}
+
+	// RequiredStatusChecks are the status checks required to pass before merging into a protected branch.
+	// If Strict is true, branches must be up to date with the base branch before merging.
+	// Contexts is deprecated in favor of Checks.
+	RequiredStatusChecks struct {
+		Strict   bool          `json:"strict"`
+		Contexts []string      `json:"contexts"`
+		Checks   []StatusCheck `json:"checks,omitempty"`
+	}
+
+	// BranchActors are the users, teams, and apps allowed to perform an action on a protected branch.
+	BranchActors struct {
+		Users []User `json:"users"`
+		Teams []Team `json:"teams"`
+		Apps  []App  `json:"apps"`
+	}
+
+	// BranchActorsParams is used for specifying the users (logins), teams (slugs), and apps (slugs)
+	// allowed to perform an action on a protected branch.
+	BranchActorsParams struct {
+		Users []string `json:"users"`
+		Teams []string `json:"teams"`
+		Apps  []string `json:"apps,omitempty"`
+	}
+
+	// RequiredReviews are the pull request reviews required before merging into a protected branch.
+	RequiredReviews struct {
+		DismissalRestrictions        *BranchActors `json:"dismissal_restrictions"`
+		DismissStaleReviews          bool          `json:"dismiss_stale_reviews"`
+		RequireCodeOwnerReviews      bool          `json:"require_code_owner_reviews"`
+		RequiredApprovingReviewCount int           `json:"required_approving_review_count"`
+		RequireLastPushApproval      bool          `json:"require_last_push_approval"`
+		BypassAllowances             *BranchActors `json:"bypass_pull_request_allowances"`
+	}
+
+	// RequiredReviewsParams is used for updating the pull request reviews required before merging into a protected branch.
+	RequiredReviewsParams struct {
+		DismissalRestrictions        *BranchActorsParams `json:"dismissal_restrictions,omitempty"`
+		DismissStaleReviews          bool                `json:"dismiss_stale_reviews"`
+		RequireCodeOwnerReviews      bool                `json:"require_code_owner_reviews"`
+		RequiredApprovingReviewCount int                 `json:"required_approving_review_count"` // Between 0 and 6
+		RequireLastPushApproval      bool                `json:"require_last_push_approval"`
+		BypassAllowances             *BranchActorsParams `json:"bypass_pull_request_allowances,omitempty"`
+	}
+
+	// BranchProtection is a GitHub branch protection object.
+	// A nil RequiredStatusChecks, RequiredPullRequestReviews, or Restrictions means the rule is not enabled.
+	BranchProtection struct {
+		RequiredStatusChecks           *RequiredStatusChecks `json:"required_status_checks"`
+		RequiredPullRequestReviews     *RequiredReviews      `json:"required_pull_request_reviews"`
+		Restrictions                   *BranchActors         `json:"restrictions"`
+		EnforceAdmins                  ProtectionSetting     `json:"enforce_admins"`
+		RequiredLinearHistory          ProtectionSetting     `json:"required_linear_history"`
+		AllowForcePushes               ProtectionSetting     `json:"allow_force_pushes"`
+		AllowDeletions                 ProtectionSetting     `json:"allow_deletions"`
+		BlockCreations                 ProtectionSetting     `json:"block_creations"`
+		RequiredConversationResolution ProtectionSetting     `json:"required_conversation_resolution"`
+		RequiredSignatures             ProtectionSetting     `json:"required_signatures"`
+		LockBranch                     ProtectionSetting     `json:"lock_branch"`
+		URL                            string                `json:"url"`
+	}
+
+	// BranchProtectionParams is used for updating a GitHub branch protection.
+	// A nil RequiredStatusChecks, RequiredPullRequestReviews, or Restrictions disables the rule.
+	// Signed commits are managed separately using SetRequiredSignatures.
+	BranchProtectionParams struct {
+		RequiredStatusChecks           *RequiredStatusChecks  `json:"required_status_checks"`
+		RequiredPullRequestReviews     *RequiredReviewsParams `json:"required_pull_request_reviews"`
+		Restrictions                   *BranchActorsParams    `json:"restrictions"`
+		EnforceAdmins                  bool                   `json:"enforce_admins"`
+		RequiredLinearHistory          bool                   `json:"required_linear_history"`
+		AllowForcePushes               bool                   `json:"allow_force_pushes"`
+		AllowDeletions                 bool                   `json:"allow_deletions"`
+		BlockCreations                 bool                   `json:"block_creations"`
+		RequiredConversationResolution bool                   `json:"required_conversation_resolution"`
+		LockBranch                     bool                   `json:"lock_branch"`
+	}
+)
+
+// MarshalJSON implements the json.Marshaler interface.
+// GitHub requires users and teams to be arrays, so nil slices are encoded as empty arrays.
+func (p BranchActorsParams) MarshalJSON() ([]byte, error) {
+	type params BranchActorsParams
+	v := params(p)
+
+	if v.Users == nil {
+		v.Users = []string{}
+	}
+	if v.Teams == nil {
+		v.Teams = []string{}
+	}
+
+	return json.Marshal(v)
+}
+
+// BranchProtection retrieves the protection of a branch in the repository.
+// If the branch is not protected, a NotFoundError is returned.
+// See https://docs.github.com/en/rest/branches/branch-protection#get-branch-protection
+func (s *RepoService) BranchProtection(ctx context.Context, branch string) (*BranchProtection, *Response, error) {
+	url := fmt.Sprintf("/repos/%s/%s/branches/%s/protection", s.owner, s.repo, escapePath(branch))
+	req, err := s.client.NewRequest(ctx, "GET", url, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	protection := new(BranchProtection)
+
+	resp, err := s.client.Do(req, protection)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return protection, resp, nil
+}
+
+// UpdateBranchProtection protects a branch in the repository or replaces its existing protection.
+// See https://docs.github.com/en/rest/branches/branch-protection#update-branch-protection
+func (s *RepoService) UpdateBranchProtection(ctx context.Context, branch string, params BranchProtectionParams) (*BranchProtection, *Response, error) {
+	url := fmt.Sprintf("/repos/%s/%s/branches/%s/protection", s.owner, s.repo, escapePath(branch))
+	req, err := s.client.NewRequest(ctx, "PUT", url, params)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	protection := new(BranchProtection)
+
+	resp, err := s.client.Do(req, protection)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return protection, resp, nil
+}
+
+// DeleteBranchProtection removes the protection of a branch in the repository.
+// See https://docs.github.com/en/rest/branches/branch-protection#delete-branch-protection
+func (s *RepoService) DeleteBranchProtection(ctx context.Context, branch string) (*Response, error) {
+	url := fmt.Sprintf("/repos/%s/%s/branches/%s/protection", s.owner, s.repo, escapePath(branch))
+	req, err := s.client.NewRequest(ctx, "DELETE", url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// RequiredStatusChecks retrieves the required status checks of a protected branch in the repository.
+// See https://docs.github.com/en/rest/branches/branch-protection#get-status-checks-protection
+func (s *RepoService) RequiredStatusChecks(ctx context.Context, branch string) (*RequiredStatusChecks, *Response, error) {
+	url := fmt.Sprintf("/repos/%s/%s/branches/%s/protection/required_status_checks", s.owner, s.repo, escapePath(branch))
+	req, err := s.client.NewRequest(ctx, "GET", url, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	checks := new(RequiredStatusChecks)
+
+	resp, err := s.client.Do(req, checks)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return checks, resp, nil
+}
+
+// UpdateRequiredStatusChecks updates the required status checks of a protected branch in the repository.
+// See https://docs.github.com/en/rest/branches/branch-protection#update-status-check-protection
+func (s *RepoService) UpdateRequiredStatusChecks(ctx context.Context, branch string, params RequiredStatusChecks) (*RequiredStatusChecks, *Response, error) {
+	url := fmt.Sprintf("/repos/%s/%s/branches/%s/protection/required_status_checks", s.owner, s.repo, escapePath(branch))
+	req, err := s.client.NewRequest(ctx, "PATCH", url, params)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	checks := new(RequiredStatusChecks)
+
+	resp, err := s.client.Do(req, checks)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return checks, resp, nil
+}
+
+// DeleteRequiredStatusChecks removes the required status checks of a protected branch in the repository.
+// See https://docs.github.com/en/rest/branches/branch-protection#remove-status-check-protection
+func (s *RepoService) DeleteRequiredStatusChecks(ctx context.Context, branch string) (*Response, error) {
+	url := fmt.Sprintf("/repos/%s/%s/branches/%s/protection/required_status_checks", s.owner, s.repo, escapePath(branch))
+	req, err := s.client.NewRequest(ctx, "DELETE", url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// RequiredReviews retrieves the required pull request reviews of a protected branch in the repository.
+// See https://docs.github.com/en/rest/branches/branch-protection#get-pull-request-review-protection
+func (s *RepoService) RequiredReviews(ctx context.Context, branch string) (*RequiredReviews, *Response, error) {
+	url := fmt.Sprintf("/repos/%s/%s/branches/%s/protection/required_pull_request_reviews", s.owner, s.repo, escapePath(branch))
+	req, err := s.client.NewRequest(ctx, "GET", url, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	reviews := new(RequiredReviews)
+
+	resp, err := s.client.Do(req, reviews)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return reviews, resp, nil
+}
+
+// UpdateRequiredReviews updates the required pull request reviews of a protected branch in the repository.
+// See https://docs.github.com/en/rest/branches/branch-protection#update-pull-request-review-protection
+func (s *RepoService) UpdateRequiredReviews(ctx context.Context, branch string, params RequiredReviewsParams) (*RequiredReviews, *Response, error) {
+	url := fmt.Sprintf("/repos/%s/%s/branches/%s/protection/required_pull_request_reviews", s.owner, s.repo, escapePath(branch))
+	req, err := s.client.NewRequest(ctx, "PATCH", url, params)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	reviews := new(RequiredReviews)
+
+	resp, err := s.client.Do(req, reviews)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return reviews, resp, nil
+}
+
+// DeleteRequiredReviews removes the required pull request reviews of a protected branch in the repository.
+// See https://docs.github.com/en/rest/branches/branch-protection#delete-pull-request-review-protection
+func (s *RepoService) DeleteRequiredReviews(ctx context.Context, branch string) (*Response, error) {
+	url := fmt.Sprintf("/repos/%s/%s/branches/%s/protection/required_pull_request_reviews", s.owner, s.repo, escapePath(branch))
+	req, err := s.client.NewRequest(ctx, "DELETE", url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// Restrictions retrieves the users, teams, and apps allowed to push to a protected branch in the repository.
+// See https://docs.github.com/en/rest/branches/branch-protection#get-access-restrictions
+func (s *RepoService) Restrictions(ctx context.Context, branch string) (*BranchActors, *Response, error) {
+	url := fmt.Sprintf("/repos/%s/%s/branches/%s/protection/restrictions", s.owner, s.repo, escapePath(branch))
+	req, err := s.client.NewRequest(ctx, "GET", url, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	actors := new(BranchActors)
+
+	resp, err := s.client.Do(req, actors)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return actors, resp, nil
+}
+
+// SetRestrictedUsers replaces the users (logins) allowed to push to a protected branch in the repository.
+// See https://docs.github.com/en/rest/branches/branch-protection#set-user-access-restrictions
+func (s *RepoService) SetRestrictedUsers(ctx context.Context, branch string, users []string) ([]User, *Response, error) {
+	result := []User{}
+
+	resp, err := s.setRestrictions(ctx, branch, "users", users, &result)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return result, resp, nil
+}
+
+// SetRestrictedTeams replaces the teams (slugs) allowed to push to a protected branch in the repository.
+// See https://docs.github.com/en/rest/branches/branch-protection#set-team-access-restrictions
+func (s *RepoService) SetRestrictedTeams(ctx context.Context, branch string, teams []string) ([]Team, *Response, error) {
+	result := []Team{}
+
+	resp, err := s.setRestrictions(ctx, branch, "teams", teams, &result)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return result, resp, nil
+}
+
+// SetRestrictedApps replaces the apps (slugs) allowed to push to a protected branch in the repository.
+// See https://docs.github.com/en/rest/branches/branch-protection#set-app-access-restrictions
+func (s *RepoService) SetRestrictedApps(ctx context.Context, branch string, apps []string) ([]App, *Response, error) {
+	result := []App{}
+
+	resp, err := s.setRestrictions(ctx, branch, "apps", apps, &result)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return result, resp, nil
+}
+
+func (s *RepoService) setRestrictions(ctx context.Context, branch, kind string, names []string, result interface{}) (*Response, error) {
+	if names == nil {
+		names = []string{}
+	}
+
+	url := fmt.Sprintf("/repos/%s/%s/branches/%s/protection/restrictions/%s", s.owner, s.repo, escapePath(branch), kind)
+	body := map[string][]string{
+		kind: names,
+	}
+
+	req, err := s.client.NewRequest(ctx, "PUT", url, body)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, result)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// DeleteRestrictions removes the push restrictions of a protected branch in the repository.
+// See https://docs.github.com/en/rest/branches/branch-protection#delete-access-restrictions
+func (s *RepoService) DeleteRestrictions(ctx context.Context, branch string) (*Response, error) {
+	url := fmt.Sprintf("/repos/%s/%s/branches/%s/protection/restrictions", s.owner, s.repo, escapePath(branch))
+	req, err := s.client.NewRequest(ctx, "DELETE", url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// SetAdminEnforcement enables/disables enforcing the protection of a branch for administrator users.
+// See https://docs.github.com/en/rest/branches/branch-protection#set-admin-branch-protection
+// See https://docs.github.com/en/rest/branches/branch-protection#delete-admin-branch-protection
+func (s *RepoService) SetAdminEnforcement(ctx context.Context, branch string, enabled bool) (*Response, error) {
+	return s.setProtectionSetting(ctx, branch, "enforce_admins", enabled)
+}
+
+// SetRequiredSignatures enables/disables requiring signed commits on a protected branch.
+// See https://docs.github.com/en/rest/branches/branch-protection#create-commit-signature-protection
+// See https://docs.github.com/en/rest/branches/branch-protection#delete-commit-signature-protection
+func (s *RepoService) SetRequiredSignatures(ctx context.Context, branch string, enabled bool) (*Response, error) {
+	return s.setProtectionSetting(ctx, branch, "required_signatures", enabled)
+}
+
+func (s *RepoService) setProtectionSetting(ctx context.Context, branch, setting string, enabled bool) (*Response, error) {
+	var method string
+	if enabled {
+		method = "POST"
+	} else {
+		method = "DELETE"
+	}
+
+	url := fmt.Sprintf("/repos/%s/%s/branches/%s/protection/%s", s.owner, s.repo, escapePath(branch), setting)
+	req, err := s.client.NewRequest(ctx, method, url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,1912 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	requiredStatusChecksBody = `{
+		"url": "https://api.github.com/repos/octocat/Hello-World/branches/main/protection/required_status_checks",
+		"strict": true,
+		"contexts": [
+			"ci/build"
+		],
+		"checks": [
+			{
+				"context": "ci/build",
+				"app_id": 15368
+			}
+		]
+	}`
+
+	requiredReviewsBody = `{
+		"url": "https://api.github.com/repos/octocat/Hello-World/branches/main/protection/required_pull_request_reviews",
+		"dismissal_restrictions": {
+			"users": [
+				{
+					"login": "octocat",
+					"id": 1,
+					"type": "User"
+				}
+			],
+			"teams": [
+				{
+					"id": 1,
+					"name": "Justice League",
+					"slug": "justice-league"
+				}
+			],
+			"apps": []
+		},
+		"dismiss_stale_reviews": true,
+		"require_code_owner_reviews": true,
+		"required_approving_review_count": 2,
+		"require_last_push_approval": false
+	}`
+
+	restrictionsBody = `{
+		"url": "https://api.github.com/repos/octocat/Hello-World/branches/main/protection/restrictions",
+		"users": [],
+		"teams": [
+			{
+				"id": 1,
+				"name": "Justice League",
+				"slug": "justice-league"
+			}
+		],
+		"apps": [
+			{
+				"id": 1,
+				"slug": "octoapp",
+				"name": "Octocat App",
+				"owner": {
+					"login": "octocat",
+					"id": 1,
+					"type": "User"
+				}
+			}
+		]
+	}`
+
+	branchProtectionBody = `{
+		"url": "https://api.github.com/repos/octocat/Hello-World/branches/main/protection",
+		"required_status_checks": {
+			"url": "https://api.github.com/repos/octocat/Hello-World/branches/main/protection/required_status_checks",
+			"strict": true,
+			"contexts": [
+				"ci/build"
+			],
+			"checks": [
+				{
+					"context": "ci/build",
+					"app_id": 15368
+				}
+			]
+		},
+		"required_pull_request_reviews": {
+			"url": "https://api.github.com/repos/octocat/Hello-World/branches/main/protection/required_pull_request_reviews",
+			"dismissal_restrictions": {
+				"users": [
+					{
+						"login": "octocat",
+						"id": 1,
+						"type": "User"
+					}
+				],
+				"teams": [
+					{
+						"id": 1,
+						"name": "Justice League",
+						"slug": "justice-league"
+					}
+				],
+				"apps": []
+			},
+			"dismiss_stale_reviews": true,
+			"require_code_owner_reviews": true,
+			"required_approving_review_count": 2,
+			"require_last_push_approval": false
+		},
+		"restrictions": {
+			"users": [],
+			"teams": [
+				{
+					"id": 1,
+					"name": "Justice League",
+					"slug": "justice-league"
+				}
+			],
+			"apps": [
+				{
+					"id": 1,
+					"slug": "octoapp",
+					"name": "Octocat App",
+					"owner": {
+						"login": "octocat",
+						"id": 1,
+						"type": "User"
+					}
+				}
+			]
+		},
+		"enforce_admins": {
+			"url": "https://api.github.com/repos/octocat/Hello-World/branches/main/protection/enforce_admins",
+			"enabled": true
+		},
+		"required_linear_history": {
+			"enabled": true
+		},
+		"allow_force_pushes": {
+			"enabled": false
+		},
+		"allow_deletions": {
+			"enabled": false
+		},
+		"block_creations": {
+			"enabled": false
+		},
+		"required_conversation_resolution": {
+			"enabled": true
+		},
+		"required_signatures": {
+			"url": "https://api.github.com/repos/octocat/Hello-World/branches/main/protection/required_signatures",
+			"enabled": true
+		},
+		"lock_branch": {
+			"enabled": false
+		}
+	}`
+)
+
+var (
+	checkAppID = 15368
+
+	requiredStatusChecks = RequiredStatusChecks{
+		Strict:   true,
+		Contexts: []string{"ci/build"},
+		Checks: []StatusCheck{
+			{
+				Context: "ci/build",
+				AppID:   &checkAppID,
+			},
+		},
+	}
+
+	requiredReviews = RequiredReviews{
+		DismissalRestrictions: &BranchActors{
+			Users: []User{
+				{ID: 1, Login: "octocat", Type: "User"},
+			},
+			Teams: []Team{
+				{ID: 1, Name: "Justice League", Slug: "justice-league"},
+			},
+			Apps: []App{},
+		},
+		DismissStaleReviews:          true,
+		RequireCodeOwnerReviews:      true,
+		RequiredApprovingReviewCount: 2,
+		RequireLastPushApproval:      false,
+	}
+
+	branchProtection = BranchProtection{
+		RequiredStatusChecks:       &requiredStatusChecks,
+		RequiredPullRequestReviews: &requiredReviews,
+		Restrictions: &BranchActors{
+			Users: []User{},
+			Teams: []Team{
+				{ID: 1, Name: "Justice League", Slug: "justice-league"},
+			},
+			Apps: []App{
+				{
+					ID:   1,
+					Slug: "octoapp",
+					Name: "Octocat App",
+					Owner: User{
+						ID:    1,
+						Login: "octocat",
+						Type:  "User",
+					},
+				},
+			},
+		},
+		EnforceAdmins:                  ProtectionSetting{Enabled: true},
+		RequiredLinearHistory:          ProtectionSetting{Enabled: true},
+		AllowForcePushes:               ProtectionSetting{Enabled: false},
+		AllowDeletions:                 ProtectionSetting{Enabled: false},
+		BlockCreations:                 ProtectionSetting{Enabled: false},
+		RequiredConversationResolution: ProtectionSetting{Enabled: true},
+		RequiredSignatures:             ProtectionSetting{Enabled: true},
+		LockBranch:                     ProtectionSetting{Enabled: false},
+		URL:                            "https://api.github.com/repos/octocat/Hello-World/branches/main/protection",
+	}
+)
+
+func TestBranchActorsParams_MarshalJSON(t *testing.T) {
+	tests := []struct {
+		name         string
+		p            BranchActorsParams
+		expectedJSON string
+	}{
+		{
+			name:         "Empty",
+			p:            BranchActorsParams{},
+			expectedJSON: `{"users":[],"teams":[]}`,
+		},
+		{
+			name: "WithActors",
+			p: BranchActorsParams{
+				Users: []string{"octocat"},
+				Teams: []string{"justice-league"},
+				Apps:  []string{"octoapp"},
+			},
+			expectedJSON: `{"users":["octocat"],"teams":["justice-league"],"apps":["octoapp"]}`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			b, err := json.Marshal(tc.p)
+
+			assert.NoError(t, err)
+			assert.JSONEq(t, tc.expectedJSON, string(b))
+		})
+	}
+}
+
+func TestRepoService_BranchProtection(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	tests := []struct {
+		name               string
+		mockResponses      []MockResponse
+		s                  *RepoService
+		ctx                context.Context
+		branch             string
+		expectedProtection *BranchProtection
+		expectedResponse   *Response
+		expectedError      string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           nil,
+			branch:        "main",
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/branches/main/protection", 404, http.Header{}, `{
+					"message": "Branch not protected"
+				}`},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			branch:        "main",
+			expectedError: `GET /repos/octocat/Hello-World/branches/main/protection: 404 Branch not protected`,
+		},
+		{
+			name: "InvalidResponse",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/branches/main/protection", 200, http.Header{}, `{`},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			branch:        "main",
+			expectedError: `unexpected EOF`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/branches/main/protection", 200, header, branchProtectionBody},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:                context.Background(),
+			branch:             "main",
+			expectedProtection: &branchProtection,
+			expectedResponse: &Response{
+				Rate: expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			defer ts.Close()
+
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			protection, resp, err := tc.s.BranchProtection(tc.ctx, tc.branch)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, protection)
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedProtection, protection)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestRepoService_UpdateBranchProtection(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	params := BranchProtectionParams{
+		RequiredStatusChecks: &RequiredStatusChecks{
+			Strict: true,
+			Checks: []StatusCheck{
+				{Context: "ci/build"},
+			},
+		},
+		RequiredPullRequestReviews: &RequiredReviewsParams{
+			DismissStaleReviews:          true,
+			RequireCodeOwnerReviews:      true,
+			RequiredApprovingReviewCount: 2,
+			BypassAllowances: &BranchActorsParams{
+				Users: []string{"octocat"},
+				Teams: []string{},
+			},
+		},
+		EnforceAdmins:                  true,
+		RequiredLinearHistory:          true,
+		RequiredConversationResolution: true,
+	}
+
+	tests := []struct {
+		name               string
+		mockResponses      []MockResponse
+		s                  *RepoService
+		ctx                context.Context
+		branch             string
+		params             BranchProtectionParams
+		expectedProtection *BranchProtection
+		expectedResponse   *Response
+		expectedError      string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           nil,
+			branch:        "main",
+			params:        params,
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"PUT", "/repos/octocat/Hello-World/branches/main/protection", 422, http.Header{}, `{
+					"message": "Validation Failed"
+				}`},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			branch:        "main",
+			params:        params,
+			expectedError: `PUT /repos/octocat/Hello-World/branches/main/protection: 422 Validation Failed`,
+		},
+		{
+			name: "InvalidResponse",
+			mockResponses: []MockResponse{
+				{"PUT", "/repos/octocat/Hello-World/branches/main/protection", 200, http.Header{}, `{`},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			branch:        "main",
+			params:        params,
+			expectedError: `unexpected EOF`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"PUT", "/repos/octocat/Hello-World/branches/main/protection", 200, header, branchProtectionBody},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:                context.Background(),
+			branch:             "main",
+			params:             params,
+			expectedProtection: &branchProtection,
+			expectedResponse: &Response{
+				Rate: expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			defer ts.Close()
+
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			protection, resp, err := tc.s.UpdateBranchProtection(tc.ctx, tc.branch, tc.params)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, protection)
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedProtection, protection)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestRepoService_DeleteBranchProtection(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	tests := []struct {
+		name             string
+		mockResponses    []MockResponse
+		s                *RepoService
+		ctx              context.Context
+		branch           string
+		expectedResponse *Response
+		expectedError    string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           nil,
+			branch:        "main",
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"DELETE", "/repos/octocat/Hello-World/branches/main/protection", 403, http.Header{}, `{
+					"message": "Resource not accessible by integration"
+				}`},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			branch:        "main",
+			expectedError: `DELETE /repos/octocat/Hello-World/branches/main/protection: 403 Resource not accessible by integration`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"DELETE", "/repos/octocat/Hello-World/branches/main/protection", 204, header, ``},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:    context.Background(),
+			branch: "main",
+			expectedResponse: &Response{
+				Rate: expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			defer ts.Close()
+
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			resp, err := tc.s.DeleteBranchProtection(tc.ctx, tc.branch)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestRepoService_RequiredStatusChecks(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	tests := []struct {
+		name             string
+		mockResponses    []MockResponse
+		s                *RepoService
+		ctx              context.Context
+		branch           string
+		expectedChecks   *RequiredStatusChecks
+		expectedResponse *Response
+		expectedError    string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           nil,
+			branch:        "main",
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/branches/main/protection/required_status_checks", 404, http.Header{}, `{
+					"message": "Required status checks not enabled"
+				}`},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			branch:        "main",
+			expectedError: `GET /repos/octocat/Hello-World/branches/main/protection/required_status_checks: 404 Required status checks not enabled`,
+		},
+		{
+			name: "InvalidResponse",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/branches/main/protection/required_status_checks", 200, http.Header{}, `{`},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			branch:        "main",
+			expectedError: `unexpected EOF`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/branches/main/protection/required_status_checks", 200, header, requiredStatusChecksBody},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:            context.Background(),
+			branch:         "main",
+			expectedChecks: &requiredStatusChecks,
+			expectedResponse: &Response{
+				Rate: expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			defer ts.Close()
+
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			checks, resp, err := tc.s.RequiredStatusChecks(tc.ctx, tc.branch)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, checks)
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedChecks, checks)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestRepoService_UpdateRequiredStatusChecks(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	tests := []struct {
+		name             string
+		mockResponses    []MockResponse
+		s                *RepoService
+		ctx              context.Context
+		branch           string
+		params           RequiredStatusChecks
+		expectedChecks   *RequiredStatusChecks
+		expectedResponse *Response
+		expectedError    string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           nil,
+			branch:        "main",
+			params:        requiredStatusChecks,
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"PATCH", "/repos/octocat/Hello-World/branches/main/protection/required_status_checks", 422, http.Header{}, `{
+					"message": "Validation Failed"
+				}`},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			branch:        "main",
+			params:        requiredStatusChecks,
+			expectedError: `PATCH /repos/octocat/Hello-World/branches/main/protection/required_status_checks: 422 Validation Failed`,
+		},
+		{
+			name: "InvalidResponse",
+			mockResponses: []MockResponse{
+				{"PATCH", "/repos/octocat/Hello-World/branches/main/protection/required_status_checks", 200, http.Header{}, `{`},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			branch:        "main",
+			params:        requiredStatusChecks,
+			expectedError: `unexpected EOF`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"PATCH", "/repos/octocat/Hello-World/branches/main/protection/required_status_checks", 200, header, requiredStatusChecksBody},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:            context.Background(),
+			branch:         "main",
+			params:         requiredStatusChecks,
+			expectedChecks: &requiredStatusChecks,
+			expectedResponse: &Response{
+				Rate: expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			defer ts.Close()
+
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			checks, resp, err := tc.s.UpdateRequiredStatusChecks(tc.ctx, tc.branch, tc.params)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, checks)
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedChecks, checks)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestRepoService_DeleteRequiredStatusChecks(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	tests := []struct {
+		name             string
+		mockResponses    []MockResponse
+		s                *RepoService
+		ctx              context.Context
+		branch           string
+		expectedResponse *Response
+		expectedError    string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           nil,
+			branch:        "main",
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"DELETE", "/repos/octocat/Hello-World/branches/main/protection/required_status_checks", 401, http.Header{}, `{
+					"message": "Bad credentials"
+				}`},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			branch:        "main",
+			expectedError: `DELETE /repos/octocat/Hello-World/branches/main/protection/required_status_checks: 401 Bad credentials`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"DELETE", "/repos/octocat/Hello-World/branches/main/protection/required_status_checks", 204, header, ``},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:    context.Background(),
+			branch: "main",
+			expectedResponse: &Response{
+				Rate: expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			defer ts.Close()
+
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			resp, err := tc.s.DeleteRequiredStatusChecks(tc.ctx, tc.branch)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestRepoService_RequiredReviews(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	tests := []struct {
+		name             string
+		mockResponses    []MockResponse
+		s                *RepoService
+		ctx              context.Context
+		branch           string
+		expectedReviews  *RequiredReviews
+		expectedResponse *Response
+		expectedError    string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           nil,
+			branch:        "main",
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/branches/main/protection/required_pull_request_reviews", 404, http.Header{}, `{
+					"message": "Required pull request reviews not enabled"
+				}`},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			branch:        "main",
+			expectedError: `GET /repos/octocat/Hello-World/branches/main/protection/required_pull_request_reviews: 404 Required pull request reviews not enabled`,
+		},
+		{
+			name: "InvalidResponse",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/branches/main/protection/required_pull_request_reviews", 200, http.Header{}, `{`},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			branch:        "main",
+			expectedError: `unexpected EOF`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/branches/main/protection/required_pull_request_reviews", 200, header, requiredReviewsBody},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:             context.Background(),
+			branch:          "main",
+			expectedReviews: &requiredReviews,
+			expectedResponse: &Response{
+				Rate: expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			defer ts.Close()
+
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			reviews, resp, err := tc.s.RequiredReviews(tc.ctx, tc.branch)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, reviews)
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedReviews, reviews)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestRepoService_UpdateRequiredReviews(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	params := RequiredReviewsParams{
+		DismissalRestrictions: &BranchActorsParams{
+			Users: []string{"octocat"},
+			Teams: []string{"justice-league"},
+		},
+		DismissStaleReviews:          true,
+		RequireCodeOwnerReviews:      true,
+		RequiredApprovingReviewCount: 2,
+	}
+
+	tests := []struct {
+		name             string
+		mockResponses    []MockResponse
+		s                *RepoService
+		ctx              context.Context
+		branch           string
+		params           RequiredReviewsParams
+		expectedReviews  *RequiredReviews
+		expectedResponse *Response
+		expectedError    string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           nil,
+			branch:        "main",
+			params:        params,
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"PATCH", "/repos/octocat/Hello-World/branches/main/protection/required_pull_request_reviews", 422, http.Header{}, `{
+					"message": "Validation Failed"
+				}`},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			branch:        "main",
+			params:        params,
+			expectedError: `PATCH /repos/octocat/Hello-World/branches/main/protection/required_pull_request_reviews: 422 Validation Failed`,
+		},
+		{
+			name: "InvalidResponse",
+			mockResponses: []MockResponse{
+				{"PATCH", "/repos/octocat/Hello-World/branches/main/protection/required_pull_request_reviews", 200, http.Header{}, `{`},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			branch:        "main",
+			params:        params,
+			expectedError: `unexpected EOF`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"PATCH", "/repos/octocat/Hello-World/branches/main/protection/required_pull_request_reviews", 200, header, requiredReviewsBody},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:             context.Background(),
+			branch:          "main",
+			params:          params,
+			expectedReviews: &requiredReviews,
+			expectedResponse: &Response{
+				Rate: expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			defer ts.Close()
+
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			reviews, resp, err := tc.s.UpdateRequiredReviews(tc.ctx, tc.branch, tc.params)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, reviews)
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedReviews, reviews)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestRepoService_DeleteRequiredReviews(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	tests := []struct {
+		name             string
+		mockResponses    []MockResponse
+		s                *RepoService
+		ctx              context.Context
+		branch           string
+		expectedResponse *Response
+		expectedError    string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           nil,
+			branch:        "main",
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"DELETE", "/repos/octocat/Hello-World/branches/main/protection/required_pull_request_reviews", 401, http.Header{}, `{
+					"message": "Bad credentials"
+				}`},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			branch:        "main",
+			expectedError: `DELETE /repos/octocat/Hello-World/branches/main/protection/required_pull_request_reviews: 401 Bad credentials`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"DELETE", "/repos/octocat/Hello-World/branches/main/protection/required_pull_request_reviews", 204, header, ``},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:    context.Background(),
+			branch: "main",
+			expectedResponse: &Response{
+				Rate: expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			defer ts.Close()
+
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			resp, err := tc.s.DeleteRequiredReviews(tc.ctx, tc.branch)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestRepoService_Restrictions(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	tests := []struct {
+		name                 string
+		mockResponses        []MockResponse
+		s                    *RepoService
+		ctx                  context.Context
+		branch               string
+		expectedRestrictions *BranchActors
+		expectedResponse     *Response
+		expectedError        string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           nil,
+			branch:        "main",
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/branches/main/protection/restrictions", 401, http.Header{}, `{
+					"message": "Bad credentials"
+				}`},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			branch:        "main",
+			expectedError: `GET /repos/octocat/Hello-World/branches/main/protection/restrictions: 401 Bad credentials`,
+		},
+		{
+			name: "InvalidResponse",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/branches/main/protection/restrictions", 200, http.Header{}, `{`},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			branch:        "main",
+			expectedError: `unexpected EOF`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/branches/main/protection/restrictions", 200, header, restrictionsBody},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:                  context.Background(),
+			branch:               "main",
+			expectedRestrictions: branchProtection.Restrictions,
+			expectedResponse: &Response{
+				Rate: expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			defer ts.Close()
+
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			restrictions, resp, err := tc.s.Restrictions(tc.ctx, tc.branch)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, restrictions)
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedRestrictions, restrictions)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestRepoService_SetRestrictedUsers(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	tests := []struct {
+		name                    string
+		mockResponses           []MockResponse
+		s                       *RepoService
+		ctx                     context.Context
+		branch                  string
+		users                   []string
+		expectedRestrictedUsers []User
+		expectedResponse        *Response
+		expectedError           string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           nil,
+			branch:        "main",
+			users:         []string{"octocat"},
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"PUT", "/repos/octocat/Hello-World/branches/main/protection/restrictions/users", 401, http.Header{}, `{
+					"message": "Bad credentials"
+				}`},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			branch:        "main",
+			users:         []string{"octocat"},
+			expectedError: `PUT /repos/octocat/Hello-World/branches/main/protection/restrictions/users: 401 Bad credentials`,
+		},
+		{
+			name: "InvalidResponse",
+			mockResponses: []MockResponse{
+				{"PUT", "/repos/octocat/Hello-World/branches/main/protection/restrictions/users", 200, http.Header{}, `{`},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			branch:        "main",
+			users:         []string{"octocat"},
+			expectedError: `unexpected EOF`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"PUT", "/repos/octocat/Hello-World/branches/main/protection/restrictions/users", 200, header, `[
+					{
+						"login": "octocat",
+						"id": 1,
+						"type": "User"
+					}
+				]`},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:    context.Background(),
+			branch: "main",
+			users:  []string{"octocat"},
+			expectedRestrictedUsers: []User{
+				{ID: 1, Login: "octocat", Type: "User"},
+			},
+			expectedResponse: &Response{
+				Rate: expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			defer ts.Close()
+
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			restrictedUsers, resp, err := tc.s.SetRestrictedUsers(tc.ctx, tc.branch, tc.users)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, restrictedUsers)
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedRestrictedUsers, restrictedUsers)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestRepoService_SetRestrictedTeams(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	tests := []struct {
+		name                    string
+		mockResponses           []MockResponse
+		s                       *RepoService
+		ctx                     context.Context
+		branch                  string
+		teams                   []string
+		expectedRestrictedTeams []Team
+		expectedResponse        *Response
+		expectedError           string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           nil,
+			branch:        "main",
+			teams:         []string{"justice-league"},
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"PUT", "/repos/octocat/Hello-World/branches/main/protection/restrictions/teams", 401, http.Header{}, `{
+					"message": "Bad credentials"
+				}`},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			branch:        "main",
+			teams:         []string{"justice-league"},
+			expectedError: `PUT /repos/octocat/Hello-World/branches/main/protection/restrictions/teams: 401 Bad credentials`,
+		},
+		{
+			name: "InvalidResponse",
+			mockResponses: []MockResponse{
+				{"PUT", "/repos/octocat/Hello-World/branches/main/protection/restrictions/teams", 200, http.Header{}, `{`},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			branch:        "main",
+			teams:         []string{"justice-league"},
+			expectedError: `unexpected EOF`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"PUT", "/repos/octocat/Hello-World/branches/main/protection/restrictions/teams", 200, header, `[
+					{
+						"id": 1,
+						"name": "Justice League",
+						"slug": "justice-league"
+					}
+				]`},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:                     context.Background(),
+			branch:                  "main",
+			teams:                   []string{"justice-league"},
+			expectedRestrictedTeams: branchProtection.Restrictions.Teams,
+			expectedResponse: &Response{
+				Rate: expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			defer ts.Close()
+
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			restrictedTeams, resp, err := tc.s.SetRestrictedTeams(tc.ctx, tc.branch, tc.teams)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, restrictedTeams)
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedRestrictedTeams, restrictedTeams)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestRepoService_SetRestrictedApps(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	tests := []struct {
+		name                   string
+		mockResponses          []MockResponse
+		s                      *RepoService
+		ctx                    context.Context
+		branch                 string
+		apps                   []string
+		expectedRestrictedApps []App
+		expectedResponse       *Response
+		expectedError          string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           nil,
+			branch:        "main",
+			apps:          []string{"octoapp"},
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"PUT", "/repos/octocat/Hello-World/branches/main/protection/restrictions/apps", 401, http.Header{}, `{
+					"message": "Bad credentials"
+				}`},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			branch:        "main",
+			apps:          []string{"octoapp"},
+			expectedError: `PUT /repos/octocat/Hello-World/branches/main/protection/restrictions/apps: 401 Bad credentials`,
+		},
+		{
+			name: "InvalidResponse",
+			mockResponses: []MockResponse{
+				{"PUT", "/repos/octocat/Hello-World/branches/main/protection/restrictions/apps", 200, http.Header{}, `{`},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			branch:        "main",
+			apps:          []string{"octoapp"},
+			expectedError: `unexpected EOF`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"PUT", "/repos/octocat/Hello-World/branches/main/protection/restrictions/apps", 200, header, `[
+					{
+						"id": 1,
+						"slug": "octoapp",
+						"name": "Octocat App",
+						"owner": {
+							"login": "octocat",
+							"id": 1,
+							"type": "User"
+						}
+					}
+				]`},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:                    context.Background(),
+			branch:                 "main",
+			apps:                   []string{"octoapp"},
+			expectedRestrictedApps: branchProtection.Restrictions.Apps,
+			expectedResponse: &Response{
+				Rate: expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			defer ts.Close()
+
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			restrictedApps, resp, err := tc.s.SetRestrictedApps(tc.ctx, tc.branch, tc.apps)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, restrictedApps)
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedRestrictedApps, restrictedApps)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestRepoService_DeleteRestrictions(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	tests := []struct {
+		name             string
+		mockResponses    []MockResponse
+		s                *RepoService
+		ctx              context.Context
+		branch           string
+		expectedResponse *Response
+		expectedError    string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           nil,
+			branch:        "main",
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"DELETE", "/repos/octocat/Hello-World/branches/main/protection/restrictions", 401, http.Header{}, `{
+					"message": "Bad credentials"
+				}`},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			branch:        "main",
+			expectedError: `DELETE /repos/octocat/Hello-World/branches/main/protection/restrictions: 401 Bad credentials`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"DELETE", "/repos/octocat/Hello-World/branches/main/protection/restrictions", 204, header, ``},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:    context.Background(),
+			branch: "main",
+			expectedResponse: &Response{
+				Rate: expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			defer ts.Close()
+
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			resp, err := tc.s.DeleteRestrictions(tc.ctx, tc.branch)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestRepoService_SetAdminEnforcement(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	tests := []struct {
+		name             string
+		mockResponses    []MockResponse
+		s                *RepoService
+		ctx              context.Context
+		branch           string
+		enabled          bool
+		expectedResponse *Response
+		expectedError    string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           nil,
+			branch:        "main",
+			enabled:       true,
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"POST", "/repos/octocat/Hello-World/branches/main/protection/enforce_admins", 401, http.Header{}, `{
+					"message": "Bad credentials"
+				}`},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			branch:        "main",
+			enabled:       true,
+			expectedError: `POST /repos/octocat/Hello-World/branches/main/protection/enforce_admins: 401 Bad credentials`,
+		},
+		{
+			name: "Success_Enable",
+			mockResponses: []MockResponse{
+				{"POST", "/repos/octocat/Hello-World/branches/main/protection/enforce_admins", 200, header, ``},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:     context.Background(),
+			branch:  "main",
+			enabled: true,
+			expectedResponse: &Response{
+				Rate: expectedRate,
+			},
+		},
+		{
+			name: "Success_Disable",
+			mockResponses: []MockResponse{
+				{"DELETE", "/repos/octocat/Hello-World/branches/main/protection/enforce_admins", 204, header, ``},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:     context.Background(),
+			branch:  "main",
+			enabled: false,
+			expectedResponse: &Response{
+				Rate: expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			defer ts.Close()
+
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			resp, err := tc.s.SetAdminEnforcement(tc.ctx, tc.branch, tc.enabled)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestRepoService_SetRequiredSignatures(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	tests := []struct {
+		name             string
+		mockResponses    []MockResponse
+		s                *RepoService
+		ctx              context.Context
+		branch           string
+		enabled          bool
+		expectedResponse *Response
+		expectedError    string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           nil,
+			branch:        "main",
+			enabled:       true,
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"POST", "/repos/octocat/Hello-World/branches/main/protection/required_signatures", 401, http.Header{}, `{
+					"message": "Bad credentials"
+				}`},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			branch:        "main",
+			enabled:       true,
+			expectedError: `POST /repos/octocat/Hello-World/branches/main/protection/required_signatures: 401 Bad credentials`,
+		},
+		{
+			name: "Success_Enable",
+			mockResponses: []MockResponse{
+				{"POST", "/repos/octocat/Hello-World/branches/main/protection/required_signatures", 200, header, ``},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:     context.Background(),
+			branch:  "main",
+			enabled: true,
+			expectedResponse: &Response{
+				Rate: expectedRate,
+			},
+		},
+		{
+			name: "Success_Disable",
+			mockResponses: []MockResponse{
+				{"DELETE", "/repos/octocat/Hello-World/branches/main/protection/required_signatures", 204, header, ``},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:     context.Background(),
+			branch:  "main",
+			enabled: false,
+			expectedResponse: &Response{
+				Rate: expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			defer ts.Close()
+
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			resp, err := tc.s.SetRequiredSignatures(tc.ctx, tc.branch, tc.enabled)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
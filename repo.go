@@ -2,8 +2,11 @@ package github
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"io"
+	"net/http"
+	"strconv"
 	"time"
 )
 
@@ -14,31 +17,161 @@ type RepoService struct {
 	owner, repo string
 
 	// Services
-	Pulls    *PullService
-	Issues   *IssueService
-	Releases *ReleaseService
-}
-
-// Repository is a GitHub repository object.
-type Repository struct {
-	ID            int       `json:"id"`
-	Name          string    `json:"name"`
-	FullName      string    `json:"full_name"`
-	Description   string    `json:"description"`
-	Topics        []string  `json:"topics"`
-	Private       bool      `json:"private"`
-	Fork          bool      `json:"fork"`
-	Archived      bool      `json:"archived"`
-	Disabled      bool      `json:"disabled"`
-	DefaultBranch string    `json:"default_branch"`
-	Owner         User      `json:"owner"`
-	URL           string    `json:"url"`
-	HTMLURL       string    `json:"html_url"`
-	CreatedAt     time.Time `json:"created_at"`
-	UpdatedAt     time.Time `json:"updated_at"`
-	PushedAt      time.Time `json:"pushed_at"`
+	Pulls       *PullService
+	Issues      *IssueService
+	Releases    *ReleaseService
+	Hooks       *HookService
+	Rulesets    *RulesetService
+	Contents    *ContentsService
+	Git         *GitService
+	Checks      *ChecksService
+	Deployments *DeploymentService
+	Insights    *InsightsService
+	Workflows   *WorkflowService
+	Secrets     *SecretsService
+	Variables   *VariablesService
+	Runners     *RunnersService
+	Caches      *CachesService
 }
 
+// Visibility represents the visibility of a GitHub repository.
+type Visibility string
+
+const (
+	// VisibilityPublic makes a repository accessible to everyone.
+	VisibilityPublic Visibility = "public"
+	// VisibilityPrivate makes a repository accessible only to the owner and the people with explicit access.
+	VisibilityPrivate Visibility = "private"
+	// VisibilityInternal makes a repository accessible to all members of an enterprise.
+	VisibilityInternal Visibility = "internal"
+)
+
+type (
+	// RepositoryPermissions represents the permissions of the authenticated user on a repository.
+	RepositoryPermissions struct {
+		Admin    bool `json:"admin"`
+		Maintain bool `json:"maintain"`
+		Push     bool `json:"push"`
+		Triage   bool `json:"triage"`
+		Pull     bool `json:"pull"`
+	}
+
+	// Repository is a GitHub repository object.
+	Repository struct {
+		ID                  int                    `json:"id"`
+		Name                string                 `json:"name"`
+		FullName            string                 `json:"full_name"`
+		Description         string                 `json:"description"`
+		Homepage            string                 `json:"homepage"`
+		Topics              []string               `json:"topics"`
+		Private             bool                   `json:"private"`
+		Visibility          Visibility             `json:"visibility"`
+		Fork                bool                   `json:"fork"`
+		Archived            bool                   `json:"archived"`
+		Disabled            bool                   `json:"disabled"`
+		IsTemplate          bool                   `json:"is_template"`
+		HasIssues           bool                   `json:"has_issues"`
+		HasProjects         bool                   `json:"has_projects"`
+		HasWiki             bool                   `json:"has_wiki"`
+		AllowForking        bool                   `json:"allow_forking"`
+		AllowMergeCommit    bool                   `json:"allow_merge_commit"`
+		AllowSquashMerge    bool                   `json:"allow_squash_merge"`
+		AllowRebaseMerge    bool                   `json:"allow_rebase_merge"`
+		AllowAutoMerge      bool                   `json:"allow_auto_merge"`
+		AllowUpdateBranch   bool                   `json:"allow_update_branch"`
+		DeleteBranchOnMerge bool                   `json:"delete_branch_on_merge"`
+		DefaultBranch       string                 `json:"default_branch"`
+		Owner               User                   `json:"owner"`
+		Permissions         *RepositoryPermissions `json:"permissions"`
+		Parent              *Repository            `json:"parent"`
+		Source              *Repository            `json:"source"`
+		TemplateRepository  *Repository            `json:"template_repository"`
+		URL                 string                 `json:"url"`
+		HTMLURL             string                 `json:"html_url"`
+		CloneURL            string                 `json:"clone_url"`
+		SSHURL              string                 `json:"ssh_url"`
+		CreatedAt           time.Time              `json:"created_at"`
+		UpdatedAt           time.Time              `json:"updated_at"`
+		PushedAt            time.Time              `json:"pushed_at"`
+	}
+)
+
+type (
+	// CreateRepoParams is used for creating a repository.
+	// See https://docs.github.com/en/rest/repos/repos#create-a-repository-for-the-authenticated-user
+	// See https://docs.github.com/en/rest/repos/repos#create-an-organization-repository
+	CreateRepoParams struct {
+		Name                string     `json:"name"`
+		Description         string     `json:"description,omitempty"`
+		Homepage            string     `json:"homepage,omitempty"`
+		Private             bool       `json:"private,omitempty"`
+		Visibility          Visibility `json:"visibility,omitempty"`
+		IsTemplate          bool       `json:"is_template,omitempty"`
+		HasIssues           *bool      `json:"has_issues,omitempty"`
+		HasProjects         *bool      `json:"has_projects,omitempty"`
+		HasWiki             *bool      `json:"has_wiki,omitempty"`
+		AllowMergeCommit    *bool      `json:"allow_merge_commit,omitempty"`
+		AllowSquashMerge    *bool      `json:"allow_squash_merge,omitempty"`
+		AllowRebaseMerge    *bool      `json:"allow_rebase_merge,omitempty"`
+		AllowAutoMerge      *bool      `json:"allow_auto_merge,omitempty"`
+		DeleteBranchOnMerge *bool      `json:"delete_branch_on_merge,omitempty"`
+		AutoInit            bool       `json:"auto_init,omitempty"`
+		GitignoreTemplate   string     `json:"gitignore_template,omitempty"`
+		LicenseTemplate     string     `json:"license_template,omitempty"`
+		TeamID              int        `json:"team_id,omitempty"` // Only for organization repositories
+	}
+
+	// CreateFromTemplateParams is used for creating a repository from a template repository.
+	// See https://docs.github.com/en/rest/repos/repos#create-a-repository-using-a-template
+	CreateFromTemplateParams struct {
+		Owner              string `json:"owner,omitempty"`
+		Name               string `json:"name"`
+		Description        string `json:"description,omitempty"`
+		Private            bool   `json:"private,omitempty"`
+		IncludeAllBranches bool   `json:"include_all_branches,omitempty"`
+	}
+
+	// UpdateRepoParams is used for updating the settings of a repository.
+	// Only the non-nil and non-empty fields are updated.
+	// See https://docs.github.com/en/rest/repos/repos#update-a-repository
+	UpdateRepoParams struct {
+		Name                string     `json:"name,omitempty"`
+		Description         *string    `json:"description,omitempty"`
+		Homepage            *string    `json:"homepage,omitempty"`
+		Private             *bool      `json:"private,omitempty"`
+		Visibility          Visibility `json:"visibility,omitempty"`
+		IsTemplate          *bool      `json:"is_template,omitempty"`
+		HasIssues           *bool      `json:"has_issues,omitempty"`
+		HasProjects         *bool      `json:"has_projects,omitempty"`
+		HasWiki             *bool      `json:"has_wiki,omitempty"`
+		AllowForking        *bool      `json:"allow_forking,omitempty"`
+		AllowMergeCommit    *bool      `json:"allow_merge_commit,omitempty"`
+		AllowSquashMerge    *bool      `json:"allow_squash_merge,omitempty"`
+		AllowRebaseMerge    *bool      `json:"allow_rebase_merge,omitempty"`
+		AllowAutoMerge      *bool      `json:"allow_auto_merge,omitempty"`
+		AllowUpdateBranch   *bool      `json:"allow_update_branch,omitempty"`
+		DeleteBranchOnMerge *bool      `json:"delete_branch_on_merge,omitempty"`
+		DefaultBranch       string     `json:"default_branch,omitempty"`
+		Archived            *bool      `json:"archived,omitempty"`
+	}
+
+	// ForkParams is used for forking a repository.
+	// See https://docs.github.com/en/rest/repos/forks#create-a-fork
+	ForkParams struct {
+		Organization      string `json:"organization,omitempty"`
+		Name              string `json:"name,omitempty"`
+		DefaultBranchOnly bool   `json:"default_branch_only,omitempty"`
+	}
+
+	// TransferParams is used for transferring a repository to another user or organization.
+	// See https://docs.github.com/en/rest/repos/repos#transfer-a-repository
+	TransferParams struct {
+		NewOwner string `json:"new_owner"`
+		NewName  string `json:"new_name,omitempty"`
+		TeamIDs  []int  `json:"team_ids,omitempty"`
+	}
+)
+
 // Permission represents a GitHub repository permission.
 // See https://docs.github.com/en/github/setting-up-and-managing-organizations-and-teams/repository-permission-levels-for-an-organization
 type Permission string
@@ -72,27 +205,60 @@ type (
 		Time  time.Time `json:"date"`
 	}
 
+	// Verification is the signature verification of a GitHub commit.
+	Verification struct {
+		Verified  bool   `json:"verified"`
+		Reason    string `json:"reason"`
+		Signature string `json:"signature"`
+		Payload   string `json:"payload"`
+	}
+
 	// RawCommit is a GitHub raw commit object.
+	// SHA, HTMLURL, and Parents are only available when the raw commit is not nested in another object.
 	RawCommit struct {
-		Message   string    `json:"message"`
-		Author    Signature `json:"author"`
-		Committer Signature `json:"committer"`
-		Tree      Hash      `json:"tree"`
-		URL       string    `json:"url"`
+		SHA          string       `json:"sha"`
+		Message      string       `json:"message"`
+		Author       Signature    `json:"author"`
+		Committer    Signature    `json:"committer"`
+		Tree         Hash         `json:"tree"`
+		Parents      []Hash       `json:"parents"`
+		Verification Verification `json:"verification"`
+		URL          string       `json:"url"`
+		HTMLURL      string       `json:"html_url"`
+	}
+
+	// CommitStats is the line changes of a GitHub commit.
+	CommitStats struct {
+		Additions int `json:"additions"`
+		Deletions int `json:"deletions"`
+		Total     int `json:"total"`
 	}
 
 	// Commit is a GitHub repository commit object.
+	// Stats and Files are only available when retrieving a single commit.
 	Commit struct {
-		SHA       string    `json:"sha"`
-		Commit    RawCommit `json:"commit"`
-		Author    User      `json:"author"`
-		Committer User      `json:"committer"`
-		Parents   []Hash    `json:"parents"`
-		URL       string    `json:"url"`
-		HTMLURL   string    `json:"html_url"`
+		SHA       string       `json:"sha"`
+		Commit    RawCommit    `json:"commit"`
+		Author    User         `json:"author"`
+		Committer User         `json:"committer"`
+		Parents   []Hash       `json:"parents"`
+		Stats     CommitStats  `json:"stats"`
+		Files     []CommitFile `json:"files"`
+		URL       string       `json:"url"`
+		HTMLURL   string       `json:"html_url"`
 	}
 )
 
+// CommitsFilter are used for fetching Commits.
+type CommitsFilter struct {
+	SHA       string // SHA or branch to start listing commits from
+	Path      string // Only commits containing this file path
+	Author    string // GitHub username or email address of the commit author
+	Committer string // GitHub username or email address of the commit committer
+	Since     time.Time
+	Until     time.Time
+}
+
 // Branch is a GitHub branch object.
 type Branch struct {
 	Name      string `json:"name"`
@@ -100,12 +266,61 @@ type Branch struct {
 	Commit    Commit `json:"commit"`
 }
 
+// BranchesFilter are used for fetching Branches.
+// If Protected is nil, both protected and unprotected branches are returned.
+type BranchesFilter struct {
+	Protected *bool
+}
+
+type (
+	// CommitFile is a file changed in a GitHub commit or comparison.
+	CommitFile struct {
+		SHA              string `json:"sha"`
+		Filename         string `json:"filename"`
+		PreviousFilename string `json:"previous_filename"`
+		Status           string `json:"status"` // Either added, removed, modified, renamed, copied, changed, or unchanged
+		Additions        int    `json:"additions"`
+		Deletions        int    `json:"deletions"`
+		Changes          int    `json:"changes"`
+		Patch            string `json:"patch"`
+		BlobURL          string `json:"blob_url"`
+		RawURL           string `json:"raw_url"`
+		ContentsURL      string `json:"contents_url"`
+	}
+
+	// Comparison is a GitHub comparison object between two commits.
+	Comparison struct {
+		Status          string       `json:"status"` // Either ahead, behind, identical, or diverged
+		AheadBy         int          `json:"ahead_by"`
+		BehindBy        int          `json:"behind_by"`
+		TotalCommits    int          `json:"total_commits"`
+		BaseCommit      Commit       `json:"base_commit"`
+		MergeBaseCommit Commit       `json:"merge_base_commit"`
+		Commits         []Commit     `json:"commits"`
+		Files           []CommitFile `json:"files"`
+		URL             string       `json:"url"`
+		HTMLURL         string       `json:"html_url"`
+		PermalinkURL    string       `json:"permalink_url"`
+		DiffURL         string       `json:"diff_url"`
+		PatchURL        string       `json:"patch_url"`
+	}
+)
+
 // Tag is a GitHib tag object.
 type Tag struct {
 	Name   string `json:"name"`
 	Commit Hash   `json:"commit"`
 }
 
+// TagProtection is a GitHub tag protection state object.
+type TagProtection struct {
+	ID        int       `json:"id"`
+	Pattern   string    `json:"pattern"`
+	Enabled   bool      `json:"enabled"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
 // Label is a GitHub label object.
 type Label struct {
 	ID          int    `json:"id"`
@@ -203,6 +418,136 @@ func (s *RepoService) Get(ctx context.Context) (*Repository, *Response, error) {
 	return repository, resp, nil
 }
 
+// CreateFromTemplate creates a new repository using the repository as a template.
+// See https://docs.github.com/en/rest/repos/repos#create-a-repository-using-a-template
+func (s *RepoService) CreateFromTemplate(ctx context.Context, params CreateFromTemplateParams) (*Repository, *Response, error) {
+	url := fmt.Sprintf("/repos/%s/%s/generate", s.owner, s.repo)
+	req, err := s.client.NewRequest(ctx, "POST", url, params)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	repository := new(Repository)
+
+	resp, err := s.client.Do(req, repository)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return repository, resp, nil
+}
+
+// Update updates the settings of the repository.
+// See https://docs.github.com/en/rest/repos/repos#update-a-repository
+func (s *RepoService) Update(ctx context.Context, params UpdateRepoParams) (*Repository, *Response, error) {
+	url := fmt.Sprintf("/repos/%s/%s", s.owner, s.repo)
+	req, err := s.client.NewRequest(ctx, "PATCH", url, params)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	repository := new(Repository)
+
+	resp, err := s.client.Do(req, repository)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return repository, resp, nil
+}
+
+// Delete deletes the repository.
+// The access token requires the delete_repo scope.
+// See https://docs.github.com/en/rest/repos/repos#delete-a-repository
+func (s *RepoService) Delete(ctx context.Context) (*Response, error) {
+	url := fmt.Sprintf("/repos/%s/%s", s.owner, s.repo)
+	req, err := s.client.NewRequest(ctx, "DELETE", url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// Fork creates a fork of the repository.
+// Forking happens asynchronously, so the returned repository may not be ready to use yet.
+// See https://docs.github.com/en/rest/repos/forks#create-a-fork
+func (s *RepoService) Fork(ctx context.Context, params ForkParams) (*Repository, *Response, error) {
+	url := fmt.Sprintf("/repos/%s/%s/forks", s.owner, s.repo)
+	req, err := s.client.NewRequest(ctx, "POST", url, params)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	repository := new(Repository)
+
+	resp, err := s.client.Do(req, repository)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return repository, resp, nil
+}
+
+// ForkAndWait creates a fork of the repository and waits until the Git objects of the fork are accessible.
+// It polls the fork with an exponential backoff until the fork is ready or the context is cancelled.
+func (s *RepoService) ForkAndWait(ctx context.Context, params ForkParams) (*Repository, error) {
+	fork, _, err := s.Fork(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	forkService := s.client.Repo(fork.Owner.Login, fork.Name)
+
+	err = poll(ctx, pollInitialInterval, pollMaxInterval, func() (bool, error) {
+		// The commits of a fork are not available until GitHub finishes copying the Git objects.
+		if _, _, err := forkService.Commits(ctx, 1, 1); err != nil {
+			// A 409 Conflict means the repository is empty, so the fork is ready but will never have any commits.
+			var respErr *ResponseError
+			if errors.As(err, &respErr) && respErr.Response.StatusCode == http.StatusConflict {
+				return true, nil
+			}
+			var notFoundErr *NotFoundError
+			if errors.As(err, &notFoundErr) {
+				return false, nil
+			}
+			return false, err
+		}
+		return true, nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return fork, nil
+}
+
+// Transfer transfers the repository to another user or organization.
+// The transfer happens asynchronously and the returned repository reflects the original owner.
+// See https://docs.github.com/en/rest/repos/repos#transfer-a-repository
+func (s *RepoService) Transfer(ctx context.Context, params TransferParams) (*Repository, *Response, error) {
+	url := fmt.Sprintf("/repos/%s/%s/transfer", s.owner, s.repo)
+	req, err := s.client.NewRequest(ctx, "POST", url, params)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	repository := new(Repository)
+
+	resp, err := s.client.Do(req, repository)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return repository, resp, nil
+}
+
 // Permission returns the repository permission for a collaborator (user).
 // See https://docs.github.com/en/rest/reference/repos#get-repository-permissions-for-a-user
 func (s *RepoService) Permission(ctx context.Context, username string) (Permission, *Response, error) {
@@ -247,12 +592,39 @@ func (s *RepoService) Commit(ctx context.Context, ref string) (*Commit, *Respons
 // Commits retrieves all commits in the repository page by page.
 // See https://docs.github.com/rest/reference/repos#list-commits
 func (s *RepoService) Commits(ctx context.Context, pageSize, pageNo int) ([]Commit, *Response, error) {
+	return s.CommitsWithFilter(ctx, pageSize, pageNo, CommitsFilter{})
+}
+
+// CommitsWithFilter retrieves the commits in the repository matching a filter page by page.
+// See https://docs.github.com/rest/reference/repos#list-commits
+func (s *RepoService) CommitsWithFilter(ctx context.Context, pageSize, pageNo int, filter CommitsFilter) ([]Commit, *Response, error) {
 	url := fmt.Sprintf("/repos/%s/%s/commits", s.owner, s.repo)
 	req, err := s.client.NewPageRequest(ctx, "GET", url, pageSize, pageNo, nil)
 	if err != nil {
 		return nil, nil, err
 	}
 
+	q := req.URL.Query()
+	if filter.SHA != "" {
+		q.Add("sha", filter.SHA)
+	}
+	if filter.Path != "" {
+		q.Add("path", filter.Path)
+	}
+	if filter.Author != "" {
+		q.Add("author", filter.Author)
+	}
+	if filter.Committer != "" {
+		q.Add("committer", filter.Committer)
+	}
+	if !filter.Since.IsZero() {
+		q.Add("since", filter.Since.Format(time.RFC3339))
+	}
+	if !filter.Until.IsZero() {
+		q.Add("until", filter.Until.Format(time.RFC3339))
+	}
+	req.URL.RawQuery = q.Encode()
+
 	commits := []Commit{}
 
 	resp, err := s.client.Do(req, &commits)
@@ -282,29 +654,140 @@ func (s *RepoService) Branch(ctx context.Context, name string) (*Branch, *Respon
 	return branch, resp, nil
 }
 
-// BranchProtection enables/disables a branch protection for administrator users.
-// See https://docs.github.com/rest/reference/repos#set-admin-branch-protection
-// See https://docs.github.com/rest/reference/repos#delete-admin-branch-protection
-func (s *RepoService) BranchProtection(ctx context.Context, branch string, enabled bool) (*Response, error) {
-	var method string
-	if enabled {
-		method = "POST"
-	} else {
-		method = "DELETE"
+// Branches retrieves all branches in the repository page by page.
+// See https://docs.github.com/en/rest/branches/branches#list-branches
+func (s *RepoService) Branches(ctx context.Context, pageSize, pageNo int, filter BranchesFilter) ([]Branch, *Response, error) {
+	url := fmt.Sprintf("/repos/%s/%s/branches", s.owner, s.repo)
+	req, err := s.client.NewPageRequest(ctx, "GET", url, pageSize, pageNo, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	q := req.URL.Query()
+	if filter.Protected != nil {
+		q.Add("protected", strconv.FormatBool(*filter.Protected))
+	}
+	req.URL.RawQuery = q.Encode()
+
+	branches := []Branch{}
+
+	resp, err := s.client.Do(req, &branches)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return branches, resp, nil
+}
+
+// CreateBranch creates a new branch in the repository pointing to a commit SHA.
+// See https://docs.github.com/en/rest/git/refs#create-a-reference
+func (s *RepoService) CreateBranch(ctx context.Context, name, sha string) (*Reference, *Response, error) {
+	return s.Git.CreateRef(ctx, "heads/"+name, sha)
+}
+
+// DeleteBranch deletes a branch in the repository by its name.
+// See https://docs.github.com/en/rest/git/refs#delete-a-reference
+func (s *RepoService) DeleteBranch(ctx context.Context, name string) (*Response, error) {
+	return s.Git.DeleteRef(ctx, "heads/"+name)
+}
+
+// RenameBranch renames a branch in the repository.
+// See https://docs.github.com/en/rest/branches/branches#rename-a-branch
+func (s *RepoService) RenameBranch(ctx context.Context, name, newName string) (*Branch, *Response, error) {
+	url := fmt.Sprintf("/repos/%s/%s/branches/%s/rename", s.owner, s.repo, escapePath(name))
+	body := struct {
+		NewName string `json:"new_name"`
+	}{
+		NewName: newName,
 	}
 
-	url := fmt.Sprintf("/repos/%s/%s/branches/%s/protection/enforce_admins", s.owner, s.repo, branch)
-	req, err := s.client.NewRequest(ctx, method, url, nil)
+	req, err := s.client.NewRequest(ctx, "POST", url, body)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	resp, err := s.client.Do(req, nil)
+	branch := new(Branch)
+
+	resp, err := s.client.Do(req, branch)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return branch, resp, nil
+}
+
+// MergeBranch merges a branch or a commit SHA (head) into a branch (base).
+// If the base already contains the head, no merge commit is created and the returned commit is nil.
+// If the merge results in conflicts, a 409 error is returned.
+// See https://docs.github.com/en/rest/branches/branches#merge-a-branch
+func (s *RepoService) MergeBranch(ctx context.Context, base, head, message string) (*Commit, *Response, error) {
+	url := fmt.Sprintf("/repos/%s/%s/merges", s.owner, s.repo)
+	body := struct {
+		Base    string `json:"base"`
+		Head    string `json:"head"`
+		Message string `json:"commit_message,omitempty"`
+	}{
+		Base:    base,
+		Head:    head,
+		Message: message,
+	}
+
+	req, err := s.client.NewRequest(ctx, "POST", url, body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	commit := new(Commit)
+
+	resp, err := s.client.Do(req, commit)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if resp.StatusCode == http.StatusNoContent {
+		return nil, resp, nil
+	}
+
+	return commit, resp, nil
+}
+
+// Compare compares two commits (base...head) in the repository.
+// Commits are paginated, but the changed files are only returned on the first page.
+// See https://docs.github.com/en/rest/commits/commits#compare-two-commits
+func (s *RepoService) Compare(ctx context.Context, base, head string, pageSize, pageNo int) (*Comparison, *Response, error) {
+	url := fmt.Sprintf("/repos/%s/%s/compare/%s...%s", s.owner, s.repo, escapePath(base), escapePath(head))
+	req, err := s.client.NewPageRequest(ctx, "GET", url, pageSize, pageNo, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	comparison := new(Comparison)
+
+	resp, err := s.client.Do(req, comparison)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return comparison, resp, nil
+}
+
+// CompareAll compares two commits (base...head) in the repository and retrieves all commits in between.
+// It fetches all pages of commits and merges them into a single comparison.
+func (s *RepoService) CompareAll(ctx context.Context, base, head string) (*Comparison, error) {
+	comparison, resp, err := s.Compare(ctx, base, head, 100, 1)
 	if err != nil {
 		return nil, err
 	}
 
-	return resp, nil
+	for next := resp.Pages.Next; next != 0; next = resp.Pages.Next {
+		var page *Comparison
+		if page, resp, err = s.Compare(ctx, base, head, 100, next); err != nil {
+			return nil, err
+		}
+		comparison.Commits = append(comparison.Commits, page.Commits...)
+	}
+
+	return comparison, nil
 }
 
 // Tags retrieves all tags in the repository page by page.
@@ -326,6 +809,103 @@ func (s *RepoService) Tags(ctx context.Context, pageSize, pageNo int) ([]Tag, *R
 	return tags, resp, nil
 }
 
+// TagByName retrieves a tag in the repository by its name.
+// Annotated tags are resolved to the commit they point to.
+func (s *RepoService) TagByName(ctx context.Context, name string) (*Tag, *Response, error) {
+	ref, resp, err := s.Git.Ref(ctx, "tags/"+name)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// An annotated tag may point to another annotated tag.
+	object := ref.Object
+	for object.Type == "tag" {
+		var tag *GitTag
+		if tag, resp, err = s.Git.Tag(ctx, object.SHA); err != nil {
+			return nil, nil, err
+		}
+		object = tag.Object
+	}
+
+	return &Tag{
+		Name: name,
+		Commit: Hash{
+			SHA: object.SHA,
+			URL: object.URL,
+		},
+	}, resp, nil
+}
+
+// DeleteTag deletes a tag in the repository by its name.
+// Tags matching a tag protection pattern can only be deleted by users with admin or maintain access.
+// See https://docs.github.com/en/rest/git/refs#delete-a-reference
+func (s *RepoService) DeleteTag(ctx context.Context, name string) (*Response, error) {
+	return s.Git.DeleteRef(ctx, "tags/"+name)
+}
+
+// TagProtections retrieves all tag protection states of the repository.
+// GitHub deprecates tag protection in favor of rulesets targeting tags (see RulesetService).
+// See https://docs.github.com/en/rest/repos/tags#list-tag-protection-states-for-a-repository
+func (s *RepoService) TagProtections(ctx context.Context) ([]TagProtection, *Response, error) {
+	url := fmt.Sprintf("/repos/%s/%s/tags/protection", s.owner, s.repo)
+	req, err := s.client.NewRequest(ctx, "GET", url, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	protections := []TagProtection{}
+
+	resp, err := s.client.Do(req, &protections)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return protections, resp, nil
+}
+
+// CreateTagProtection protects the tags matching a pattern in the repository.
+// Only users with admin or maintain access can create, update, or delete protected tags.
+// See https://docs.github.com/en/rest/repos/tags#create-a-tag-protection-state-for-a-repository
+func (s *RepoService) CreateTagProtection(ctx context.Context, pattern string) (*TagProtection, *Response, error) {
+	url := fmt.Sprintf("/repos/%s/%s/tags/protection", s.owner, s.repo)
+	body := struct {
+		Pattern string `json:"pattern"`
+	}{
+		Pattern: pattern,
+	}
+
+	req, err := s.client.NewRequest(ctx, "POST", url, body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	protection := new(TagProtection)
+
+	resp, err := s.client.Do(req, protection)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return protection, resp, nil
+}
+
+// DeleteTagProtection removes a tag protection state from the repository by its id.
+// See https://docs.github.com/en/rest/repos/tags#delete-a-tag-protection-state-for-a-repository
+func (s *RepoService) DeleteTagProtection(ctx context.Context, protectionID int) (*Response, error) {
+	url := fmt.Sprintf("/repos/%s/%s/tags/protection/%d", s.owner, s.repo, protectionID)
+	req, err := s.client.NewRequest(ctx, "DELETE", url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
 // DownloadTarArchive downloads a repository archive in tar format.
 func (s *RepoService) DownloadTarArchive(ctx context.Context, ref string, w io.Writer) (*Response, error) {
 	url := fmt.Sprintf("/repos/%s/%s/tarball/%s", s.owner, s.repo, ref)
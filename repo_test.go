@@ -6,6 +6,7 @@ import (
 	"net/http"
 	"net/url"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -45,7 +46,7 @@ const (
 		}
 	}`
 
-	commitBody1 = `{
+	commitDetailBody = `{
 		"sha": "6dcb09b5b57875f334f61aebed695e2e4193db5e",
 		"commit": {
 			"author": {
@@ -58,7 +59,13 @@ const (
 				"email": "octocat@github.com",
 				"date": "2020-10-20T19:59:59Z"
 			},
-			"message": "Fix all the bugs"
+			"message": "Fix all the bugs",
+			"verification": {
+				"verified": true,
+				"reason": "valid",
+				"signature": "-----BEGIN PGP SIGNATURE-----\n...\n-----END PGP SIGNATURE-----",
+				"payload": "tree 6dcb09b5b57875f334f61aebed695e2e4193db5e\n..."
+			}
 		},
 		"author": {
 			"login": "octocat",
@@ -75,7 +82,26 @@ const (
 				"url": "https://api.github.com/repos/octocat/Hello-World/commits/c3d0be41ecbe669545ee3e94d31ed9a4bc91ee3c",
 				"sha": "c3d0be41ecbe669545ee3e94d31ed9a4bc91ee3c"
 			}
-  	]
+		],
+		"stats": {
+			"additions": 1,
+			"deletions": 1,
+			"total": 2
+		},
+		"files": [
+			{
+				"sha": "bbcd538c8e72b8c175046e27cc8f907076331401",
+				"filename": "README.md",
+				"status": "modified",
+				"additions": 1,
+				"deletions": 1,
+				"changes": 2,
+				"patch": "@@ -1 +1 @@\n-Hello\n+Hello, World!",
+				"blob_url": "https://github.com/octocat/Hello-World/blob/c3d0be41ecbe669545ee3e94d31ed9a4bc91ee3c/README.md",
+				"raw_url": "https://github.com/octocat/Hello-World/raw/c3d0be41ecbe669545ee3e94d31ed9a4bc91ee3c/README.md",
+				"contents_url": "https://api.github.com/repos/octocat/Hello-World/contents/README.md?ref=c3d0be41ecbe669545ee3e94d31ed9a4bc91ee3c"
+			}
+		]
 	}`
 
 	commitsBody = `[
@@ -179,6 +205,120 @@ const (
 			}
 		}
 	]`
+
+	tagProtectionBody = `{
+		"id": 2,
+		"pattern": "v1.*",
+		"enabled": true,
+		"created_at": "2020-10-10T10:00:00Z",
+		"updated_at": "2020-10-10T10:00:00Z"
+	}`
+
+	tagProtectionsBody = `[` + tagProtectionBody + `]`
+
+	branchesBody = `[
+		{
+			"name": "main",
+			"commit": {
+				"sha": "c3d0be41ecbe669545ee3e94d31ed9a4bc91ee3c",
+				"url": "https://api.github.com/repos/octocat/Hello-World/commits/c3d0be41ecbe669545ee3e94d31ed9a4bc91ee3c"
+			},
+			"protected": true
+		}
+	]`
+
+	mergeCommitBody = `{
+		"sha": "c3d0be41ecbe669545ee3e94d31ed9a4bc91ee3c",
+		"commit": {
+			"author": {
+				"name": "The Octocat",
+				"email": "octocat@github.com",
+				"date": "2020-10-27T23:59:59Z"
+			},
+			"committer": {
+				"name": "The Octocat",
+				"email": "octocat@github.com",
+				"date": "2020-10-27T23:59:59Z"
+			},
+			"message": "Release v0.1.0"
+		},
+		"author": {
+			"login": "octocat",
+			"id": 1,
+			"type": "User"
+		},
+		"committer": {
+			"login": "octocat",
+			"id": 1,
+			"type": "User"
+		}
+	}`
+
+	comparisonBody = `{
+		"status": "ahead",
+		"ahead_by": 1,
+		"behind_by": 0,
+		"total_commits": 1,
+		"commits": [
+			{
+				"sha": "c3d0be41ecbe669545ee3e94d31ed9a4bc91ee3c",
+				"commit": {
+					"author": {
+						"name": "The Octocat",
+						"email": "octocat@github.com",
+						"date": "2020-10-27T23:59:59Z"
+					},
+					"committer": {
+						"name": "The Octocat",
+						"email": "octocat@github.com",
+						"date": "2020-10-27T23:59:59Z"
+					},
+					"message": "Release v0.1.0"
+				},
+				"author": {
+					"login": "octocat",
+					"id": 1,
+					"type": "User"
+				},
+				"committer": {
+					"login": "octocat",
+					"id": 1,
+					"type": "User"
+				}
+			}
+		],
+		"files": [
+			{
+				"sha": "bbcd538c8e72b8c175046e27cc8f907076331401",
+				"filename": "README.md",
+				"status": "modified",
+				"additions": 1,
+				"deletions": 1,
+				"changes": 2,
+				"patch": "@@ -1 +1 @@\n-Hello\n+Hello, World!",
+				"blob_url": "https://github.com/octocat/Hello-World/blob/c3d0be41ecbe669545ee3e94d31ed9a4bc91ee3c/README.md",
+				"raw_url": "https://github.com/octocat/Hello-World/raw/c3d0be41ecbe669545ee3e94d31ed9a4bc91ee3c/README.md",
+				"contents_url": "https://api.github.com/repos/octocat/Hello-World/contents/README.md?ref=c3d0be41ecbe669545ee3e94d31ed9a4bc91ee3c"
+			}
+		],
+		"url": "https://api.github.com/repos/octocat/Hello-World/compare/v0.1.0...main",
+		"html_url": "https://github.com/octocat/Hello-World/compare/v0.1.0...main"
+	}`
+
+	comparisonPage2Body = `{
+		"status": "ahead",
+		"ahead_by": 2,
+		"behind_by": 0,
+		"total_commits": 2,
+		"commits": [
+			{
+				"sha": "6dcb09b5b57875f334f61aebed695e2e4193db5e",
+				"commit": {
+					"message": "Fix all the bugs"
+				}
+			}
+		]
+	}`
 )
 
 var (
@@ -191,6 +331,7 @@ var (
 		Description:   "This your first repo!",
 		Topics:        []string{"octocat", "api"},
 		Private:       false,
+		Visibility:    VisibilityPublic,
 		Fork:          false,
 		Archived:      false,
 		Disabled:      false,
@@ -238,6 +379,64 @@ var (
 		},
 	}
 
+	commitDetail = Commit{
+		SHA: "6dcb09b5b57875f334f61aebed695e2e4193db5e",
+		Commit: RawCommit{
+			Message: "Fix all the bugs",
+			Author: Signature{
+				Name:  "The Octocat",
+				Email: "octocat@github.com",
+				Time:  parseGitHubTime("2020-10-20T19:59:59Z"),
+			},
+			Committer: Signature{
+				Name:  "The Octocat",
+				Email: "octocat@github.com",
+				Time:  parseGitHubTime("2020-10-20T19:59:59Z"),
+			},
+			Verification: Verification{
+				Verified:  true,
+				Reason:    "valid",
+				Signature: "-----BEGIN PGP SIGNATURE-----\n...\n-----END PGP SIGNATURE-----",
+				Payload:   "tree 6dcb09b5b57875f334f61aebed695e2e4193db5e\n...",
+			},
+		},
+		Author: User{
+			ID:    1,
+			Login: "octocat",
+			Type:  "User",
+		},
+		Committer: User{
+			ID:    1,
+			Login: "octocat",
+			Type:  "User",
+		},
+		Parents: []Hash{
+			{
+				SHA: "c3d0be41ecbe669545ee3e94d31ed9a4bc91ee3c",
+				URL: "https://api.github.com/repos/octocat/Hello-World/commits/c3d0be41ecbe669545ee3e94d31ed9a4bc91ee3c",
+			},
+		},
+		Stats: CommitStats{
+			Additions: 1,
+			Deletions: 1,
+			Total:     2,
+		},
+		Files: []CommitFile{
+			{
+				SHA:         "bbcd538c8e72b8c175046e27cc8f907076331401",
+				Filename:    "README.md",
+				Status:      "modified",
+				Additions:   1,
+				Deletions:   1,
+				Changes:     2,
+				Patch:       "@@ -1 +1 @@\n-Hello\n+Hello, World!",
+				BlobURL:     "https://github.com/octocat/Hello-World/blob/c3d0be41ecbe669545ee3e94d31ed9a4bc91ee3c/README.md",
+				RawURL:      "https://github.com/octocat/Hello-World/raw/c3d0be41ecbe669545ee3e94d31ed9a4bc91ee3c/README.md",
+				ContentsURL: "https://api.github.com/repos/octocat/Hello-World/contents/README.md?ref=c3d0be41ecbe669545ee3e94d31ed9a4bc91ee3c",
+			},
+		},
+	}
+
 	commit2 = Commit{
 		SHA: "c3d0be41ecbe669545ee3e94d31ed9a4bc91ee3c",
 		Commit: RawCommit{
@@ -278,6 +477,47 @@ var (
 			URL: "https://api.github.com/repos/octocat/Hello-World/commits/c3d0be41ecbe669545ee3e94d31ed9a4bc91ee3c",
 		},
 	}
+
+	tagProtection = TagProtection{
+		ID:        2,
+		Pattern:   "v1.*",
+		Enabled:   true,
+		CreatedAt: parseGitHubTime("2020-10-10T10:00:00Z"),
+		UpdatedAt: parseGitHubTime("2020-10-10T10:00:00Z"),
+	}
+
+	branchRef = Branch{
+		Name:      "main",
+		Protected: true,
+		Commit: Commit{
+			SHA: "c3d0be41ecbe669545ee3e94d31ed9a4bc91ee3c",
+			URL: "https://api.github.com/repos/octocat/Hello-World/commits/c3d0be41ecbe669545ee3e94d31ed9a4bc91ee3c",
+		},
+	}
+
+	commitFile = CommitFile{
+		SHA:         "bbcd538c8e72b8c175046e27cc8f907076331401",
+		Filename:    "README.md",
+		Status:      "modified",
+		Additions:   1,
+		Deletions:   1,
+		Changes:     2,
+		Patch:       "@@ -1 +1 @@\n-Hello\n+Hello, World!",
+		BlobURL:     "https://github.com/octocat/Hello-World/blob/c3d0be41ecbe669545ee3e94d31ed9a4bc91ee3c/README.md",
+		RawURL:      "https://github.com/octocat/Hello-World/raw/c3d0be41ecbe669545ee3e94d31ed9a4bc91ee3c/README.md",
+		ContentsURL: "https://api.github.com/repos/octocat/Hello-World/contents/README.md?ref=c3d0be41ecbe669545ee3e94d31ed9a4bc91ee3c",
+	}
+
+	comparison = Comparison{
+		Status:       "ahead",
+		AheadBy:      1,
+		BehindBy:     0,
+		TotalCommits: 1,
+		Commits:      []Commit{commit2},
+		Files:        []CommitFile{commitFile},
+		URL:          "https://api.github.com/repos/octocat/Hello-World/compare/v0.1.0...main",
+		HTMLURL:      "https://github.com/octocat/Hello-World/compare/v0.1.0...main",
+	}
 )
 
 func TestRepoService_Get(t *testing.T) {
@@ -541,7 +781,7 @@ func TestRepoService_Commit(t *testing.T) {
 		{
 			name: "Success",
 			mockResponses: []MockResponse{
-				{"GET", "/repos/octocat/Hello-World/commits/6dcb09b5b57875f334f61aebed695e2e4193db5e", 200, header, commitBody1},
+				{"GET", "/repos/octocat/Hello-World/commits/6dcb09b5b57875f334f61aebed695e2e4193db5e", 200, header, commitDetailBody},
 			},
 			s: &RepoService{
 				client: c,
@@ -550,7 +790,7 @@ func TestRepoService_Commit(t *testing.T) {
 			},
 			ctx:            context.Background(),
 			ref:            "6dcb09b5b57875f334f61aebed695e2e4193db5e",
-			expectedCommit: &commit1,
+			expectedCommit: &commitDetail,
 			expectedResponse: &Response{
 				Rate: expectedRate,
 			},
@@ -630,7 +870,7 @@ func TestRepoService_Commits(t *testing.T) {
 			expectedError: `GET /repos/octocat/Hello-World/commits: 401 Bad credentials`,
 		},
 		{
-			name: "ّInvalidResponse",
+			name: "InvalidResponse",
 			mockResponses: []MockResponse{
 				{"GET", "/repos/octocat/Hello-World/commits", 200, http.Header{}, `[`},
 			},
@@ -690,7 +930,7 @@ func TestRepoService_Commits(t *testing.T) {
 	}
 }
 
-func TestRepoService_Branch(t *testing.T) {
+func TestRepoService_CommitsWithFilter(t *testing.T) {
 	c := &Client{
 		httpClient: &http.Client{},
 		rates:      map[rateGroup]Rate{},
@@ -702,8 +942,10 @@ func TestRepoService_Branch(t *testing.T) {
 		mockResponses    []MockResponse
 		s                *RepoService
 		ctx              context.Context
-		branchName       string
-		expectedBranch   *Branch
+		pageSize         int
+		pageNo           int
+		filter           CommitsFilter
+		expectedCommits  []Commit
 		expectedResponse *Response
 		expectedError    string
 	}{
@@ -716,13 +958,14 @@ func TestRepoService_Branch(t *testing.T) {
 				repo:   "Hello-World",
 			},
 			ctx:           nil,
-			branchName:    "main",
+			pageSize:      10,
+			pageNo:        1,
 			expectedError: `net/http: nil Context`,
 		},
 		{
 			name: "InvalidStatusCode",
 			mockResponses: []MockResponse{
-				{"GET", "/repos/octocat/Hello-World/branches/main", 401, http.Header{}, `{
+				{"GET", "/repos/octocat/Hello-World/commits", 401, http.Header{}, `{
 					"message": "Bad credentials"
 				}`},
 			},
@@ -732,13 +975,14 @@ func TestRepoService_Branch(t *testing.T) {
 				repo:   "Hello-World",
 			},
 			ctx:           context.Background(),
-			branchName:    "main",
-			expectedError: `GET /repos/octocat/Hello-World/branches/main: 401 Bad credentials`,
+			pageSize:      10,
+			pageNo:        1,
+			expectedError: `GET /repos/octocat/Hello-World/commits: 401 Bad credentials`,
 		},
 		{
-			name: "ّInvalidResponse",
+			name: "InvalidResponse",
 			mockResponses: []MockResponse{
-				{"GET", "/repos/octocat/Hello-World/branches/main", 200, http.Header{}, `{`},
+				{"GET", "/repos/octocat/Hello-World/commits", 200, http.Header{}, `[`},
 			},
 			s: &RepoService{
 				client: c,
@@ -746,24 +990,35 @@ func TestRepoService_Branch(t *testing.T) {
 				repo:   "Hello-World",
 			},
 			ctx:           context.Background(),
-			branchName:    "main",
+			pageSize:      10,
+			pageNo:        1,
 			expectedError: `unexpected EOF`,
 		},
 		{
 			name: "Success",
 			mockResponses: []MockResponse{
-				{"GET", "/repos/octocat/Hello-World/branches/main", 200, header, branchBody},
+				{"GET", "/repos/octocat/Hello-World/commits", 200, header, commitsBody},
 			},
 			s: &RepoService{
 				client: c,
 				owner:  "octocat",
 				repo:   "Hello-World",
 			},
-			ctx:            context.Background(),
-			branchName:     "main",
-			expectedBranch: &branch,
+			ctx:      context.Background(),
+			pageSize: 10,
+			pageNo:   1,
+			filter: CommitsFilter{
+				SHA:       "main",
+				Path:      "docs",
+				Author:    "octocat",
+				Committer: "octocat",
+				Since:     parseGitHubTime("2020-10-01T00:00:00Z"),
+				Until:     parseGitHubTime("2020-10-31T00:00:00Z"),
+			},
+			expectedCommits: []Commit{commit2, commit1},
 			expectedResponse: &Response{
-				Rate: expectedRate,
+				Pages: expectedPages,
+				Rate:  expectedRate,
 			},
 		},
 	}
@@ -775,24 +1030,25 @@ func TestRepoService_Branch(t *testing.T) {
 
 			tc.s.client.apiURL, _ = url.Parse(ts.URL)
 
-			branch, resp, err := tc.s.Branch(tc.ctx, tc.branchName)
+			commits, resp, err := tc.s.CommitsWithFilter(tc.ctx, tc.pageSize, tc.pageNo, tc.filter)
 
 			if tc.expectedError != "" {
-				assert.Nil(t, branch)
+				assert.Nil(t, commits)
 				assert.Nil(t, resp)
 				assert.EqualError(t, err, tc.expectedError)
 			} else {
 				assert.NoError(t, err)
-				assert.Equal(t, tc.expectedBranch, branch)
+				assert.Equal(t, tc.expectedCommits, commits)
 				assert.NotNil(t, resp)
 				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Pages, resp.Pages)
 				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
 			}
 		})
 	}
 }
 
-func TestRepoService_BranchProtection(t *testing.T) {
+func TestRepoService_Branch(t *testing.T) {
 	c := &Client{
 		httpClient: &http.Client{},
 		rates:      map[rateGroup]Rate{},
@@ -804,8 +1060,8 @@ func TestRepoService_BranchProtection(t *testing.T) {
 		mockResponses    []MockResponse
 		s                *RepoService
 		ctx              context.Context
-		branch           string
-		enabled          bool
+		branchName       string
+		expectedBranch   *Branch
 		expectedResponse *Response
 		expectedError    string
 	}{
@@ -818,14 +1074,13 @@ func TestRepoService_BranchProtection(t *testing.T) {
 				repo:   "Hello-World",
 			},
 			ctx:           nil,
-			branch:        "main",
-			enabled:       true,
+			branchName:    "main",
 			expectedError: `net/http: nil Context`,
 		},
 		{
 			name: "InvalidStatusCode",
 			mockResponses: []MockResponse{
-				{"POST", "/repos/octocat/Hello-World/branches/main/protection/enforce_admins", 401, http.Header{}, `{
+				{"GET", "/repos/octocat/Hello-World/branches/main", 401, http.Header{}, `{
 					"message": "Bad credentials"
 				}`},
 			},
@@ -835,40 +1090,36 @@ func TestRepoService_BranchProtection(t *testing.T) {
 				repo:   "Hello-World",
 			},
 			ctx:           context.Background(),
-			branch:        "main",
-			enabled:       true,
-			expectedError: `POST /repos/octocat/Hello-World/branches/main/protection/enforce_admins: 401 Bad credentials`,
+			branchName:    "main",
+			expectedError: `GET /repos/octocat/Hello-World/branches/main: 401 Bad credentials`,
 		},
 		{
-			name: "Success_Enable",
+			name: "ّInvalidResponse",
 			mockResponses: []MockResponse{
-				{"POST", "/repos/octocat/Hello-World/branches/main/protection/enforce_admins", 200, header, ``},
+				{"GET", "/repos/octocat/Hello-World/branches/main", 200, http.Header{}, `{`},
 			},
 			s: &RepoService{
 				client: c,
 				owner:  "octocat",
 				repo:   "Hello-World",
 			},
-			ctx:     context.Background(),
-			branch:  "main",
-			enabled: true,
-			expectedResponse: &Response{
-				Rate: expectedRate,
-			},
+			ctx:           context.Background(),
+			branchName:    "main",
+			expectedError: `unexpected EOF`,
 		},
 		{
-			name: "Success_Disable",
+			name: "Success",
 			mockResponses: []MockResponse{
-				{"DELETE", "/repos/octocat/Hello-World/branches/main/protection/enforce_admins", 204, header, ``},
+				{"GET", "/repos/octocat/Hello-World/branches/main", 200, header, branchBody},
 			},
 			s: &RepoService{
 				client: c,
 				owner:  "octocat",
 				repo:   "Hello-World",
 			},
-			ctx:     context.Background(),
-			branch:  "main",
-			enabled: false,
+			ctx:            context.Background(),
+			branchName:     "main",
+			expectedBranch: &branch,
 			expectedResponse: &Response{
 				Rate: expectedRate,
 			},
@@ -882,13 +1133,15 @@ func TestRepoService_BranchProtection(t *testing.T) {
 
 			tc.s.client.apiURL, _ = url.Parse(ts.URL)
 
-			resp, err := tc.s.BranchProtection(tc.ctx, tc.branch, tc.enabled)
+			branch, resp, err := tc.s.Branch(tc.ctx, tc.branchName)
 
 			if tc.expectedError != "" {
+				assert.Nil(t, branch)
 				assert.Nil(t, resp)
 				assert.EqualError(t, err, tc.expectedError)
 			} else {
 				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedBranch, branch)
 				assert.NotNil(t, resp)
 				assert.NotNil(t, resp.Response)
 				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
@@ -897,13 +1150,15 @@ func TestRepoService_BranchProtection(t *testing.T) {
 	}
 }
 
-func TestRepoService_Tags(t *testing.T) {
+func TestRepoService_Branches(t *testing.T) {
 	c := &Client{
 		httpClient: &http.Client{},
 		rates:      map[rateGroup]Rate{},
 		apiURL:     publicAPIURL,
 	}
 
+	protected := true
+
 	tests := []struct {
 		name             string
 		mockResponses    []MockResponse
@@ -911,7 +1166,8 @@ func TestRepoService_Tags(t *testing.T) {
 		ctx              context.Context
 		pageSize         int
 		pageNo           int
-		expectedTags     []Tag
+		filter           BranchesFilter
+		expectedBranches []Branch
 		expectedResponse *Response
 		expectedError    string
 	}{
@@ -926,12 +1182,13 @@ func TestRepoService_Tags(t *testing.T) {
 			ctx:           nil,
 			pageSize:      10,
 			pageNo:        1,
+			filter:        BranchesFilter{},
 			expectedError: `net/http: nil Context`,
 		},
 		{
 			name: "InvalidStatusCode",
 			mockResponses: []MockResponse{
-				{"GET", "/repos/octocat/Hello-World/tags", 401, http.Header{}, `{
+				{"GET", "/repos/octocat/Hello-World/branches", 401, http.Header{}, `{
 					"message": "Bad credentials"
 				}`},
 			},
@@ -943,12 +1200,13 @@ func TestRepoService_Tags(t *testing.T) {
 			ctx:           context.Background(),
 			pageSize:      10,
 			pageNo:        1,
-			expectedError: `GET /repos/octocat/Hello-World/tags: 401 Bad credentials`,
+			filter:        BranchesFilter{},
+			expectedError: `GET /repos/octocat/Hello-World/branches: 401 Bad credentials`,
 		},
 		{
-			name: "ّInvalidResponse",
+			name: "InvalidResponse",
 			mockResponses: []MockResponse{
-				{"GET", "/repos/octocat/Hello-World/tags", 200, http.Header{}, `[`},
+				{"GET", "/repos/octocat/Hello-World/branches", 200, http.Header{}, `[`},
 			},
 			s: &RepoService{
 				client: c,
@@ -958,22 +1216,26 @@ func TestRepoService_Tags(t *testing.T) {
 			ctx:           context.Background(),
 			pageSize:      10,
 			pageNo:        1,
+			filter:        BranchesFilter{},
 			expectedError: `unexpected EOF`,
 		},
 		{
 			name: "Success",
 			mockResponses: []MockResponse{
-				{"GET", "/repos/octocat/Hello-World/tags", 200, header, tagsBody},
+				{"GET", "/repos/octocat/Hello-World/branches", 200, header, branchesBody},
 			},
 			s: &RepoService{
 				client: c,
 				owner:  "octocat",
 				repo:   "Hello-World",
 			},
-			ctx:          context.Background(),
-			pageSize:     10,
-			pageNo:       1,
-			expectedTags: []Tag{tag},
+			ctx:      context.Background(),
+			pageSize: 10,
+			pageNo:   1,
+			filter: BranchesFilter{
+				Protected: &protected,
+			},
+			expectedBranches: []Branch{branchRef},
 			expectedResponse: &Response{
 				Pages: expectedPages,
 				Rate:  expectedRate,
@@ -988,15 +1250,15 @@ func TestRepoService_Tags(t *testing.T) {
 
 			tc.s.client.apiURL, _ = url.Parse(ts.URL)
 
-			tags, resp, err := tc.s.Tags(tc.ctx, tc.pageSize, tc.pageNo)
+			branches, resp, err := tc.s.Branches(tc.ctx, tc.pageSize, tc.pageNo, tc.filter)
 
 			if tc.expectedError != "" {
-				assert.Nil(t, tags)
+				assert.Nil(t, branches)
 				assert.Nil(t, resp)
 				assert.EqualError(t, err, tc.expectedError)
 			} else {
 				assert.NoError(t, err)
-				assert.Equal(t, tc.expectedTags, tags)
+				assert.Equal(t, tc.expectedBranches, branches)
 				assert.NotNil(t, resp)
 				assert.NotNil(t, resp.Response)
 				assert.Equal(t, tc.expectedResponse.Pages, resp.Pages)
@@ -1006,7 +1268,7 @@ func TestRepoService_Tags(t *testing.T) {
 	}
 }
 
-func TestRepoService_DownloadTarArchive(t *testing.T) {
+func TestRepoService_CreateBranch(t *testing.T) {
 	c := &Client{
 		httpClient: &http.Client{},
 		rates:      map[rateGroup]Rate{},
@@ -1014,14 +1276,15 @@ func TestRepoService_DownloadTarArchive(t *testing.T) {
 	}
 
 	tests := []struct {
-		name             string
-		mockResponses    []MockResponse
-		s                *RepoService
-		ctx              context.Context
-		ref              string
-		w                io.Writer
-		expectedResponse *Response
-		expectedError    string
+		name              string
+		mockResponses     []MockResponse
+		s                 *RepoService
+		ctx               context.Context
+		branchName        string
+		sha               string
+		expectedReference *Reference
+		expectedResponse  *Response
+		expectedError     string
 	}{
 		{
 			name:          "NilContext",
@@ -1030,40 +1293,78 @@ func TestRepoService_DownloadTarArchive(t *testing.T) {
 				client: c,
 				owner:  "octocat",
 				repo:   "Hello-World",
+				Git: &GitService{
+					client: c,
+					owner:  "octocat",
+					repo:   "Hello-World",
+				},
 			},
 			ctx:           nil,
-			ref:           "main",
-			w:             nil,
+			branchName:    "main",
+			sha:           "6dcb09b5b57875f334f61aebed695e2e4193db5e",
 			expectedError: `net/http: nil Context`,
 		},
 		{
 			name: "InvalidStatusCode",
 			mockResponses: []MockResponse{
-				{"GET", "/repos/octocat/Hello-World/tarball/main", 401, http.Header{}, ``},
+				{"POST", "/repos/octocat/Hello-World/git/refs", 422, http.Header{}, `{
+					"message": "Reference already exists"
+				}`},
 			},
 			s: &RepoService{
 				client: c,
 				owner:  "octocat",
 				repo:   "Hello-World",
+				Git: &GitService{
+					client: c,
+					owner:  "octocat",
+					repo:   "Hello-World",
+				},
 			},
 			ctx:           context.Background(),
-			ref:           "main",
-			w:             io.Discard,
-			expectedError: `GET /repos/octocat/Hello-World/tarball/main: 401 `,
+			branchName:    "main",
+			sha:           "6dcb09b5b57875f334f61aebed695e2e4193db5e",
+			expectedError: `POST /repos/octocat/Hello-World/git/refs: 422 Reference already exists`,
+		},
+		{
+			name: "InvalidResponse",
+			mockResponses: []MockResponse{
+				{"POST", "/repos/octocat/Hello-World/git/refs", 201, http.Header{}, `{`},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+				Git: &GitService{
+					client: c,
+					owner:  "octocat",
+					repo:   "Hello-World",
+				},
+			},
+			ctx:           context.Background(),
+			branchName:    "main",
+			sha:           "6dcb09b5b57875f334f61aebed695e2e4193db5e",
+			expectedError: `unexpected EOF`,
 		},
 		{
 			name: "Success",
 			mockResponses: []MockResponse{
-				{"GET", "/repos/octocat/Hello-World/tarball/main", 200, header, `content`},
+				{"POST", "/repos/octocat/Hello-World/git/refs", 201, header, referenceBody},
 			},
 			s: &RepoService{
 				client: c,
 				owner:  "octocat",
 				repo:   "Hello-World",
+				Git: &GitService{
+					client: c,
+					owner:  "octocat",
+					repo:   "Hello-World",
+				},
 			},
-			ctx: context.Background(),
-			ref: "main",
-			w:   io.Discard,
+			ctx:               context.Background(),
+			branchName:        "main",
+			sha:               "6dcb09b5b57875f334f61aebed695e2e4193db5e",
+			expectedReference: &reference,
 			expectedResponse: &Response{
 				Rate: expectedRate,
 			},
@@ -1077,13 +1378,15 @@ func TestRepoService_DownloadTarArchive(t *testing.T) {
 
 			tc.s.client.apiURL, _ = url.Parse(ts.URL)
 
-			resp, err := tc.s.DownloadTarArchive(tc.ctx, tc.ref, tc.w)
+			reference, resp, err := tc.s.CreateBranch(tc.ctx, tc.branchName, tc.sha)
 
 			if tc.expectedError != "" {
+				assert.Nil(t, reference)
 				assert.Nil(t, resp)
 				assert.EqualError(t, err, tc.expectedError)
 			} else {
 				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedReference, reference)
 				assert.NotNil(t, resp)
 				assert.NotNil(t, resp.Response)
 				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
@@ -1092,7 +1395,7 @@ func TestRepoService_DownloadTarArchive(t *testing.T) {
 	}
 }
 
-func TestRepoService_DownloadZipArchive(t *testing.T) {
+func TestRepoService_DeleteBranch(t *testing.T) {
 	c := &Client{
 		httpClient: &http.Client{},
 		rates:      map[rateGroup]Rate{},
@@ -1104,8 +1407,7 @@ func TestRepoService_DownloadZipArchive(t *testing.T) {
 		mockResponses    []MockResponse
 		s                *RepoService
 		ctx              context.Context
-		ref              string
-		w                io.Writer
+		branchName       string
 		expectedResponse *Response
 		expectedError    string
 	}{
@@ -1116,40 +1418,54 @@ func TestRepoService_DownloadZipArchive(t *testing.T) {
 				client: c,
 				owner:  "octocat",
 				repo:   "Hello-World",
+				Git: &GitService{
+					client: c,
+					owner:  "octocat",
+					repo:   "Hello-World",
+				},
 			},
 			ctx:           nil,
-			ref:           "main",
-			w:             nil,
+			branchName:    "feature/login",
 			expectedError: `net/http: nil Context`,
 		},
 		{
 			name: "InvalidStatusCode",
 			mockResponses: []MockResponse{
-				{"GET", "/repos/octocat/Hello-World/zipball/main", 401, http.Header{}, ``},
+				{"DELETE", "/repos/octocat/Hello-World/git/refs/heads/feature/login", 422, http.Header{}, `{
+					"message": "Reference does not exist"
+				}`},
 			},
 			s: &RepoService{
 				client: c,
 				owner:  "octocat",
 				repo:   "Hello-World",
+				Git: &GitService{
+					client: c,
+					owner:  "octocat",
+					repo:   "Hello-World",
+				},
 			},
 			ctx:           context.Background(),
-			ref:           "main",
-			w:             io.Discard,
-			expectedError: `GET /repos/octocat/Hello-World/zipball/main: 401 `,
+			branchName:    "feature/login",
+			expectedError: `DELETE /repos/octocat/Hello-World/git/refs/heads/feature/login: 422 Reference does not exist`,
 		},
 		{
 			name: "Success",
 			mockResponses: []MockResponse{
-				{"GET", "/repos/octocat/Hello-World/zipball/main", 200, header, `content`},
+				{"DELETE", "/repos/octocat/Hello-World/git/refs/heads/feature/login", 204, header, ``},
 			},
 			s: &RepoService{
 				client: c,
 				owner:  "octocat",
 				repo:   "Hello-World",
+				Git: &GitService{
+					client: c,
+					owner:  "octocat",
+					repo:   "Hello-World",
+				},
 			},
-			ctx: context.Background(),
-			ref: "main",
-			w:   io.Discard,
+			ctx:        context.Background(),
+			branchName: "feature/login",
 			expectedResponse: &Response{
 				Rate: expectedRate,
 			},
@@ -1163,7 +1479,7 @@ func TestRepoService_DownloadZipArchive(t *testing.T) {
 
 			tc.s.client.apiURL, _ = url.Parse(ts.URL)
 
-			resp, err := tc.s.DownloadZipArchive(tc.ctx, tc.ref, tc.w)
+			resp, err := tc.s.DeleteBranch(tc.ctx, tc.branchName)
 
 			if tc.expectedError != "" {
 				assert.Nil(t, resp)
@@ -1177,3 +1493,1955 @@ func TestRepoService_DownloadZipArchive(t *testing.T) {
 		})
 	}
 }
+
+func TestRepoService_RenameBranch(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	tests := []struct {
+		name             string
+		mockResponses    []MockResponse
+		s                *RepoService
+		ctx              context.Context
+		branchName       string
+		newName          string
+		expectedBranch   *Branch
+		expectedResponse *Response
+		expectedError    string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           nil,
+			branchName:    "master",
+			newName:       "main",
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"POST", "/repos/octocat/Hello-World/branches/master/rename", 403, http.Header{}, `{
+					"message": "Resource not accessible by integration"
+				}`},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			branchName:    "master",
+			newName:       "main",
+			expectedError: `POST /repos/octocat/Hello-World/branches/master/rename: 403 Resource not accessible by integration`,
+		},
+		{
+			name: "InvalidResponse",
+			mockResponses: []MockResponse{
+				{"POST", "/repos/octocat/Hello-World/branches/master/rename", 201, http.Header{}, `{`},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			branchName:    "master",
+			newName:       "main",
+			expectedError: `unexpected EOF`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"POST", "/repos/octocat/Hello-World/branches/master/rename", 201, header, branchBody},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:            context.Background(),
+			branchName:     "master",
+			newName:        "main",
+			expectedBranch: &branch,
+			expectedResponse: &Response{
+				Rate: expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			defer ts.Close()
+
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			branch, resp, err := tc.s.RenameBranch(tc.ctx, tc.branchName, tc.newName)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, branch)
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedBranch, branch)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestRepoService_MergeBranch(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	tests := []struct {
+		name             string
+		mockResponses    []MockResponse
+		s                *RepoService
+		ctx              context.Context
+		base             string
+		head             string
+		message          string
+		expectedCommit   *Commit
+		expectedResponse *Response
+		expectedError    string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           nil,
+			base:          "main",
+			head:          "feature",
+			message:       "Merge feature",
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "Conflict",
+			mockResponses: []MockResponse{
+				{"POST", "/repos/octocat/Hello-World/merges", 409, http.Header{}, `{
+					"message": "Merge conflict"
+				}`},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			base:          "main",
+			head:          "feature",
+			message:       "Merge feature",
+			expectedError: `POST /repos/octocat/Hello-World/merges: 409 Merge conflict`,
+		},
+		{
+			name: "InvalidResponse",
+			mockResponses: []MockResponse{
+				{"POST", "/repos/octocat/Hello-World/merges", 201, http.Header{}, `{`},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			base:          "main",
+			head:          "feature",
+			message:       "Merge feature",
+			expectedError: `unexpected EOF`,
+		},
+		{
+			name: "NothingToMerge",
+			mockResponses: []MockResponse{
+				{"POST", "/repos/octocat/Hello-World/merges", 204, header, ``},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:            context.Background(),
+			base:           "main",
+			head:           "feature",
+			message:        "Merge feature",
+			expectedCommit: nil,
+			expectedResponse: &Response{
+				Rate: expectedRate,
+			},
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"POST", "/repos/octocat/Hello-World/merges", 201, header, mergeCommitBody},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:            context.Background(),
+			base:           "main",
+			head:           "feature",
+			message:        "Merge feature",
+			expectedCommit: &commit2,
+			expectedResponse: &Response{
+				Rate: expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			defer ts.Close()
+
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			commit, resp, err := tc.s.MergeBranch(tc.ctx, tc.base, tc.head, tc.message)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, commit)
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedCommit, commit)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestRepoService_Compare(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	tests := []struct {
+		name               string
+		mockResponses      []MockResponse
+		s                  *RepoService
+		ctx                context.Context
+		base               string
+		head               string
+		pageSize           int
+		pageNo             int
+		expectedComparison *Comparison
+		expectedResponse   *Response
+		expectedError      string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           nil,
+			base:          "v0.1.0",
+			head:          "main",
+			pageSize:      10,
+			pageNo:        1,
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/compare/v0.1.0...main", 404, http.Header{}, `{
+					"message": "Not Found"
+				}`},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			base:          "v0.1.0",
+			head:          "main",
+			pageSize:      10,
+			pageNo:        1,
+			expectedError: `GET /repos/octocat/Hello-World/compare/v0.1.0...main: 404 Not Found`,
+		},
+		{
+			name: "InvalidResponse",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/compare/v0.1.0...main", 200, http.Header{}, `{`},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			base:          "v0.1.0",
+			head:          "main",
+			pageSize:      10,
+			pageNo:        1,
+			expectedError: `unexpected EOF`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/compare/v0.1.0...main", 200, header, comparisonBody},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:                context.Background(),
+			base:               "v0.1.0",
+			head:               "main",
+			pageSize:           10,
+			pageNo:             1,
+			expectedComparison: &comparison,
+			expectedResponse: &Response{
+				Pages: expectedPages,
+				Rate:  expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			defer ts.Close()
+
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			comparison, resp, err := tc.s.Compare(tc.ctx, tc.base, tc.head, tc.pageSize, tc.pageNo)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, comparison)
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedComparison, comparison)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Pages, resp.Pages)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestRepoService_CompareAll(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	tests := []struct {
+		name               string
+		mockResponses      []MockResponse
+		s                  *RepoService
+		ctx                context.Context
+		base               string
+		head               string
+		expectedComparison *Comparison
+		expectedError      string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           nil,
+			base:          "v0.1.0",
+			head:          "main",
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "SecondPageFails",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/compare/v0.1.0...main", 200, header, comparisonBody},
+				{"GET", "/repos/octocat/Hello-World/compare/v0.1.0...main", 500, http.Header{}, `{
+					"message": "Server Error"
+				}`},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			base:          "v0.1.0",
+			head:          "main",
+			expectedError: `GET /repos/octocat/Hello-World/compare/v0.1.0...main: 500 Server Error`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/compare/v0.1.0...main", 200, header, comparisonBody},
+				{"GET", "/repos/octocat/Hello-World/compare/v0.1.0...main", 200, http.Header{}, comparisonPage2Body},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:  context.Background(),
+			base: "v0.1.0",
+			head: "main",
+			expectedComparison: &Comparison{
+				Status:       "ahead",
+				AheadBy:      1,
+				BehindBy:     0,
+				TotalCommits: 1,
+				Commits: []Commit{
+					commit2,
+					{
+						SHA: "6dcb09b5b57875f334f61aebed695e2e4193db5e",
+						Commit: RawCommit{
+							Message: "Fix all the bugs",
+						},
+					},
+				},
+				Files:   []CommitFile{commitFile},
+				URL:     "https://api.github.com/repos/octocat/Hello-World/compare/v0.1.0...main",
+				HTMLURL: "https://github.com/octocat/Hello-World/compare/v0.1.0...main",
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			defer ts.Close()
+
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			comparison, err := tc.s.CompareAll(tc.ctx, tc.base, tc.head)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, comparison)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedComparison, comparison)
+			}
+		})
+	}
+}
+
+func TestRepoService_Tags(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	tests := []struct {
+		name             string
+		mockResponses    []MockResponse
+		s                *RepoService
+		ctx              context.Context
+		pageSize         int
+		pageNo           int
+		expectedTags     []Tag
+		expectedResponse *Response
+		expectedError    string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           nil,
+			pageSize:      10,
+			pageNo:        1,
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/tags", 401, http.Header{}, `{
+					"message": "Bad credentials"
+				}`},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			pageSize:      10,
+			pageNo:        1,
+			expectedError: `GET /repos/octocat/Hello-World/tags: 401 Bad credentials`,
+		},
+		{
+			name: "ّInvalidResponse",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/tags", 200, http.Header{}, `[`},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			pageSize:      10,
+			pageNo:        1,
+			expectedError: `unexpected EOF`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/tags", 200, header, tagsBody},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:          context.Background(),
+			pageSize:     10,
+			pageNo:       1,
+			expectedTags: []Tag{tag},
+			expectedResponse: &Response{
+				Pages: expectedPages,
+				Rate:  expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			defer ts.Close()
+
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			tags, resp, err := tc.s.Tags(tc.ctx, tc.pageSize, tc.pageNo)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, tags)
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedTags, tags)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Pages, resp.Pages)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestRepoService_TagByName(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	expectedTag := &Tag{
+		Name: "v0.1.0",
+		Commit: Hash{
+			SHA: "c3d0be41ecbe669545ee3e94d31ed9a4bc91ee3c",
+			URL: "https://api.github.com/repos/octocat/Hello-World/git/commits/c3d0be41ecbe669545ee3e94d31ed9a4bc91ee3c",
+		},
+	}
+
+	tests := []struct {
+		name             string
+		mockResponses    []MockResponse
+		s                *RepoService
+		ctx              context.Context
+		tagName          string
+		expectedTag      *Tag
+		expectedResponse *Response
+		expectedError    string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+				Git: &GitService{
+					client: c,
+					owner:  "octocat",
+					repo:   "Hello-World",
+				},
+			},
+			ctx:           nil,
+			tagName:       "v0.1.0",
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "RefNotFound",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/git/ref/tags/v0.1.0", 404, http.Header{}, `{
+					"message": "Not Found"
+				}`},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+				Git: &GitService{
+					client: c,
+					owner:  "octocat",
+					repo:   "Hello-World",
+				},
+			},
+			ctx:           context.Background(),
+			tagName:       "v0.1.0",
+			expectedError: `GET /repos/octocat/Hello-World/git/ref/tags/v0.1.0: 404 Not Found`,
+		},
+		{
+			name: "TagFails",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/git/ref/tags/v0.1.0", 200, header, annotatedTagReferenceBody},
+				{"GET", "/repos/octocat/Hello-World/git/tags/940bd336248efae0f9ee5bc7b2d5c985887b16ac", 401, http.Header{}, `{
+					"message": "Bad credentials"
+				}`},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+				Git: &GitService{
+					client: c,
+					owner:  "octocat",
+					repo:   "Hello-World",
+				},
+			},
+			ctx:           context.Background(),
+			tagName:       "v0.1.0",
+			expectedError: `GET /repos/octocat/Hello-World/git/tags/940bd336248efae0f9ee5bc7b2d5c985887b16ac: 401 Bad credentials`,
+		},
+		{
+			name: "LightweightTag",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/git/ref/tags/v0.1.0", 200, header, `{
+					"ref": "refs/tags/v0.1.0",
+					"url": "https://api.github.com/repos/octocat/Hello-World/git/refs/tags/v0.1.0",
+					"object": {
+						"type": "commit",
+						"sha": "c3d0be41ecbe669545ee3e94d31ed9a4bc91ee3c",
+						"url": "https://api.github.com/repos/octocat/Hello-World/git/commits/c3d0be41ecbe669545ee3e94d31ed9a4bc91ee3c"
+					}
+				}`},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+				Git: &GitService{
+					client: c,
+					owner:  "octocat",
+					repo:   "Hello-World",
+				},
+			},
+			ctx:         context.Background(),
+			tagName:     "v0.1.0",
+			expectedTag: expectedTag,
+			expectedResponse: &Response{
+				Rate: expectedRate,
+			},
+		},
+		{
+			name: "AnnotatedTag",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/git/ref/tags/v0.1.0", 200, header, annotatedTagReferenceBody},
+				{"GET", "/repos/octocat/Hello-World/git/tags/940bd336248efae0f9ee5bc7b2d5c985887b16ac", 200, header, gitTagBody},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+				Git: &GitService{
+					client: c,
+					owner:  "octocat",
+					repo:   "Hello-World",
+				},
+			},
+			ctx:         context.Background(),
+			tagName:     "v0.1.0",
+			expectedTag: expectedTag,
+			expectedResponse: &Response{
+				Rate: expectedRate,
+			},
+		},
+		{
+			name: "NestedAnnotatedTag",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/git/ref/tags/v0.1.0", 200, header, annotatedTagReferenceBody},
+				{"GET", "/repos/octocat/Hello-World/git/tags/940bd336248efae0f9ee5bc7b2d5c985887b16ac", 200, header, `{
+					"sha": "940bd336248efae0f9ee5bc7b2d5c985887b16ac",
+					"tag": "v0.1.0",
+					"object": {
+						"type": "tag",
+						"sha": "5b0e2e9e6c2b0b8c2f5a1c3d4e5f60718293a4b5",
+						"url": "https://api.github.com/repos/octocat/Hello-World/git/tags/5b0e2e9e6c2b0b8c2f5a1c3d4e5f60718293a4b5"
+					}
+				}`},
+				{"GET", "/repos/octocat/Hello-World/git/tags/5b0e2e9e6c2b0b8c2f5a1c3d4e5f60718293a4b5", 200, header, gitTagBody},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+				Git: &GitService{
+					client: c,
+					owner:  "octocat",
+					repo:   "Hello-World",
+				},
+			},
+			ctx:         context.Background(),
+			tagName:     "v0.1.0",
+			expectedTag: expectedTag,
+			expectedResponse: &Response{
+				Rate: expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			defer ts.Close()
+
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			tag, resp, err := tc.s.TagByName(tc.ctx, tc.tagName)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, tag)
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedTag, tag)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestRepoService_DeleteTag(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	tests := []struct {
+		name             string
+		mockResponses    []MockResponse
+		s                *RepoService
+		ctx              context.Context
+		tagName          string
+		expectedResponse *Response
+		expectedError    string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+				Git: &GitService{
+					client: c,
+					owner:  "octocat",
+					repo:   "Hello-World",
+				},
+			},
+			ctx:           nil,
+			tagName:       "v0.1.0",
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"DELETE", "/repos/octocat/Hello-World/git/refs/tags/v0.1.0", 401, http.Header{}, `{
+					"message": "Bad credentials"
+				}`},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+				Git: &GitService{
+					client: c,
+					owner:  "octocat",
+					repo:   "Hello-World",
+				},
+			},
+			ctx:           context.Background(),
+			tagName:       "v0.1.0",
+			expectedError: `DELETE /repos/octocat/Hello-World/git/refs/tags/v0.1.0: 401 Bad credentials`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"DELETE", "/repos/octocat/Hello-World/git/refs/tags/v0.1.0", 204, header, ``},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+				Git: &GitService{
+					client: c,
+					owner:  "octocat",
+					repo:   "Hello-World",
+				},
+			},
+			ctx:     context.Background(),
+			tagName: "v0.1.0",
+			expectedResponse: &Response{
+				Rate: expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			defer ts.Close()
+
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			resp, err := tc.s.DeleteTag(tc.ctx, tc.tagName)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestRepoService_TagProtections(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	tests := []struct {
+		name                string
+		mockResponses       []MockResponse
+		s                   *RepoService
+		ctx                 context.Context
+		expectedProtections []TagProtection
+		expectedResponse    *Response
+		expectedError       string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           nil,
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/tags/protection", 401, http.Header{}, `{
+					"message": "Bad credentials"
+				}`},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			expectedError: `GET /repos/octocat/Hello-World/tags/protection: 401 Bad credentials`,
+		},
+		{
+			name: "InvalidResponse",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/tags/protection", 200, http.Header{}, `{`},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			expectedError: `unexpected EOF`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/tags/protection", 200, header, tagProtectionsBody},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:                 context.Background(),
+			expectedProtections: []TagProtection{tagProtection},
+			expectedResponse: &Response{
+				Rate: expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			defer ts.Close()
+
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			protections, resp, err := tc.s.TagProtections(tc.ctx)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, protections)
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedProtections, protections)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestRepoService_CreateTagProtection(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	tests := []struct {
+		name               string
+		mockResponses      []MockResponse
+		s                  *RepoService
+		ctx                context.Context
+		pattern            string
+		expectedProtection *TagProtection
+		expectedResponse   *Response
+		expectedError      string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           nil,
+			pattern:       "v1.*",
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"POST", "/repos/octocat/Hello-World/tags/protection", 401, http.Header{}, `{
+					"message": "Bad credentials"
+				}`},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			pattern:       "v1.*",
+			expectedError: `POST /repos/octocat/Hello-World/tags/protection: 401 Bad credentials`,
+		},
+		{
+			name: "InvalidResponse",
+			mockResponses: []MockResponse{
+				{"POST", "/repos/octocat/Hello-World/tags/protection", 201, http.Header{}, `{`},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			pattern:       "v1.*",
+			expectedError: `unexpected EOF`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"POST", "/repos/octocat/Hello-World/tags/protection", 201, header, tagProtectionBody},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:                context.Background(),
+			pattern:            "v1.*",
+			expectedProtection: &tagProtection,
+			expectedResponse: &Response{
+				Rate: expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			defer ts.Close()
+
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			protection, resp, err := tc.s.CreateTagProtection(tc.ctx, tc.pattern)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, protection)
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedProtection, protection)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestRepoService_DeleteTagProtection(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	tests := []struct {
+		name             string
+		mockResponses    []MockResponse
+		s                *RepoService
+		ctx              context.Context
+		protectionID     int
+		expectedResponse *Response
+		expectedError    string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           nil,
+			protectionID:  2,
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"DELETE", "/repos/octocat/Hello-World/tags/protection/2", 401, http.Header{}, `{
+					"message": "Bad credentials"
+				}`},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			protectionID:  2,
+			expectedError: `DELETE /repos/octocat/Hello-World/tags/protection/2: 401 Bad credentials`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"DELETE", "/repos/octocat/Hello-World/tags/protection/2", 204, header, ``},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:          context.Background(),
+			protectionID: 2,
+			expectedResponse: &Response{
+				Rate: expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			defer ts.Close()
+
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			resp, err := tc.s.DeleteTagProtection(tc.ctx, tc.protectionID)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestRepoService_DownloadTarArchive(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	tests := []struct {
+		name             string
+		mockResponses    []MockResponse
+		s                *RepoService
+		ctx              context.Context
+		ref              string
+		w                io.Writer
+		expectedResponse *Response
+		expectedError    string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           nil,
+			ref:           "main",
+			w:             nil,
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/tarball/main", 401, http.Header{}, ``},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			ref:           "main",
+			w:             io.Discard,
+			expectedError: `GET /repos/octocat/Hello-World/tarball/main: 401 `,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/tarball/main", 200, header, `content`},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx: context.Background(),
+			ref: "main",
+			w:   io.Discard,
+			expectedResponse: &Response{
+				Rate: expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			defer ts.Close()
+
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			resp, err := tc.s.DownloadTarArchive(tc.ctx, tc.ref, tc.w)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestRepoService_DownloadZipArchive(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	tests := []struct {
+		name             string
+		mockResponses    []MockResponse
+		s                *RepoService
+		ctx              context.Context
+		ref              string
+		w                io.Writer
+		expectedResponse *Response
+		expectedError    string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           nil,
+			ref:           "main",
+			w:             nil,
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/zipball/main", 401, http.Header{}, ``},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			ref:           "main",
+			w:             io.Discard,
+			expectedError: `GET /repos/octocat/Hello-World/zipball/main: 401 `,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/zipball/main", 200, header, `content`},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx: context.Background(),
+			ref: "main",
+			w:   io.Discard,
+			expectedResponse: &Response{
+				Rate: expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			defer ts.Close()
+
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			resp, err := tc.s.DownloadZipArchive(tc.ctx, tc.ref, tc.w)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestRepoService_CreateFromTemplate(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	params := CreateFromTemplateParams{
+		Owner: "octocat",
+		Name:  "Hello-World",
+	}
+
+	tests := []struct {
+		name               string
+		mockResponses      []MockResponse
+		s                  *RepoService
+		ctx                context.Context
+		params             CreateFromTemplateParams
+		expectedRepository *Repository
+		expectedResponse   *Response
+		expectedError      string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "template",
+			},
+			ctx:           nil,
+			params:        params,
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"POST", "/repos/octocat/template/generate", 401, http.Header{}, `{
+					"message": "Bad credentials"
+				}`},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "template",
+			},
+			ctx:           context.Background(),
+			params:        params,
+			expectedError: `POST /repos/octocat/template/generate: 401 Bad credentials`,
+		},
+		{
+			name: "InvalidResponse",
+			mockResponses: []MockResponse{
+				{"POST", "/repos/octocat/template/generate", 201, http.Header{}, `{`},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "template",
+			},
+			ctx:           context.Background(),
+			params:        params,
+			expectedError: `unexpected EOF`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"POST", "/repos/octocat/template/generate", 201, header, repositoryBody},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "template",
+			},
+			ctx:                context.Background(),
+			params:             params,
+			expectedRepository: &repository,
+			expectedResponse: &Response{
+				Rate: expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			defer ts.Close()
+
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			repository, resp, err := tc.s.CreateFromTemplate(tc.ctx, tc.params)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, repository)
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedRepository, repository)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestRepoService_Update(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	archived := true
+	params := UpdateRepoParams{
+		DefaultBranch: "main",
+		Archived:      &archived,
+	}
+
+	tests := []struct {
+		name               string
+		mockResponses      []MockResponse
+		s                  *RepoService
+		ctx                context.Context
+		params             UpdateRepoParams
+		expectedRepository *Repository
+		expectedResponse   *Response
+		expectedError      string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           nil,
+			params:        params,
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"PATCH", "/repos/octocat/Hello-World", 401, http.Header{}, `{
+					"message": "Bad credentials"
+				}`},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			params:        params,
+			expectedError: `PATCH /repos/octocat/Hello-World: 401 Bad credentials`,
+		},
+		{
+			name: "InvalidResponse",
+			mockResponses: []MockResponse{
+				{"PATCH", "/repos/octocat/Hello-World", 200, http.Header{}, `{`},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			params:        params,
+			expectedError: `unexpected EOF`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"PATCH", "/repos/octocat/Hello-World", 200, header, repositoryBody},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:                context.Background(),
+			params:             params,
+			expectedRepository: &repository,
+			expectedResponse: &Response{
+				Rate: expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			defer ts.Close()
+
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			repository, resp, err := tc.s.Update(tc.ctx, tc.params)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, repository)
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedRepository, repository)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestRepoService_Delete(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	tests := []struct {
+		name             string
+		mockResponses    []MockResponse
+		s                *RepoService
+		ctx              context.Context
+		expectedResponse *Response
+		expectedError    string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           nil,
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"DELETE", "/repos/octocat/Hello-World", 403, http.Header{}, `{
+					"message": "Must have admin rights to Repository."
+				}`},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			expectedError: `DELETE /repos/octocat/Hello-World: 403 Must have admin rights to Repository.`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"DELETE", "/repos/octocat/Hello-World", 204, header, ``},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx: context.Background(),
+			expectedResponse: &Response{
+				Rate: expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			defer ts.Close()
+
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			resp, err := tc.s.Delete(tc.ctx)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestRepoService_Fork(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	tests := []struct {
+		name               string
+		mockResponses      []MockResponse
+		s                  *RepoService
+		ctx                context.Context
+		params             ForkParams
+		expectedRepository *Repository
+		expectedResponse   *Response
+		expectedError      string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           nil,
+			params:        ForkParams{},
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"POST", "/repos/octocat/Hello-World/forks", 401, http.Header{}, `{
+					"message": "Bad credentials"
+				}`},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			params:        ForkParams{},
+			expectedError: `POST /repos/octocat/Hello-World/forks: 401 Bad credentials`,
+		},
+		{
+			name: "InvalidResponse",
+			mockResponses: []MockResponse{
+				{"POST", "/repos/octocat/Hello-World/forks", 202, http.Header{}, `{`},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			params:        ForkParams{},
+			expectedError: `unexpected EOF`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"POST", "/repos/octocat/Hello-World/forks", 202, header, repositoryBody},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:                context.Background(),
+			params:             ForkParams{},
+			expectedRepository: &repository,
+			expectedResponse: &Response{
+				Rate: expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			defer ts.Close()
+
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			repository, resp, err := tc.s.Fork(tc.ctx, tc.params)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, repository)
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedRepository, repository)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestRepoService_ForkAndWait(t *testing.T) {
+	initialInterval, maxInterval := pollInitialInterval, pollMaxInterval
+	pollInitialInterval, pollMaxInterval = time.Millisecond, time.Millisecond
+	t.Cleanup(func() { pollInitialInterval, pollMaxInterval = initialInterval, maxInterval })
+
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	forkBody := `{
+		"id": 1296270,
+		"name": "Hello-World",
+		"full_name": "octo-org/Hello-World",
+		"owner": {
+			"login": "octo-org",
+			"id": 2,
+			"type": "Organization"
+		},
+		"fork": true
+	}`
+
+	expectedFork := &Repository{
+		ID:       1296270,
+		Name:     "Hello-World",
+		FullName: "octo-org/Hello-World",
+		Fork:     true,
+		Owner: User{
+			ID:    2,
+			Login: "octo-org",
+			Type:  "Organization",
+		},
+	}
+
+	tests := []struct {
+		name               string
+		mockResponses      []MockResponse
+		s                  *RepoService
+		ctx                context.Context
+		params             ForkParams
+		expectedRepository *Repository
+		expectedError      string
+	}{
+		{
+			name: "ForkFails",
+			mockResponses: []MockResponse{
+				{"POST", "/repos/octocat/Hello-World/forks", 401, http.Header{}, `{
+					"message": "Bad credentials"
+				}`},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			params:        ForkParams{Organization: "octo-org"},
+			expectedError: `POST /repos/octocat/Hello-World/forks: 401 Bad credentials`,
+		},
+		{
+			name: "PollingFails",
+			mockResponses: []MockResponse{
+				{"POST", "/repos/octocat/Hello-World/forks", 202, header, forkBody},
+				{"GET", "/repos/octo-org/Hello-World/commits", 404, http.Header{}, `{"message": "Not Found"}`},
+				{"GET", "/repos/octo-org/Hello-World/commits", 500, http.Header{}, ``},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			params:        ForkParams{Organization: "octo-org"},
+			expectedError: `GET /repos/octo-org/Hello-World/commits: 500 `,
+		},
+		{
+			name: "EmptyRepository",
+			mockResponses: []MockResponse{
+				{"POST", "/repos/octocat/Hello-World/forks", 202, header, forkBody},
+				{"GET", "/repos/octo-org/Hello-World/commits", 404, http.Header{}, `{"message": "Not Found"}`},
+				{"GET", "/repos/octo-org/Hello-World/commits", 409, http.Header{}, `{"message": "Git Repository is empty."}`},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:                context.Background(),
+			params:             ForkParams{Organization: "octo-org"},
+			expectedRepository: expectedFork,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"POST", "/repos/octocat/Hello-World/forks", 202, header, forkBody},
+				{"GET", "/repos/octo-org/Hello-World/commits", 404, http.Header{}, `{"message": "Not Found"}`},
+				{"GET", "/repos/octo-org/Hello-World/commits", 200, header, commitsBody},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:                context.Background(),
+			params:             ForkParams{Organization: "octo-org"},
+			expectedRepository: expectedFork,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			defer ts.Close()
+
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			repository, err := tc.s.ForkAndWait(tc.ctx, tc.params)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, repository)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedRepository, repository)
+			}
+		})
+	}
+}
+
+func TestRepoService_Transfer(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	params := TransferParams{
+		NewOwner: "octo-org",
+	}
+
+	tests := []struct {
+		name               string
+		mockResponses      []MockResponse
+		s                  *RepoService
+		ctx                context.Context
+		params             TransferParams
+		expectedRepository *Repository
+		expectedResponse   *Response
+		expectedError      string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           nil,
+			params:        params,
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"POST", "/repos/octocat/Hello-World/transfer", 401, http.Header{}, `{
+					"message": "Bad credentials"
+				}`},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			params:        params,
+			expectedError: `POST /repos/octocat/Hello-World/transfer: 401 Bad credentials`,
+		},
+		{
+			name: "InvalidResponse",
+			mockResponses: []MockResponse{
+				{"POST", "/repos/octocat/Hello-World/transfer", 202, http.Header{}, `{`},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			params:        params,
+			expectedError: `unexpected EOF`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"POST", "/repos/octocat/Hello-World/transfer", 202, header, repositoryBody},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:                context.Background(),
+			params:             params,
+			expectedRepository: &repository,
+			expectedResponse: &Response{
+				Rate: expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			defer ts.Close()
+
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			repository, resp, err := tc.s.Transfer(tc.ctx, tc.params)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, repository)
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedRepository, repository)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
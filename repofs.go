@@ -0,0 +1,342 @@
+package github
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"io/fs"
+	"path"
+	"sort"
+	"sync"
+	"time"
+)
+
+// FS returns a read-only file system for the repository at a reference (SHA, branch, or tag).
+// The file tree is retrieved on first use using the recursive Git tree API,
+// and the contents of files are retrieved lazily and cached.
+// The returned file system implements fs.ReadDirFS, fs.ReadFileFS, and fs.StatFS.
+//
+// Symbolic links are not followed; opening a symbolic link reads its target path.
+// Submodules are represented as irregular files with no content.
+func (s *RepoService) FS(ctx context.Context, ref string) fs.FS {
+	return &repoFS{
+		ctx:   ctx,
+		ref:   ref,
+		git:   s.Git,
+		blobs: map[string][]byte{},
+	}
+}
+
+type repoFS struct {
+	ctx context.Context
+	ref string
+	git *GitService
+
+	once    sync.Once
+	err     error
+	entries map[string]*repoFileInfo
+	dirs    map[string][]fs.DirEntry
+
+	mu    sync.Mutex
+	blobs map[string][]byte
+}
+
+// load retrieves the file tree of the repository once.
+func (f *repoFS) load() error {
+	f.once.Do(func() {
+		f.entries = map[string]*repoFileInfo{
+			".": {name: ".", mode: fs.ModeDir | 0555},
+		}
+		f.dirs = map[string][]fs.DirEntry{}
+
+		tree, _, err := f.git.Tree(f.ctx, f.ref, true)
+		if err != nil {
+			f.err = err
+			return
+		}
+
+		entries := tree.Entries
+		if tree.Truncated {
+			if entries, err = f.walkTree(f.ref, ""); err != nil {
+				f.err = err
+				return
+			}
+		}
+
+		for _, e := range entries {
+			info := newRepoFileInfo(e)
+			f.entries[e.Path] = info
+			dir := path.Dir(e.Path)
+			f.dirs[dir] = append(f.dirs[dir], fs.FileInfoToDirEntry(info))
+		}
+
+		for _, entries := range f.dirs {
+			sort.Slice(entries, func(i, j int) bool {
+				return entries[i].Name() < entries[j].Name()
+			})
+		}
+	})
+
+	return f.err
+}
+
+// walkTree retrieves the entries of a tree and its subtrees one tree at a time.
+// It is used when the recursive tree is truncated.
+func (f *repoFS) walkTree(sha, prefix string) ([]TreeEntry, error) {
+	tree, _, err := f.git.Tree(f.ctx, sha, false)
+	if err != nil {
+		return nil, err
+	}
+
+	entries := []TreeEntry{}
+	for _, e := range tree.Entries {
+		e.Path = path.Join(prefix, e.Path)
+		entries = append(entries, e)
+
+		if e.Type == "tree" {
+			subentries, err := f.walkTree(e.SHA, e.Path)
+			if err != nil {
+				return nil, err
+			}
+			entries = append(entries, subentries...)
+		}
+	}
+
+	return entries, nil
+}
+
+func (f *repoFS) lookup(op, name string) (*repoFileInfo, error) {
+	if !fs.ValidPath(name) {
+		return nil, &fs.PathError{Op: op, Path: name, Err: fs.ErrInvalid}
+	}
+
+	if err := f.load(); err != nil {
+		return nil, &fs.PathError{Op: op, Path: name, Err: err}
+	}
+
+	info, ok := f.entries[name]
+	if !ok {
+		return nil, &fs.PathError{Op: op, Path: name, Err: fs.ErrNotExist}
+	}
+
+	return info, nil
+}
+
+// content retrieves the content of a blob once and caches it.
+func (f *repoFS) content(info *repoFileInfo) ([]byte, error) {
+	if info.sha == "" {
+		return []byte{}, nil
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if b, ok := f.blobs[info.sha]; ok {
+		return b, nil
+	}
+
+	blob, _, err := f.git.Blob(f.ctx, info.sha)
+	if err != nil {
+		return nil, err
+	}
+
+	f.blobs[info.sha] = blob.Data
+
+	return blob.Data, nil
+}
+
+// Open implements the fs.FS interface.
+func (f *repoFS) Open(name string) (fs.File, error) {
+	info, err := f.lookup("open", name)
+	if err != nil {
+		return nil, err
+	}
+
+	if info.IsDir() {
+		return &repoDir{
+			info:    info,
+			entries: f.dirs[name],
+		}, nil
+	}
+
+	return &repoFile{
+		fs:   f,
+		info: info,
+	}, nil
+}
+
+// ReadDir implements the fs.ReadDirFS interface.
+func (f *repoFS) ReadDir(name string) ([]fs.DirEntry, error) {
+	info, err := f.lookup("readdir", name)
+	if err != nil {
+		return nil, err
+	}
+
+	if !info.IsDir() {
+		return nil, &fs.PathError{Op: "readdir", Path: name, Err: fs.ErrInvalid}
+	}
+
+	entries := make([]fs.DirEntry, len(f.dirs[name]))
+	copy(entries, f.dirs[name])
+
+	return entries, nil
+}
+
+// ReadFile implements the fs.ReadFileFS interface.
+func (f *repoFS) ReadFile(name string) ([]byte, error) {
+	info, err := f.lookup("read", name)
+	if err != nil {
+		return nil, err
+	}
+
+	if info.IsDir() {
+		return nil, &fs.PathError{Op: "read", Path: name, Err: fs.ErrInvalid}
+	}
+
+	b, err := f.content(info)
+	if err != nil {
+		return nil, &fs.PathError{Op: "read", Path: name, Err: err}
+	}
+
+	return bytes.Clone(b), nil
+}
+
+// Stat implements the fs.StatFS interface.
+func (f *repoFS) Stat(name string) (fs.FileInfo, error) {
+	info, err := f.lookup("stat", name)
+	if err != nil {
+		return nil, err
+	}
+
+	return info, nil
+}
+
+// ReadLink returns the target of a symbolic link.
+func (f *repoFS) ReadLink(name string) (string, error) {
+	info, err := f.lookup("readlink", name)
+	if err != nil {
+		return "", err
+	}
+
+	if info.mode&fs.ModeSymlink == 0 {
+		return "", &fs.PathError{Op: "readlink", Path: name, Err: fs.ErrInvalid}
+	}
+
+	b, err := f.content(info)
+	if err != nil {
+		return "", &fs.PathError{Op: "readlink", Path: name, Err: err}
+	}
+
+	return string(b), nil
+}
+
+// Lstat returns the file info of a file without following symbolic links.
+func (f *repoFS) Lstat(name string) (fs.FileInfo, error) {
+	return f.Stat(name)
+}
+
+// repoFileInfo implements the fs.FileInfo interface for a Git tree entry.
+type repoFileInfo struct {
+	name string
+	size int64
+	mode fs.FileMode
+	sha  string
+}
+
+func newRepoFileInfo(e TreeEntry) *repoFileInfo {
+	info := &repoFileInfo{
+		name: path.Base(e.Path),
+		size: int64(e.Size),
+		sha:  e.SHA,
+	}
+
+	switch e.Mode {
+	case TreeModeDir:
+		info.mode = fs.ModeDir | 0555
+		info.sha = ""
+	case TreeModeExecutable:
+		info.mode = 0555
+	case TreeModeSymlink:
+		info.mode = fs.ModeSymlink | 0444
+	case TreeModeSubmodule:
+		info.mode = fs.ModeIrregular | 0444
+		info.sha = ""
+	default:
+		info.mode = 0444
+	}
+
+	return info
+}
+
+func (i *repoFileInfo) Name() string       { return i.name }
+func (i *repoFileInfo) Size() int64        { return i.size }
+func (i *repoFileInfo) Mode() fs.FileMode  { return i.mode }
+func (i *repoFileInfo) ModTime() time.Time { return time.Time{} }
+func (i *repoFileInfo) IsDir() bool        { return i.mode.IsDir() }
+func (i *repoFileInfo) Sys() interface{}   { return nil }
+
+// repoFile implements the fs.File interface for a file in a repository.
+type repoFile struct {
+	fs     *repoFS
+	info   *repoFileInfo
+	reader *bytes.Reader
+}
+
+func (f *repoFile) Stat() (fs.FileInfo, error) {
+	return f.info, nil
+}
+
+func (f *repoFile) Read(b []byte) (int, error) {
+	if f.reader == nil {
+		content, err := f.fs.content(f.info)
+		if err != nil {
+			return 0, &fs.PathError{Op: "read", Path: f.info.name, Err: err}
+		}
+		f.reader = bytes.NewReader(content)
+	}
+
+	return f.reader.Read(b)
+}
+
+func (f *repoFile) Close() error {
+	return nil
+}
+
+// repoDir implements the fs.ReadDirFile interface for a directory in a repository.
+type repoDir struct {
+	info    *repoFileInfo
+	entries []fs.DirEntry
+	offset  int
+}
+
+func (d *repoDir) Stat() (fs.FileInfo, error) {
+	return d.info, nil
+}
+
+func (d *repoDir) Read([]byte) (int, error) {
+	return 0, &fs.PathError{Op: "read", Path: d.info.name, Err: fs.ErrInvalid}
+}
+
+func (d *repoDir) Close() error {
+	return nil
+}
+
+func (d *repoDir) ReadDir(n int) ([]fs.DirEntry, error) {
+	remaining := d.entries[d.offset:]
+	if n <= 0 {
+		d.offset = len(d.entries)
+		return append([]fs.DirEntry{}, remaining...), nil
+	}
+
+	if len(remaining) == 0 {
+		return nil, io.EOF
+	}
+
+	if n > len(remaining) {
+		n = len(remaining)
+	}
+
+	d.offset += n
+
+	return append([]fs.DirEntry{}, remaining[:n]...), nil
+}
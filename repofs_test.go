@@ -0,0 +1,238 @@
+package github
+
+import (
+	"context"
+	"io"
+	"io/fs"
+	"net/http"
+	"net/url"
+	"testing"
+	"testing/fstest"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	fsTreeBody = `{
+		"sha": "9fb037999f264ba9a7fc6274d15fa3ae2ab98312",
+		"truncated": false,
+		"tree": [
+			{ "path": "README.md", "mode": "100644", "type": "blob", "size": 13, "sha": "1000000000000000000000000000000000000001" },
+			{ "path": "bin", "mode": "040000", "type": "tree", "sha": "2000000000000000000000000000000000000002" },
+			{ "path": "bin/run.sh", "mode": "100755", "type": "blob", "size": 19, "sha": "3000000000000000000000000000000000000003" },
+			{ "path": "docs", "mode": "040000", "type": "tree", "sha": "4000000000000000000000000000000000000004" },
+			{ "path": "docs/guide.md", "mode": "100644", "type": "blob", "size": 7, "sha": "5000000000000000000000000000000000000005" },
+			{ "path": "docs/index.md", "mode": "120000", "type": "blob", "size": 8, "sha": "6000000000000000000000000000000000000006" },
+			{ "path": "vendor", "mode": "160000", "type": "commit", "sha": "7000000000000000000000000000000000000007" }
+		]
+	}`
+
+	fsTruncatedTreeBody = `{
+		"sha": "9fb037999f264ba9a7fc6274d15fa3ae2ab98312",
+		"truncated": true,
+		"tree": [
+			{ "path": "README.md", "mode": "100644", "type": "blob", "size": 13, "sha": "1000000000000000000000000000000000000001" }
+		]
+	}`
+
+	fsRootTreeBody = `{
+		"sha": "9fb037999f264ba9a7fc6274d15fa3ae2ab98312",
+		"truncated": false,
+		"tree": [
+			{ "path": "README.md", "mode": "100644", "type": "blob", "size": 13, "sha": "1000000000000000000000000000000000000001" },
+			{ "path": "docs", "mode": "040000", "type": "tree", "sha": "4000000000000000000000000000000000000004" }
+		]
+	}`
+
+	fsDocsTreeBody = `{
+		"sha": "4000000000000000000000000000000000000004",
+		"truncated": false,
+		"tree": [
+			{ "path": "guide.md", "mode": "100644", "type": "blob", "size": 7, "sha": "5000000000000000000000000000000000000005" }
+		]
+	}`
+)
+
+var fsBlobMocks = []MockResponse{
+	{"GET", "/repos/octocat/Hello-World/git/blobs/1000000000000000000000000000000000000001", 200, header, `{
+		"sha": "1000000000000000000000000000000000000001",
+		"size": 13,
+		"encoding": "base64",
+		"content": "SGVsbG8sIFdvcmxkIQ=="
+	}`},
+	{"GET", "/repos/octocat/Hello-World/git/blobs/3000000000000000000000000000000000000003", 200, header, `{
+		"sha": "3000000000000000000000000000000000000003",
+		"size": 19,
+		"encoding": "base64",
+		"content": "IyEvYmluL3NoCmVjaG8gcnVuCg=="
+	}`},
+	{"GET", "/repos/octocat/Hello-World/git/blobs/5000000000000000000000000000000000000005", 200, header, `{
+		"sha": "5000000000000000000000000000000000000005",
+		"size": 7,
+		"encoding": "base64",
+		"content": "IyBHdWlkZQ=="
+	}`},
+	{"GET", "/repos/octocat/Hello-World/git/blobs/6000000000000000000000000000000000000006", 200, header, `{
+		"sha": "6000000000000000000000000000000000000006",
+		"size": 8,
+		"encoding": "base64",
+		"content": "Z3VpZGUubWQ="
+	}`},
+}
+
+func TestRepoService_FS(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	s := &RepoService{
+		client: c,
+		owner:  "octocat",
+		repo:   "Hello-World",
+		Git: &GitService{
+			client: c,
+			owner:  "octocat",
+			repo:   "Hello-World",
+		},
+	}
+
+	t.Run("TestFS", func(t *testing.T) {
+		ts := newHTTPTestServer(append([]MockResponse{
+			{"GET", "/repos/octocat/Hello-World/git/trees/main", 200, header, fsTreeBody},
+		}, fsBlobMocks...)...)
+		defer ts.Close()
+
+		c.apiURL, _ = url.Parse(ts.URL)
+
+		fsys := s.FS(context.Background(), "main")
+		assert.NoError(t, fstest.TestFS(fsys, "README.md", "bin/run.sh", "docs/guide.md", "docs/index.md", "vendor"))
+	})
+
+	t.Run("Truncated", func(t *testing.T) {
+		ts := newHTTPTestServer(append([]MockResponse{
+			{"GET", "/repos/octocat/Hello-World/git/trees/main", 200, header, fsTruncatedTreeBody},
+			{"GET", "/repos/octocat/Hello-World/git/trees/main", 200, header, fsRootTreeBody},
+			{"GET", "/repos/octocat/Hello-World/git/trees/4000000000000000000000000000000000000004", 200, header, fsDocsTreeBody},
+		}, fsBlobMocks...)...)
+		defer ts.Close()
+
+		c.apiURL, _ = url.Parse(ts.URL)
+
+		fsys := s.FS(context.Background(), "main")
+
+		matches, err := fs.Glob(fsys, "*/*.md")
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"docs/guide.md"}, matches)
+
+		b, err := fs.ReadFile(fsys, "docs/guide.md")
+		assert.NoError(t, err)
+		assert.Equal(t, "# Guide", string(b))
+	})
+
+	t.Run("TreeError", func(t *testing.T) {
+		ts := newHTTPTestServer(
+			MockResponse{"GET", "/repos/octocat/Hello-World/git/trees/main", 404, http.Header{}, `{
+				"message": "Not Found"
+			}`},
+		)
+		defer ts.Close()
+
+		c.apiURL, _ = url.Parse(ts.URL)
+
+		fsys := s.FS(context.Background(), "main")
+
+		_, err := fsys.Open("README.md")
+		assert.EqualError(t, err, "open README.md: GET /repos/octocat/Hello-World/git/trees/main: 404 Not Found")
+	})
+
+	t.Run("BlobError", func(t *testing.T) {
+		ts := newHTTPTestServer(
+			MockResponse{"GET", "/repos/octocat/Hello-World/git/trees/main", 200, header, fsTreeBody},
+			MockResponse{"GET", "/repos/octocat/Hello-World/git/blobs/1000000000000000000000000000000000000001", 401, http.Header{}, `{
+				"message": "Bad credentials"
+			}`},
+		)
+		defer ts.Close()
+
+		c.apiURL, _ = url.Parse(ts.URL)
+
+		fsys := s.FS(context.Background(), "main")
+
+		_, err := fs.ReadFile(fsys, "README.md")
+		assert.EqualError(t, err, "read README.md: GET /repos/octocat/Hello-World/git/blobs/1000000000000000000000000000000000000001: 401 Bad credentials")
+
+		f, err := fsys.Open("README.md")
+		assert.NoError(t, err)
+		_, err = io.ReadAll(f)
+		assert.EqualError(t, err, "read README.md: GET /repos/octocat/Hello-World/git/blobs/1000000000000000000000000000000000000001: 401 Bad credentials")
+	})
+
+	t.Run("Files", func(t *testing.T) {
+		ts := newHTTPTestServer(append([]MockResponse{
+			{"GET", "/repos/octocat/Hello-World/git/trees/main", 200, header, fsTreeBody},
+		}, fsBlobMocks...)...)
+		defer ts.Close()
+
+		c.apiURL, _ = url.Parse(ts.URL)
+
+		fsys := s.FS(context.Background(), "main")
+
+		_, err := fsys.Open("../README.md")
+		assert.EqualError(t, err, "open ../README.md: invalid argument")
+
+		_, err = fs.Stat(fsys, "LICENSE")
+		assert.EqualError(t, err, "stat LICENSE: file does not exist")
+
+		_, err = fs.ReadDir(fsys, "README.md")
+		assert.EqualError(t, err, "readdir README.md: invalid argument")
+
+		_, err = fs.ReadFile(fsys, "docs")
+		assert.EqualError(t, err, "read docs: invalid argument")
+
+		entries, err := fs.ReadDir(fsys, ".")
+		assert.NoError(t, err)
+		names := []string{}
+		for _, e := range entries {
+			names = append(names, e.Name())
+		}
+		assert.Equal(t, []string{"README.md", "bin", "docs", "vendor"}, names)
+
+		info, err := fs.Stat(fsys, "README.md")
+		assert.NoError(t, err)
+		assert.Equal(t, fs.FileMode(0444), info.Mode())
+		assert.Equal(t, int64(13), info.Size())
+
+		info, err = fs.Stat(fsys, "bin/run.sh")
+		assert.NoError(t, err)
+		assert.Equal(t, fs.FileMode(0555), info.Mode())
+
+		info, err = fs.Stat(fsys, "docs")
+		assert.NoError(t, err)
+		assert.True(t, info.IsDir())
+
+		info, err = fs.Stat(fsys, "docs/index.md")
+		assert.NoError(t, err)
+		assert.Equal(t, fs.ModeSymlink, info.Mode().Type())
+
+		info, err = fs.Stat(fsys, "vendor")
+		assert.NoError(t, err)
+		assert.Equal(t, fs.ModeIrregular, info.Mode().Type())
+
+		b, err := fs.ReadFile(fsys, "README.md")
+		assert.NoError(t, err)
+		assert.Equal(t, "Hello, World!", string(b))
+
+		b, err = fs.ReadFile(fsys, "vendor")
+		assert.NoError(t, err)
+		assert.Empty(t, b)
+
+		target, err := fsys.(*repoFS).ReadLink("docs/index.md")
+		assert.NoError(t, err)
+		assert.Equal(t, "guide.md", target)
+
+		_, err = fsys.(*repoFS).ReadLink("README.md")
+		assert.EqualError(t, err, "readlink README.md: invalid argument")
+	})
+}
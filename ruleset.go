@@ -0,0 +1,575 @@
+package github
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+)
+
+// RulesetService provides GitHub APIs for rulesets in a repository or an organization.
+// See https://docs.github.com/en/rest/repos/rules
+// See https://docs.github.com/en/rest/orgs/rules
+type RulesetService struct {
+	client   *Client
+	basePath string
+}
+
+// RulesetTarget is the target of a GitHub ruleset.
+type RulesetTarget string
+
+const (
+	// RulesetTargetBranch targets branches.
+	RulesetTargetBranch RulesetTarget = "branch"
+	// RulesetTargetTag targets tags.
+	RulesetTargetTag RulesetTarget = "tag"
+	// RulesetTargetPush targets pushes.
+	RulesetTargetPush RulesetTarget = "push"
+)
+
+// RulesetEnforcement is the enforcement level of a GitHub ruleset.
+type RulesetEnforcement string
+
+const (
+	// EnforcementDisabled does not enforce a ruleset.
+	EnforcementDisabled RulesetEnforcement = "disabled"
+	// EnforcementActive enforces a ruleset.
+	EnforcementActive RulesetEnforcement = "active"
+	// EnforcementEvaluate only evaluates a ruleset without enforcing it.
+	EnforcementEvaluate RulesetEnforcement = "evaluate"
+)
+
+// RuleType is the type of a GitHub ruleset rule.
+type RuleType string
+
+const (
+	// RuleTypeCreation only allows users with bypass permission to create matching refs.
+	RuleTypeCreation RuleType = "creation"
+	// RuleTypeUpdate only allows users with bypass permission to update matching refs.
+	RuleTypeUpdate RuleType = "update"
+	// RuleTypeDeletion only allows users with bypass permissions to delete matching refs.
+	RuleTypeDeletion RuleType = "deletion"
+	// RuleTypeRequiredLinearHistory prevents merge commits from being pushed to matching refs.
+	RuleTypeRequiredLinearHistory RuleType = "required_linear_history"
+	// RuleTypeRequiredDeployments requires deployments to succeed before refs can be pushed.
+	RuleTypeRequiredDeployments RuleType = "required_deployments"
+	// RuleTypeRequiredSignatures requires commits pushed to matching refs to have verified signatures.
+	RuleTypeRequiredSignatures RuleType = "required_signatures"
+	// RuleTypePullRequest requires all commits to be made to a non-target branch and submitted via a pull request.
+	RuleTypePullRequest RuleType = "pull_request"
+	// RuleTypeRequiredStatusChecks requires status checks to pass before refs can be updated.
+	RuleTypeRequiredStatusChecks RuleType = "required_status_checks"
+	// RuleTypeNonFastForward prevents users with push access from force pushing to refs.
+	RuleTypeNonFastForward RuleType = "non_fast_forward"
+	// RuleTypeCommitMessagePattern requires commit messages to match a pattern.
+	RuleTypeCommitMessagePattern RuleType = "commit_message_pattern"
+	// RuleTypeCommitAuthorEmailPattern requires commit author emails to match a pattern.
+	RuleTypeCommitAuthorEmailPattern RuleType = "commit_author_email_pattern"
+	// RuleTypeCommitterEmailPattern requires committer emails to match a pattern.
+	RuleTypeCommitterEmailPattern RuleType = "committer_email_pattern"
+	// RuleTypeBranchNamePattern requires branch names to match a pattern.
+	RuleTypeBranchNamePattern RuleType = "branch_name_pattern"
+	// RuleTypeTagNamePattern requires tag names to match a pattern.
+	RuleTypeTagNamePattern RuleType = "tag_name_pattern"
+	// RuleTypeFilePathRestriction prevents commits that change the specified file paths from being pushed.
+	RuleTypeFilePathRestriction RuleType = "file_path_restriction"
+)
+
+type (
+	// PullRequestRuleParameters are the parameters of a pull_request rule.
+	PullRequestRuleParameters struct {
+		DismissStaleReviewsOnPush      bool     `json:"dismiss_stale_reviews_on_push"`
+		RequireCodeOwnerReview         bool     `json:"require_code_owner_review"`
+		RequireLastPushApproval        bool     `json:"require_last_push_approval"`
+		RequiredApprovingReviewCount   int      `json:"required_approving_review_count"`
+		RequiredReviewThreadResolution bool     `json:"required_review_thread_resolution"`
+		AllowedMergeMethods            []string `json:"allowed_merge_methods,omitempty"` // Any of merge, squash, or rebase
+	}
+
+	// RuleStatusCheck is a status check required by a required_status_checks rule.
+	RuleStatusCheck struct {
+		Context       string `json:"context"`
+		IntegrationID *int   `json:"integration_id,omitempty"`
+	}
+
+	// StatusChecksRuleParameters are the parameters of a required_status_checks rule.
+	StatusChecksRuleParameters struct {
+		StrictRequiredStatusChecksPolicy bool              `json:"strict_required_status_checks_policy"`
+		DoNotEnforceOnCreate             bool              `json:"do_not_enforce_on_create,omitempty"`
+		RequiredStatusChecks             []RuleStatusCheck `json:"required_status_checks"`
+	}
+
+	// PatternRuleParameters are the parameters of the *_pattern rules.
+	PatternRuleParameters struct {
+		Name     string `json:"name,omitempty"`
+		Negate   bool   `json:"negate"`
+		Operator string `json:"operator"` // Either starts_with, ends_with, contains, or regex
+		Pattern  string `json:"pattern"`
+	}
+
+	// FilePathRestrictionRuleParameters are the parameters of a file_path_restriction rule.
+	FilePathRestrictionRuleParameters struct {
+		RestrictedFilePaths []string `json:"restricted_file_paths"`
+	}
+
+	// UpdateRuleParameters are the parameters of an update rule.
+	UpdateRuleParameters struct {
+		UpdateAllowsFetchAndMerge bool `json:"update_allows_fetch_and_merge"`
+	}
+
+	// RequiredDeploymentsRuleParameters are the parameters of a required_deployments rule.
+	RequiredDeploymentsRuleParameters struct {
+		RequiredDeploymentEnvironments []string `json:"required_deployment_environments"`
+	}
+
+	// Rule is a GitHub ruleset rule.
+	// Depending on the Type, at most one of the typed parameters is set.
+	// The raw parameters of every rule are kept in Parameters, and the typed parameters are merged over them when marshaling,
+	// so rules of unknown types and parameters not modelled by the typed parameters are preserved
+	// when a ruleset is read and written back.
+	Rule struct {
+		Type                 RuleType
+		PullRequest          *PullRequestRuleParameters
+		RequiredStatusChecks *StatusChecksRuleParameters
+		Pattern              *PatternRuleParameters
+		FilePathRestriction  *FilePathRestrictionRuleParameters
+		Update               *UpdateRuleParameters
+		RequiredDeployments  *RequiredDeploymentsRuleParameters
+		Parameters           json.RawMessage
+	}
+)
+
+// ruleJSON is the wire representation of a ruleset rule.
+type ruleJSON struct {
+	Type       RuleType        `json:"type"`
+	Parameters json.RawMessage `json:"parameters,omitempty"`
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (r Rule) MarshalJSON() ([]byte, error) {
+	var params interface{}
+	switch {
+	case r.PullRequest != nil:
+		params = r.PullRequest
+	case r.RequiredStatusChecks != nil:
+		params = r.RequiredStatusChecks
+	case r.Pattern != nil:
+		params = r.Pattern
+	case r.FilePathRestriction != nil:
+		params = r.FilePathRestriction
+	case r.Update != nil:
+		params = r.Update
+	case r.RequiredDeployments != nil:
+		params = r.RequiredDeployments
+	}
+
+	rule := ruleJSON{
+		Type:       r.Type,
+		Parameters: r.Parameters,
+	}
+
+	if params != nil {
+		b, err := mergeRuleParameters(r.Parameters, params)
+		if err != nil {
+			return nil, err
+		}
+		rule.Parameters = b
+	}
+
+	return json.Marshal(rule)
+}
+
+// mergeRuleParameters merges typed rule parameters over raw rule parameters.
+// The fields of the typed parameters replace the raw fields with the same names, even if they are omitted,
+// and the raw fields not modelled by the typed parameters are kept.
+func mergeRuleParameters(raw json.RawMessage, typed interface{}) (json.RawMessage, error) {
+	b, err := json.Marshal(typed)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(raw) == 0 {
+		return b, nil
+	}
+
+	merged := map[string]json.RawMessage{}
+	if err := json.Unmarshal(raw, &merged); err != nil {
+		return nil, err
+	}
+
+	t := reflect.TypeOf(typed).Elem()
+	for i := 0; i < t.NumField(); i++ {
+		name, _, _ := strings.Cut(t.Field(i).Tag.Get("json"), ",")
+		delete(merged, name)
+	}
+
+	if err := json.Unmarshal(b, &merged); err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(merged)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (r *Rule) UnmarshalJSON(b []byte) error {
+	var rule ruleJSON
+	if err := json.Unmarshal(b, &rule); err != nil {
+		return err
+	}
+
+	*r = Rule{
+		Type: rule.Type,
+	}
+
+	if len(rule.Parameters) == 0 {
+		return nil
+	}
+
+	var params bytes.Buffer
+	if err := json.Compact(&params, rule.Parameters); err != nil {
+		return err
+	}
+	r.Parameters = params.Bytes()
+
+	var typed interface{}
+	switch rule.Type {
+	case RuleTypePullRequest:
+		r.PullRequest = new(PullRequestRuleParameters)
+		typed = r.PullRequest
+	case RuleTypeRequiredStatusChecks:
+		r.RequiredStatusChecks = new(StatusChecksRuleParameters)
+		typed = r.RequiredStatusChecks
+	case RuleTypeCommitMessagePattern, RuleTypeCommitAuthorEmailPattern, RuleTypeCommitterEmailPattern, RuleTypeBranchNamePattern, RuleTypeTagNamePattern:
+		r.Pattern = new(PatternRuleParameters)
+		typed = r.Pattern
+	case RuleTypeFilePathRestriction:
+		r.FilePathRestriction = new(FilePathRestrictionRuleParameters)
+		typed = r.FilePathRestriction
+	case RuleTypeUpdate:
+		r.Update = new(UpdateRuleParameters)
+		typed = r.Update
+	case RuleTypeRequiredDeployments:
+		r.RequiredDeployments = new(RequiredDeploymentsRuleParameters)
+		typed = r.RequiredDeployments
+	default:
+		return nil
+	}
+
+	return json.Unmarshal(rule.Parameters, typed)
+}
+
+type (
+	// RulesetBypassActor is an actor that can bypass a GitHub ruleset.
+	RulesetBypassActor struct {
+		ActorID    *int   `json:"actor_id"`
+		ActorType  string `json:"actor_type"`  // Either Integration, OrganizationAdmin, RepositoryRole, Team, or DeployKey
+		BypassMode string `json:"bypass_mode"` // Either always or pull_request
+	}
+
+	// RulesetRefCondition specifies the refs a GitHub ruleset applies to.
+	// Patterns can use fnmatch syntax and the special values ~DEFAULT_BRANCH and ~ALL.
+	RulesetRefCondition struct {
+		Include []string `json:"include"`
+		Exclude []string `json:"exclude"`
+	}
+
+	// RulesetRepoNameCondition specifies the repositories an organization ruleset applies to by name.
+	RulesetRepoNameCondition struct {
+		Include   []string `json:"include"`
+		Exclude   []string `json:"exclude"`
+		Protected bool     `json:"protected,omitempty"`
+	}
+
+	// RulesetRepoIDCondition specifies the repositories an organization ruleset applies to by id.
+	RulesetRepoIDCondition struct {
+		RepositoryIDs []int `json:"repository_ids"`
+	}
+
+	// RulesetConditions are the conditions for a GitHub ruleset to apply.
+	RulesetConditions struct {
+		RefName        *RulesetRefCondition      `json:"ref_name,omitempty"`
+		RepositoryName *RulesetRepoNameCondition `json:"repository_name,omitempty"`
+		RepositoryID   *RulesetRepoIDCondition   `json:"repository_id,omitempty"`
+	}
+
+	// RulesetParams is used for creating or updating a GitHub ruleset.
+	RulesetParams struct {
+		Name         string               `json:"name"`
+		Target       RulesetTarget        `json:"target,omitempty"`
+		Enforcement  RulesetEnforcement   `json:"enforcement"`
+		BypassActors []RulesetBypassActor `json:"bypass_actors,omitempty"`
+		Conditions   *RulesetConditions   `json:"conditions,omitempty"`
+		Rules        []Rule               `json:"rules,omitempty"`
+	}
+
+	// Ruleset is a GitHub ruleset object.
+	// BypassActors, Conditions, and Rules are only available when retrieving a single ruleset.
+	Ruleset struct {
+		ID                   int                  `json:"id"`
+		Name                 string               `json:"name"`
+		Target               RulesetTarget        `json:"target"`
+		SourceType           string               `json:"source_type"` // Either Repository or Organization
+		Source               string               `json:"source"`
+		Enforcement          RulesetEnforcement   `json:"enforcement"`
+		BypassActors         []RulesetBypassActor `json:"bypass_actors"`
+		CurrentUserCanBypass string               `json:"current_user_can_bypass"`
+		Conditions           *RulesetConditions   `json:"conditions"`
+		Rules                []Rule               `json:"rules"`
+		CreatedAt            time.Time            `json:"created_at"`
+		UpdatedAt            time.Time            `json:"updated_at"`
+	}
+
+	// BranchRule is a ruleset rule that applies to a branch.
+	BranchRule struct {
+		Rule
+		RulesetSourceType string
+		RulesetSource     string
+		RulesetID         int
+	}
+)
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (r *BranchRule) UnmarshalJSON(b []byte) error {
+	var source struct {
+		RulesetSourceType string `json:"ruleset_source_type"`
+		RulesetSource     string `json:"ruleset_source"`
+		RulesetID         int    `json:"ruleset_id"`
+	}
+
+	if err := json.Unmarshal(b, &source); err != nil {
+		return err
+	}
+
+	if err := json.Unmarshal(b, &r.Rule); err != nil {
+		return err
+	}
+
+	r.RulesetSourceType = source.RulesetSourceType
+	r.RulesetSource = source.RulesetSource
+	r.RulesetID = source.RulesetID
+
+	return nil
+}
+
+type (
+	// RuleEvaluationSource is the source of an evaluated rule.
+	RuleEvaluationSource struct {
+		Type string `json:"type"` // Either ruleset or protected_branch
+		ID   *int   `json:"id"`
+		Name string `json:"name"`
+	}
+
+	// RuleEvaluation is the evaluation of a single rule in a rule suite.
+	RuleEvaluation struct {
+		RuleSource  RuleEvaluationSource `json:"rule_source"`
+		Enforcement string               `json:"enforcement"` // Either active, evaluate, or deleted ruleset
+		Result      string               `json:"result"`      // Either pass or fail
+		RuleType    RuleType             `json:"rule_type"`
+		Details     string               `json:"details"`
+	}
+
+	// RuleSuite is the evaluation of the rules for a push to a repository.
+	// RuleEvaluations are only available when retrieving a single rule suite.
+	RuleSuite struct {
+		ID               int              `json:"id"`
+		ActorID          int              `json:"actor_id"`
+		ActorName        string           `json:"actor_name"`
+		BeforeSHA        string           `json:"before_sha"`
+		AfterSHA         string           `json:"after_sha"`
+		Ref              string           `json:"ref"`
+		RepositoryID     int              `json:"repository_id"`
+		RepositoryName   string           `json:"repository_name"`
+		Result           string           `json:"result"`            // Either pass, fail, or bypass
+		EvaluationResult string           `json:"evaluation_result"` // Either pass or fail
+		RuleEvaluations  []RuleEvaluation `json:"rule_evaluations"`
+		PushedAt         time.Time        `json:"pushed_at"`
+	}
+)
+
+// RuleSuitesFilter are used for fetching RuleSuites.
+type RuleSuitesFilter struct {
+	Ref            string
+	RepositoryName string // Only for organizations
+	TimePeriod     string // Either hour, day, week, or month
+	ActorName      string
+	Result         string // Either pass, fail, bypass, or all
+}
+
+// List retrieves all rulesets page by page.
+// See https://docs.github.com/en/rest/repos/rules#get-all-repository-rulesets
+// See https://docs.github.com/en/rest/orgs/rules#get-all-organization-repository-rulesets
+func (s *RulesetService) List(ctx context.Context, pageSize, pageNo int) ([]Ruleset, *Response, error) {
+	url := fmt.Sprintf("%s/rulesets", s.basePath)
+	req, err := s.client.NewPageRequest(ctx, "GET", url, pageSize, pageNo, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	rulesets := []Ruleset{}
+
+	resp, err := s.client.Do(req, &rulesets)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return rulesets, resp, nil
+}
+
+// Get retrieves a ruleset by its id.
+// See https://docs.github.com/en/rest/repos/rules#get-a-repository-ruleset
+// See https://docs.github.com/en/rest/orgs/rules#get-an-organization-repository-ruleset
+func (s *RulesetService) Get(ctx context.Context, id int) (*Ruleset, *Response, error) {
+	url := fmt.Sprintf("%s/rulesets/%d", s.basePath, id)
+	req, err := s.client.NewRequest(ctx, "GET", url, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ruleset := new(Ruleset)
+
+	resp, err := s.client.Do(req, ruleset)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return ruleset, resp, nil
+}
+
+// Create creates a new ruleset.
+// See https://docs.github.com/en/rest/repos/rules#create-a-repository-ruleset
+// See https://docs.github.com/en/rest/orgs/rules#create-an-organization-repository-ruleset
+func (s *RulesetService) Create(ctx context.Context, params RulesetParams) (*Ruleset, *Response, error) {
+	url := fmt.Sprintf("%s/rulesets", s.basePath)
+	req, err := s.client.NewRequest(ctx, "POST", url, params)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ruleset := new(Ruleset)
+
+	resp, err := s.client.Do(req, ruleset)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return ruleset, resp, nil
+}
+
+// Update updates an existing ruleset.
+// See https://docs.github.com/en/rest/repos/rules#update-a-repository-ruleset
+// See https://docs.github.com/en/rest/orgs/rules#update-an-organization-repository-ruleset
+func (s *RulesetService) Update(ctx context.Context, id int, params RulesetParams) (*Ruleset, *Response, error) {
+	url := fmt.Sprintf("%s/rulesets/%d", s.basePath, id)
+	req, err := s.client.NewRequest(ctx, "PUT", url, params)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ruleset := new(Ruleset)
+
+	resp, err := s.client.Do(req, ruleset)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return ruleset, resp, nil
+}
+
+// Delete deletes a ruleset by its id.
+// See https://docs.github.com/en/rest/repos/rules#delete-a-repository-ruleset
+// See https://docs.github.com/en/rest/orgs/rules#delete-an-organization-repository-ruleset
+func (s *RulesetService) Delete(ctx context.Context, id int) (*Response, error) {
+	url := fmt.Sprintf("%s/rulesets/%d", s.basePath, id)
+	req, err := s.client.NewRequest(ctx, "DELETE", url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// BranchRules retrieves all active rules that apply to a branch page by page.
+// This is only available for repository rulesets.
+// See https://docs.github.com/en/rest/repos/rules#get-rules-for-a-branch
+func (s *RulesetService) BranchRules(ctx context.Context, branch string, pageSize, pageNo int) ([]BranchRule, *Response, error) {
+	url := fmt.Sprintf("%s/rules/branches/%s", s.basePath, escapePath(branch))
+	req, err := s.client.NewPageRequest(ctx, "GET", url, pageSize, pageNo, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	rules := []BranchRule{}
+
+	resp, err := s.client.Do(req, &rules)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return rules, resp, nil
+}
+
+// RuleSuites retrieves all rule suite evaluations page by page.
+// See https://docs.github.com/en/rest/repos/rule-suites#list-repository-rule-suites
+// See https://docs.github.com/en/rest/orgs/rule-suites#list-organization-rule-suites
+func (s *RulesetService) RuleSuites(ctx context.Context, pageSize, pageNo int, filter RuleSuitesFilter) ([]RuleSuite, *Response, error) {
+	url := fmt.Sprintf("%s/rulesets/rule-suites", s.basePath)
+	req, err := s.client.NewPageRequest(ctx, "GET", url, pageSize, pageNo, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	q := req.URL.Query()
+	if filter.Ref != "" {
+		q.Add("ref", filter.Ref)
+	}
+	if filter.RepositoryName != "" {
+		q.Add("repository_name", filter.RepositoryName)
+	}
+	if filter.TimePeriod != "" {
+		q.Add("time_period", filter.TimePeriod)
+	}
+	if filter.ActorName != "" {
+		q.Add("actor_name", filter.ActorName)
+	}
+	if filter.Result != "" {
+		q.Add("rule_suite_result", filter.Result)
+	}
+	req.URL.RawQuery = q.Encode()
+
+	suites := []RuleSuite{}
+
+	resp, err := s.client.Do(req, &suites)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return suites, resp, nil
+}
+
+// RuleSuite retrieves a rule suite evaluation by its id including the evaluation of every rule.
+// See https://docs.github.com/en/rest/repos/rule-suites#get-a-repository-rule-suite
+// See https://docs.github.com/en/rest/orgs/rule-suites#get-an-organization-rule-suite
+func (s *RulesetService) RuleSuite(ctx context.Context, id int) (*RuleSuite, *Response, error) {
+	url := fmt.Sprintf("%s/rulesets/rule-suites/%d", s.basePath, id)
+	req, err := s.client.NewRequest(ctx, "GET", url, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	suite := new(RuleSuite)
+
+	resp, err := s.client.Do(req, suite)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return suite, resp, nil
+}
@@ -0,0 +1,1267 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	rulesetsBody = `[
+		{
+			"id": 42,
+			"name": "main protection",
+			"target": "branch",
+			"source_type": "Repository",
+			"source": "octocat/Hello-World",
+			"enforcement": "active",
+			"created_at": "2023-07-15T08:43:03Z",
+			"updated_at": "2023-08-23T16:29:47Z"
+		}
+	]`
+
+	rulesetBody = `{
+		"id": 42,
+		"name": "main protection",
+		"target": "branch",
+		"source_type": "Repository",
+		"source": "octocat/Hello-World",
+		"enforcement": "active",
+		"bypass_actors": [
+			{
+				"actor_id": 234,
+				"actor_type": "Team",
+				"bypass_mode": "always"
+			}
+		],
+		"current_user_can_bypass": "never",
+		"conditions": {
+			"ref_name": {
+				"include": ["~DEFAULT_BRANCH", "refs/heads/release/*"],
+				"exclude": []
+			}
+		},
+		"rules": [
+			{
+				"type": "non_fast_forward"
+			},
+			{
+				"type": "pull_request",
+				"parameters": {
+					"dismiss_stale_reviews_on_push": true,
+					"require_code_owner_review": true,
+					"require_last_push_approval": false,
+					"required_approving_review_count": 2,
+					"required_review_thread_resolution": true
+				}
+			},
+			{
+				"type": "commit_message_pattern",
+				"parameters": {
+					"name": "conventional commits",
+					"negate": false,
+					"operator": "regex",
+					"pattern": "^(feat|fix|chore): "
+				}
+			}
+		],
+		"created_at": "2023-07-15T08:43:03Z",
+		"updated_at": "2023-08-23T16:29:47Z"
+	}`
+
+	branchRulesBody = `[
+		{
+			"type": "required_status_checks",
+			"ruleset_source_type": "Organization",
+			"ruleset_source": "octo-org",
+			"ruleset_id": 7,
+			"parameters": {
+				"strict_required_status_checks_policy": true,
+				"required_status_checks": [
+					{
+						"context": "ci/build",
+						"integration_id": 15368
+					}
+				]
+			}
+		}
+	]`
+
+	ruleSuitesBody = `[
+		{
+			"id": 21,
+			"actor_id": 1,
+			"actor_name": "octocat",
+			"before_sha": "893f768e172fb1bc9c5d6f3dd48557e45f14e01d",
+			"after_sha": "dedd88641a362b6b4ea872da4847d6131a164d01",
+			"ref": "refs/heads/main",
+			"repository_id": 1296269,
+			"repository_name": "Hello-World",
+			"pushed_at": "2023-07-06T08:43:03Z",
+			"result": "bypass"
+		}
+	]`
+
+	ruleSuiteBody = `{
+		"id": 21,
+		"actor_id": 1,
+		"actor_name": "octocat",
+		"before_sha": "893f768e172fb1bc9c5d6f3dd48557e45f14e01d",
+		"after_sha": "dedd88641a362b6b4ea872da4847d6131a164d01",
+		"ref": "refs/heads/main",
+		"repository_id": 1296269,
+		"repository_name": "Hello-World",
+		"pushed_at": "2023-07-06T08:43:03Z",
+		"result": "bypass",
+		"evaluation_result": "fail",
+		"rule_evaluations": [
+			{
+				"rule_source": {
+					"type": "ruleset",
+					"id": 42,
+					"name": "main protection"
+				},
+				"enforcement": "active",
+				"result": "fail",
+				"rule_type": "commit_message_pattern",
+				"details": "Commit message does not match the pattern"
+			}
+		]
+	}`
+)
+
+var (
+	teamActorID      = 234
+	rulesetIntegID   = 15368
+	ruleSourceID     = 42
+	rulesetCreatedAt = parseGitHubTime("2023-07-15T08:43:03Z")
+	rulesetUpdatedAt = parseGitHubTime("2023-08-23T16:29:47Z")
+
+	rulesetSummary = Ruleset{
+		ID:          42,
+		Name:        "main protection",
+		Target:      RulesetTargetBranch,
+		SourceType:  "Repository",
+		Source:      "octocat/Hello-World",
+		Enforcement: EnforcementActive,
+		CreatedAt:   rulesetCreatedAt,
+		UpdatedAt:   rulesetUpdatedAt,
+	}
+
+	ruleset = Ruleset{
+		ID:          42,
+		Name:        "main protection",
+		Target:      RulesetTargetBranch,
+		SourceType:  "Repository",
+		Source:      "octocat/Hello-World",
+		Enforcement: EnforcementActive,
+		BypassActors: []RulesetBypassActor{
+			{
+				ActorID:    &teamActorID,
+				ActorType:  "Team",
+				BypassMode: "always",
+			},
+		},
+		CurrentUserCanBypass: "never",
+		Conditions: &RulesetConditions{
+			RefName: &RulesetRefCondition{
+				Include: []string{"~DEFAULT_BRANCH", "refs/heads/release/*"},
+				Exclude: []string{},
+			},
+		},
+		Rules: []Rule{
+			{
+				Type: RuleTypeNonFastForward,
+			},
+			{
+				Type: RuleTypePullRequest,
+				PullRequest: &PullRequestRuleParameters{
+					DismissStaleReviewsOnPush:      true,
+					RequireCodeOwnerReview:         true,
+					RequireLastPushApproval:        false,
+					RequiredApprovingReviewCount:   2,
+					RequiredReviewThreadResolution: true,
+				},
+				Parameters: json.RawMessage(`{"dismiss_stale_reviews_on_push":true,"require_code_owner_review":true,"require_last_push_approval":false,"required_approving_review_count":2,"required_review_thread_resolution":true}`),
+			},
+			{
+				Type: RuleTypeCommitMessagePattern,
+				Pattern: &PatternRuleParameters{
+					Name:     "conventional commits",
+					Negate:   false,
+					Operator: "regex",
+					Pattern:  "^(feat|fix|chore): ",
+				},
+				Parameters: json.RawMessage(`{"name":"conventional commits","negate":false,"operator":"regex","pattern":"^(feat|fix|chore): "}`),
+			},
+		},
+		CreatedAt: rulesetCreatedAt,
+		UpdatedAt: rulesetUpdatedAt,
+	}
+
+	rulesetParams = RulesetParams{
+		Name:        "main protection",
+		Target:      RulesetTargetBranch,
+		Enforcement: EnforcementActive,
+		Conditions: &RulesetConditions{
+			RefName: &RulesetRefCondition{
+				Include: []string{"~DEFAULT_BRANCH"},
+				Exclude: []string{},
+			},
+		},
+		Rules: []Rule{
+			{Type: RuleTypeNonFastForward},
+		},
+	}
+
+	branchRule = BranchRule{
+		Rule: Rule{
+			Type: RuleTypeRequiredStatusChecks,
+			RequiredStatusChecks: &StatusChecksRuleParameters{
+				StrictRequiredStatusChecksPolicy: true,
+				RequiredStatusChecks: []RuleStatusCheck{
+					{
+						Context:       "ci/build",
+						IntegrationID: &rulesetIntegID,
+					},
+				},
+			},
+			Parameters: json.RawMessage(`{"strict_required_status_checks_policy":true,"required_status_checks":[{"context":"ci/build","integration_id":15368}]}`),
+		},
+		RulesetSourceType: "Organization",
+		RulesetSource:     "octo-org",
+		RulesetID:         7,
+	}
+
+	ruleSuiteSummary = RuleSuite{
+		ID:             21,
+		ActorID:        1,
+		ActorName:      "octocat",
+		BeforeSHA:      "893f768e172fb1bc9c5d6f3dd48557e45f14e01d",
+		AfterSHA:       "dedd88641a362b6b4ea872da4847d6131a164d01",
+		Ref:            "refs/heads/main",
+		RepositoryID:   1296269,
+		RepositoryName: "Hello-World",
+		Result:         "bypass",
+		PushedAt:       parseGitHubTime("2023-07-06T08:43:03Z"),
+	}
+
+	ruleSuite = RuleSuite{
+		ID:               21,
+		ActorID:          1,
+		ActorName:        "octocat",
+		BeforeSHA:        "893f768e172fb1bc9c5d6f3dd48557e45f14e01d",
+		AfterSHA:         "dedd88641a362b6b4ea872da4847d6131a164d01",
+		Ref:              "refs/heads/main",
+		RepositoryID:     1296269,
+		RepositoryName:   "Hello-World",
+		Result:           "bypass",
+		EvaluationResult: "fail",
+		RuleEvaluations: []RuleEvaluation{
+			{
+				RuleSource: RuleEvaluationSource{
+					Type: "ruleset",
+					ID:   &ruleSourceID,
+					Name: "main protection",
+				},
+				Enforcement: "active",
+				Result:      "fail",
+				RuleType:    RuleTypeCommitMessagePattern,
+				Details:     "Commit message does not match the pattern",
+			},
+		},
+		PushedAt: parseGitHubTime("2023-07-06T08:43:03Z"),
+	}
+)
+
+func TestRule_MarshalJSON(t *testing.T) {
+	tests := []struct {
+		name         string
+		r            Rule
+		expectedJSON string
+	}{
+		{
+			name: "WithoutParameters",
+			r: Rule{
+				Type: RuleTypeDeletion,
+			},
+			expectedJSON: `{"type":"deletion"}`,
+		},
+		{
+			name: "TypedParameters",
+			r: Rule{
+				Type: RuleTypeFilePathRestriction,
+				FilePathRestriction: &FilePathRestrictionRuleParameters{
+					RestrictedFilePaths: []string{".github/workflows/**"},
+				},
+			},
+			expectedJSON: `{"type":"file_path_restriction","parameters":{"restricted_file_paths":[".github/workflows/**"]}}`,
+		},
+		{
+			name: "RawParameters",
+			r: Rule{
+				Type:       "max_file_size",
+				Parameters: json.RawMessage(`{"max_file_size":10}`),
+			},
+			expectedJSON: `{"type":"max_file_size","parameters":{"max_file_size":10}}`,
+		},
+		{
+			name: "MergedParameters",
+			r: Rule{
+				Type: RuleTypePullRequest,
+				PullRequest: &PullRequestRuleParameters{
+					RequiredApprovingReviewCount: 1,
+				},
+				Parameters: json.RawMessage(`{"required_approving_review_count":2,"allowed_merge_methods":["squash"],"automatic_copilot_code_review_enabled":true}`),
+			},
+			expectedJSON: `{"type":"pull_request","parameters":{"dismiss_stale_reviews_on_push":false,"require_code_owner_review":false,"require_last_push_approval":false,"required_approving_review_count":1,"required_review_thread_resolution":false,"automatic_copilot_code_review_enabled":true}}`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			b, err := json.Marshal(tc.r)
+
+			assert.NoError(t, err)
+			assert.JSONEq(t, tc.expectedJSON, string(b))
+		})
+	}
+}
+
+func TestRule_UnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		name          string
+		data          string
+		expectedRule  Rule
+		expectedError string
+	}{
+		{
+			name:          "InvalidJSON",
+			data:          `{`,
+			expectedError: `unexpected end of JSON input`,
+		},
+		{
+			name:          "InvalidParameters",
+			data:          `{"type":"update","parameters":{"update_allows_fetch_and_merge":"yes"}}`,
+			expectedError: `json: cannot unmarshal string into Go struct field UpdateRuleParameters.update_allows_fetch_and_merge of type bool`,
+		},
+		{
+			name: "WithoutParameters",
+			data: `{"type":"required_signatures"}`,
+			expectedRule: Rule{
+				Type: RuleTypeRequiredSignatures,
+			},
+		},
+		{
+			name: "TypedParameters",
+			data: `{"type":"required_deployments","parameters":{"required_deployment_environments":["staging"]}}`,
+			expectedRule: Rule{
+				Type: RuleTypeRequiredDeployments,
+				RequiredDeployments: &RequiredDeploymentsRuleParameters{
+					RequiredDeploymentEnvironments: []string{"staging"},
+				},
+				Parameters: json.RawMessage(`{"required_deployment_environments":["staging"]}`),
+			},
+		},
+		{
+			name: "UnknownType",
+			data: `{"type":"max_file_size","parameters":{"max_file_size":10}}`,
+			expectedRule: Rule{
+				Type:       "max_file_size",
+				Parameters: json.RawMessage(`{"max_file_size":10}`),
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var r Rule
+			err := json.Unmarshal([]byte(tc.data), &r)
+
+			if tc.expectedError != "" {
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedRule, r)
+			}
+		})
+	}
+}
+
+func TestRule_RoundTrip(t *testing.T) {
+	data := `[
+		{"type":"merge_queue","parameters":{"check_response_timeout_minutes":60,"grouping_strategy":"ALLGREEN"}},
+		{"type":"branch_name_pattern","parameters":{"negate":true,"operator":"starts_with","pattern":"tmp/"}},
+		{"type":"pull_request","parameters":{"dismiss_stale_reviews_on_push":true,"require_code_owner_review":false,"require_last_push_approval":false,"required_approving_review_count":1,"required_review_thread_resolution":false,"automatic_copilot_code_review_enabled":true}},
+		{"type":"creation"}
+	]`
+
+	var rules []Rule
+	err := json.Unmarshal([]byte(data), &rules)
+	assert.NoError(t, err)
+
+	b, err := json.Marshal(rules)
+	assert.NoError(t, err)
+	assert.JSONEq(t, data, string(b))
+}
+
+func TestBranchRule_UnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		name               string
+		data               string
+		expectedBranchRule BranchRule
+		expectedError      string
+	}{
+		{
+			name:          "InvalidJSON",
+			data:          `{`,
+			expectedError: `unexpected end of JSON input`,
+		},
+		{
+			name:          "InvalidRule",
+			data:          `{"type":"pull_request","parameters":[]}`,
+			expectedError: `json: cannot unmarshal array into Go value of type github.PullRequestRuleParameters`,
+		},
+		{
+			name: "Success",
+			data: `{"type":"deletion","ruleset_source_type":"Repository","ruleset_source":"octocat/Hello-World","ruleset_id":42}`,
+			expectedBranchRule: BranchRule{
+				Rule: Rule{
+					Type: RuleTypeDeletion,
+				},
+				RulesetSourceType: "Repository",
+				RulesetSource:     "octocat/Hello-World",
+				RulesetID:         42,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var r BranchRule
+			err := json.Unmarshal([]byte(tc.data), &r)
+
+			if tc.expectedError != "" {
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedBranchRule, r)
+			}
+		})
+	}
+}
+
+func TestRulesetService_List(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	tests := []struct {
+		name             string
+		mockResponses    []MockResponse
+		s                *RulesetService
+		ctx              context.Context
+		pageSize         int
+		pageNo           int
+		expectedRulesets []Ruleset
+		expectedResponse *Response
+		expectedError    string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &RulesetService{
+				client:   c,
+				basePath: "/repos/octocat/Hello-World",
+			},
+			ctx:           nil,
+			pageSize:      10,
+			pageNo:        1,
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/rulesets", 401, http.Header{}, `{
+					"message": "Bad credentials"
+				}`},
+			},
+			s: &RulesetService{
+				client:   c,
+				basePath: "/repos/octocat/Hello-World",
+			},
+			ctx:           context.Background(),
+			pageSize:      10,
+			pageNo:        1,
+			expectedError: `GET /repos/octocat/Hello-World/rulesets: 401 Bad credentials`,
+		},
+		{
+			name: "InvalidResponse",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/rulesets", 200, http.Header{}, `{`},
+			},
+			s: &RulesetService{
+				client:   c,
+				basePath: "/repos/octocat/Hello-World",
+			},
+			ctx:           context.Background(),
+			pageSize:      10,
+			pageNo:        1,
+			expectedError: `unexpected EOF`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/rulesets", 200, header, rulesetsBody},
+			},
+			s: &RulesetService{
+				client:   c,
+				basePath: "/repos/octocat/Hello-World",
+			},
+			ctx:              context.Background(),
+			pageSize:         10,
+			pageNo:           1,
+			expectedRulesets: []Ruleset{rulesetSummary},
+			expectedResponse: &Response{
+				Pages: expectedPages,
+				Rate:  expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			defer ts.Close()
+
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			rulesets, resp, err := tc.s.List(tc.ctx, tc.pageSize, tc.pageNo)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, rulesets)
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedRulesets, rulesets)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Pages, resp.Pages)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestRulesetService_Get(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	tests := []struct {
+		name             string
+		mockResponses    []MockResponse
+		s                *RulesetService
+		ctx              context.Context
+		id               int
+		expectedRuleset  *Ruleset
+		expectedResponse *Response
+		expectedError    string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &RulesetService{
+				client:   c,
+				basePath: "/repos/octocat/Hello-World",
+			},
+			ctx:           nil,
+			id:            42,
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/rulesets/42", 404, http.Header{}, `{
+					"message": "Not Found"
+				}`},
+			},
+			s: &RulesetService{
+				client:   c,
+				basePath: "/repos/octocat/Hello-World",
+			},
+			ctx:           context.Background(),
+			id:            42,
+			expectedError: `GET /repos/octocat/Hello-World/rulesets/42: 404 Not Found`,
+		},
+		{
+			name: "InvalidResponse",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/rulesets/42", 200, http.Header{}, `{`},
+			},
+			s: &RulesetService{
+				client:   c,
+				basePath: "/repos/octocat/Hello-World",
+			},
+			ctx:           context.Background(),
+			id:            42,
+			expectedError: `unexpected EOF`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/rulesets/42", 200, header, rulesetBody},
+			},
+			s: &RulesetService{
+				client:   c,
+				basePath: "/repos/octocat/Hello-World",
+			},
+			ctx:             context.Background(),
+			id:              42,
+			expectedRuleset: &ruleset,
+			expectedResponse: &Response{
+				Rate: expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			defer ts.Close()
+
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			ruleset, resp, err := tc.s.Get(tc.ctx, tc.id)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, ruleset)
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedRuleset, ruleset)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestRulesetService_Create(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	tests := []struct {
+		name             string
+		mockResponses    []MockResponse
+		s                *RulesetService
+		ctx              context.Context
+		params           RulesetParams
+		expectedRuleset  *Ruleset
+		expectedResponse *Response
+		expectedError    string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &RulesetService{
+				client:   c,
+				basePath: "/repos/octocat/Hello-World",
+			},
+			ctx:           nil,
+			params:        rulesetParams,
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"POST", "/repos/octocat/Hello-World/rulesets", 422, http.Header{}, `{
+					"message": "Validation Failed"
+				}`},
+			},
+			s: &RulesetService{
+				client:   c,
+				basePath: "/repos/octocat/Hello-World",
+			},
+			ctx:           context.Background(),
+			params:        rulesetParams,
+			expectedError: `POST /repos/octocat/Hello-World/rulesets: 422 Validation Failed`,
+		},
+		{
+			name: "InvalidResponse",
+			mockResponses: []MockResponse{
+				{"POST", "/repos/octocat/Hello-World/rulesets", 201, http.Header{}, `{`},
+			},
+			s: &RulesetService{
+				client:   c,
+				basePath: "/repos/octocat/Hello-World",
+			},
+			ctx:           context.Background(),
+			params:        rulesetParams,
+			expectedError: `unexpected EOF`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"POST", "/repos/octocat/Hello-World/rulesets", 201, header, rulesetBody},
+			},
+			s: &RulesetService{
+				client:   c,
+				basePath: "/repos/octocat/Hello-World",
+			},
+			ctx:             context.Background(),
+			params:          rulesetParams,
+			expectedRuleset: &ruleset,
+			expectedResponse: &Response{
+				Rate: expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			defer ts.Close()
+
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			ruleset, resp, err := tc.s.Create(tc.ctx, tc.params)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, ruleset)
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedRuleset, ruleset)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestRulesetService_Update(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	tests := []struct {
+		name             string
+		mockResponses    []MockResponse
+		s                *RulesetService
+		ctx              context.Context
+		id               int
+		params           RulesetParams
+		expectedRuleset  *Ruleset
+		expectedResponse *Response
+		expectedError    string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &RulesetService{
+				client:   c,
+				basePath: "/repos/octocat/Hello-World",
+			},
+			ctx:           nil,
+			id:            42,
+			params:        rulesetParams,
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"PUT", "/repos/octocat/Hello-World/rulesets/42", 422, http.Header{}, `{
+					"message": "Validation Failed"
+				}`},
+			},
+			s: &RulesetService{
+				client:   c,
+				basePath: "/repos/octocat/Hello-World",
+			},
+			ctx:           context.Background(),
+			id:            42,
+			params:        rulesetParams,
+			expectedError: `PUT /repos/octocat/Hello-World/rulesets/42: 422 Validation Failed`,
+		},
+		{
+			name: "InvalidResponse",
+			mockResponses: []MockResponse{
+				{"PUT", "/repos/octocat/Hello-World/rulesets/42", 200, http.Header{}, `{`},
+			},
+			s: &RulesetService{
+				client:   c,
+				basePath: "/repos/octocat/Hello-World",
+			},
+			ctx:           context.Background(),
+			id:            42,
+			params:        rulesetParams,
+			expectedError: `unexpected EOF`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"PUT", "/repos/octocat/Hello-World/rulesets/42", 200, header, rulesetBody},
+			},
+			s: &RulesetService{
+				client:   c,
+				basePath: "/repos/octocat/Hello-World",
+			},
+			ctx:             context.Background(),
+			id:              42,
+			params:          rulesetParams,
+			expectedRuleset: &ruleset,
+			expectedResponse: &Response{
+				Rate: expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			defer ts.Close()
+
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			ruleset, resp, err := tc.s.Update(tc.ctx, tc.id, tc.params)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, ruleset)
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedRuleset, ruleset)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestRulesetService_Delete(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	tests := []struct {
+		name             string
+		mockResponses    []MockResponse
+		s                *RulesetService
+		ctx              context.Context
+		id               int
+		expectedResponse *Response
+		expectedError    string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &RulesetService{
+				client:   c,
+				basePath: "/repos/octocat/Hello-World",
+			},
+			ctx:           nil,
+			id:            42,
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"DELETE", "/repos/octocat/Hello-World/rulesets/42", 404, http.Header{}, `{
+					"message": "Not Found"
+				}`},
+			},
+			s: &RulesetService{
+				client:   c,
+				basePath: "/repos/octocat/Hello-World",
+			},
+			ctx:           context.Background(),
+			id:            42,
+			expectedError: `DELETE /repos/octocat/Hello-World/rulesets/42: 404 Not Found`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"DELETE", "/repos/octocat/Hello-World/rulesets/42", 204, header, ``},
+			},
+			s: &RulesetService{
+				client:   c,
+				basePath: "/repos/octocat/Hello-World",
+			},
+			ctx: context.Background(),
+			id:  42,
+			expectedResponse: &Response{
+				Rate: expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			defer ts.Close()
+
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			resp, err := tc.s.Delete(tc.ctx, tc.id)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestRulesetService_BranchRules(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	tests := []struct {
+		name             string
+		mockResponses    []MockResponse
+		s                *RulesetService
+		ctx              context.Context
+		branch           string
+		pageSize         int
+		pageNo           int
+		expectedRules    []BranchRule
+		expectedResponse *Response
+		expectedError    string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &RulesetService{
+				client:   c,
+				basePath: "/repos/octocat/Hello-World",
+			},
+			ctx:           nil,
+			branch:        "main",
+			pageSize:      10,
+			pageNo:        1,
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/rules/branches/main", 401, http.Header{}, `{
+					"message": "Bad credentials"
+				}`},
+			},
+			s: &RulesetService{
+				client:   c,
+				basePath: "/repos/octocat/Hello-World",
+			},
+			ctx:           context.Background(),
+			branch:        "main",
+			pageSize:      10,
+			pageNo:        1,
+			expectedError: `GET /repos/octocat/Hello-World/rules/branches/main: 401 Bad credentials`,
+		},
+		{
+			name: "InvalidResponse",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/rules/branches/main", 200, http.Header{}, `{`},
+			},
+			s: &RulesetService{
+				client:   c,
+				basePath: "/repos/octocat/Hello-World",
+			},
+			ctx:           context.Background(),
+			branch:        "main",
+			pageSize:      10,
+			pageNo:        1,
+			expectedError: `unexpected EOF`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/rules/branches/main", 200, header, branchRulesBody},
+			},
+			s: &RulesetService{
+				client:   c,
+				basePath: "/repos/octocat/Hello-World",
+			},
+			ctx:           context.Background(),
+			branch:        "main",
+			pageSize:      10,
+			pageNo:        1,
+			expectedRules: []BranchRule{branchRule},
+			expectedResponse: &Response{
+				Pages: expectedPages,
+				Rate:  expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			defer ts.Close()
+
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			rules, resp, err := tc.s.BranchRules(tc.ctx, tc.branch, tc.pageSize, tc.pageNo)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, rules)
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedRules, rules)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Pages, resp.Pages)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestRulesetService_RuleSuites(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	filter := RuleSuitesFilter{
+		Ref:            "refs/heads/main",
+		RepositoryName: "Hello-World",
+		TimePeriod:     "week",
+		ActorName:      "octocat",
+		Result:         "bypass",
+	}
+
+	tests := []struct {
+		name             string
+		mockResponses    []MockResponse
+		s                *RulesetService
+		ctx              context.Context
+		pageSize         int
+		pageNo           int
+		filter           RuleSuitesFilter
+		expectedSuites   []RuleSuite
+		expectedResponse *Response
+		expectedError    string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &RulesetService{
+				client:   c,
+				basePath: "/repos/octocat/Hello-World",
+			},
+			ctx:           nil,
+			pageSize:      10,
+			pageNo:        1,
+			filter:        filter,
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/rulesets/rule-suites", 401, http.Header{}, `{
+					"message": "Bad credentials"
+				}`},
+			},
+			s: &RulesetService{
+				client:   c,
+				basePath: "/repos/octocat/Hello-World",
+			},
+			ctx:           context.Background(),
+			pageSize:      10,
+			pageNo:        1,
+			filter:        filter,
+			expectedError: `GET /repos/octocat/Hello-World/rulesets/rule-suites: 401 Bad credentials`,
+		},
+		{
+			name: "InvalidResponse",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/rulesets/rule-suites", 200, http.Header{}, `{`},
+			},
+			s: &RulesetService{
+				client:   c,
+				basePath: "/repos/octocat/Hello-World",
+			},
+			ctx:           context.Background(),
+			pageSize:      10,
+			pageNo:        1,
+			filter:        filter,
+			expectedError: `unexpected EOF`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/rulesets/rule-suites", 200, header, ruleSuitesBody},
+			},
+			s: &RulesetService{
+				client:   c,
+				basePath: "/repos/octocat/Hello-World",
+			},
+			ctx:            context.Background(),
+			pageSize:       10,
+			pageNo:         1,
+			filter:         filter,
+			expectedSuites: []RuleSuite{ruleSuiteSummary},
+			expectedResponse: &Response{
+				Pages: expectedPages,
+				Rate:  expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			defer ts.Close()
+
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			suites, resp, err := tc.s.RuleSuites(tc.ctx, tc.pageSize, tc.pageNo, tc.filter)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, suites)
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedSuites, suites)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Pages, resp.Pages)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestRulesetService_RuleSuite(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	tests := []struct {
+		name             string
+		mockResponses    []MockResponse
+		s                *RulesetService
+		ctx              context.Context
+		id               int
+		expectedSuite    *RuleSuite
+		expectedResponse *Response
+		expectedError    string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &RulesetService{
+				client:   c,
+				basePath: "/repos/octocat/Hello-World",
+			},
+			ctx:           nil,
+			id:            21,
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/rulesets/rule-suites/21", 404, http.Header{}, `{
+					"message": "Not Found"
+				}`},
+			},
+			s: &RulesetService{
+				client:   c,
+				basePath: "/repos/octocat/Hello-World",
+			},
+			ctx:           context.Background(),
+			id:            21,
+			expectedError: `GET /repos/octocat/Hello-World/rulesets/rule-suites/21: 404 Not Found`,
+		},
+		{
+			name: "InvalidResponse",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/rulesets/rule-suites/21", 200, http.Header{}, `{`},
+			},
+			s: &RulesetService{
+				client:   c,
+				basePath: "/repos/octocat/Hello-World",
+			},
+			ctx:           context.Background(),
+			id:            21,
+			expectedError: `unexpected EOF`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/rulesets/rule-suites/21", 200, header, ruleSuiteBody},
+			},
+			s: &RulesetService{
+				client:   c,
+				basePath: "/repos/octocat/Hello-World",
+			},
+			ctx:           context.Background(),
+			id:            21,
+			expectedSuite: &ruleSuite,
+			expectedResponse: &Response{
+				Rate: expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			defer ts.Close()
+
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			suite, resp, err := tc.s.RuleSuite(tc.ctx, tc.id)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, suite)
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedSuite, suite)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
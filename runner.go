@@ -0,0 +1,261 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// RunnersService provides GitHub APIs for self-hosted runners in a repository or an organization.
+// See https://docs.github.com/en/rest/actions/self-hosted-runners
+type RunnersService struct {
+	client   *Client
+	basePath string
+}
+
+type (
+	// RunnerLabel is a label of a self-hosted runner.
+	RunnerLabel struct {
+		ID   int    `json:"id,omitempty"`
+		Name string `json:"name"`
+		Type string `json:"type"` // Either read-only or custom
+	}
+
+	// Runner is a GitHub Actions self-hosted runner object.
+	Runner struct {
+		ID            int           `json:"id"`
+		Name          string        `json:"name"`
+		OS            string        `json:"os"`
+		Status        string        `json:"status"` // Either online or offline
+		Busy          bool          `json:"busy"`
+		Ephemeral     bool          `json:"ephemeral"`
+		RunnerGroupID int           `json:"runner_group_id,omitempty"`
+		Labels        []RunnerLabel `json:"labels"`
+	}
+
+	// RunnerToken is a token for registering or removing a self-hosted runner.
+	RunnerToken struct {
+		Token     string    `json:"token"`
+		ExpiresAt time.Time `json:"expires_at"`
+	}
+
+	// RunnerApplication is a downloadable self-hosted runner application.
+	RunnerApplication struct {
+		OS                string `json:"os"`
+		Architecture      string `json:"architecture"`
+		DownloadURL       string `json:"download_url"`
+		Filename          string `json:"filename"`
+		TempDownloadToken string `json:"temp_download_token,omitempty"`
+		SHA256Checksum    string `json:"sha256_checksum,omitempty"`
+	}
+)
+
+// RunnersFilter are used for fetching Runners.
+type RunnersFilter struct {
+	Name string
+}
+
+// List retrieves all self-hosted runners page by page.
+// See https://docs.github.com/en/rest/actions/self-hosted-runners#list-self-hosted-runners-for-a-repository
+// See https://docs.github.com/en/rest/actions/self-hosted-runners#list-self-hosted-runners-for-an-organization
+func (s *RunnersService) List(ctx context.Context, pageSize, pageNo int, filter RunnersFilter) ([]Runner, *Response, error) {
+	url := s.basePath + "/runners"
+	req, err := s.client.NewPageRequest(ctx, "GET", url, pageSize, pageNo, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	q := req.URL.Query()
+	if filter.Name != "" {
+		q.Add("name", filter.Name)
+	}
+	req.URL.RawQuery = q.Encode()
+
+	body := new(struct {
+		TotalCount int      `json:"total_count"`
+		Runners    []Runner `json:"runners"`
+	})
+
+	resp, err := s.client.Do(req, body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return body.Runners, resp, nil
+}
+
+// Get retrieves a self-hosted runner by its id.
+// See https://docs.github.com/en/rest/actions/self-hosted-runners#get-a-self-hosted-runner-for-a-repository
+// See https://docs.github.com/en/rest/actions/self-hosted-runners#get-a-self-hosted-runner-for-an-organization
+func (s *RunnersService) Get(ctx context.Context, runnerID int) (*Runner, *Response, error) {
+	url := fmt.Sprintf("%s/runners/%d", s.basePath, runnerID)
+	req, err := s.client.NewRequest(ctx, "GET", url, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	runner := new(Runner)
+
+	resp, err := s.client.Do(req, runner)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return runner, resp, nil
+}
+
+// Delete removes a self-hosted runner by its id.
+// See https://docs.github.com/en/rest/actions/self-hosted-runners#delete-a-self-hosted-runner-from-a-repository
+// See https://docs.github.com/en/rest/actions/self-hosted-runners#delete-a-self-hosted-runner-from-an-organization
+func (s *RunnersService) Delete(ctx context.Context, runnerID int) (*Response, error) {
+	url := fmt.Sprintf("%s/runners/%d", s.basePath, runnerID)
+	req, err := s.client.NewRequest(ctx, "DELETE", url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// Labels retrieves all labels of a self-hosted runner.
+// See https://docs.github.com/en/rest/actions/self-hosted-runners#list-labels-for-a-self-hosted-runner-for-a-repository
+// See https://docs.github.com/en/rest/actions/self-hosted-runners#list-labels-for-a-self-hosted-runner-for-an-organization
+func (s *RunnersService) Labels(ctx context.Context, runnerID int) ([]RunnerLabel, *Response, error) {
+	url := fmt.Sprintf("%s/runners/%d/labels", s.basePath, runnerID)
+	return s.runnerLabels(ctx, "GET", url, nil)
+}
+
+// AddLabels adds custom labels to a self-hosted runner and returns all labels of the runner.
+// See https://docs.github.com/en/rest/actions/self-hosted-runners#add-custom-labels-to-a-self-hosted-runner-for-a-repository
+// See https://docs.github.com/en/rest/actions/self-hosted-runners#add-custom-labels-to-a-self-hosted-runner-for-an-organization
+func (s *RunnersService) AddLabels(ctx context.Context, runnerID int, labels []string) ([]RunnerLabel, *Response, error) {
+	url := fmt.Sprintf("%s/runners/%d/labels", s.basePath, runnerID)
+	body := struct {
+		Labels []string `json:"labels"`
+	}{
+		Labels: labels,
+	}
+
+	return s.runnerLabels(ctx, "POST", url, body)
+}
+
+// SetLabels replaces all custom labels of a self-hosted runner and returns all labels of the runner.
+// See https://docs.github.com/en/rest/actions/self-hosted-runners#set-custom-labels-for-a-self-hosted-runner-for-a-repository
+// See https://docs.github.com/en/rest/actions/self-hosted-runners#set-custom-labels-for-a-self-hosted-runner-for-an-organization
+func (s *RunnersService) SetLabels(ctx context.Context, runnerID int, labels []string) ([]RunnerLabel, *Response, error) {
+	url := fmt.Sprintf("%s/runners/%d/labels", s.basePath, runnerID)
+	body := struct {
+		Labels []string `json:"labels"`
+	}{
+		Labels: labels,
+	}
+
+	if body.Labels == nil {
+		body.Labels = []string{}
+	}
+
+	return s.runnerLabels(ctx, "PUT", url, body)
+}
+
+// RemoveLabel removes a custom label from a self-hosted runner and returns the remaining labels of the runner.
+// See https://docs.github.com/en/rest/actions/self-hosted-runners#remove-a-custom-label-from-a-self-hosted-runner-for-a-repository
+// See https://docs.github.com/en/rest/actions/self-hosted-runners#remove-a-custom-label-from-a-self-hosted-runner-for-an-organization
+func (s *RunnersService) RemoveLabel(ctx context.Context, runnerID int, label string) ([]RunnerLabel, *Response, error) {
+	url := fmt.Sprintf("%s/runners/%d/labels/%s", s.basePath, runnerID, escapePath(label))
+	return s.runnerLabels(ctx, "DELETE", url, nil)
+}
+
+// RemoveCustomLabels removes all custom labels from a self-hosted runner and returns the remaining read-only labels of the runner.
+// See https://docs.github.com/en/rest/actions/self-hosted-runners#remove-all-custom-labels-from-a-self-hosted-runner-for-a-repository
+// See https://docs.github.com/en/rest/actions/self-hosted-runners#remove-all-custom-labels-from-a-self-hosted-runner-for-an-organization
+func (s *RunnersService) RemoveCustomLabels(ctx context.Context, runnerID int) ([]RunnerLabel, *Response, error) {
+	url := fmt.Sprintf("%s/runners/%d/labels", s.basePath, runnerID)
+	return s.runnerLabels(ctx, "DELETE", url, nil)
+}
+
+func (s *RunnersService) runnerLabels(ctx context.Context, method, url string, body interface{}) ([]RunnerLabel, *Response, error) {
+	req, err := s.client.NewRequest(ctx, method, url, body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	labels := new(struct {
+		TotalCount int           `json:"total_count"`
+		Labels     []RunnerLabel `json:"labels"`
+	})
+
+	resp, err := s.client.Do(req, labels)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return labels.Labels, resp, nil
+}
+
+// RegistrationToken creates a token for registering a self-hosted runner with the config script.
+// The token expires after one hour.
+// Registration tokens have a separate rate limit, which is tracked independently of the core rate limit.
+// See https://docs.github.com/en/rest/actions/self-hosted-runners#create-a-registration-token-for-a-repository
+// See https://docs.github.com/en/rest/actions/self-hosted-runners#create-a-registration-token-for-an-organization
+func (s *RunnersService) RegistrationToken(ctx context.Context) (*RunnerToken, *Response, error) {
+	url := s.basePath + "/runners/registration-token"
+	req, err := s.client.NewRequest(ctx, "POST", url, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	token := new(RunnerToken)
+
+	resp, err := s.client.Do(req, token)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return token, resp, nil
+}
+
+// RemoveToken creates a token for removing a self-hosted runner with the config script.
+// The token expires after one hour.
+// See https://docs.github.com/en/rest/actions/self-hosted-runners#create-a-remove-token-for-a-repository
+// See https://docs.github.com/en/rest/actions/self-hosted-runners#create-a-remove-token-for-an-organization
+func (s *RunnersService) RemoveToken(ctx context.Context) (*RunnerToken, *Response, error) {
+	url := s.basePath + "/runners/remove-token"
+	req, err := s.client.NewRequest(ctx, "POST", url, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	token := new(RunnerToken)
+
+	resp, err := s.client.Do(req, token)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return token, resp, nil
+}
+
+// Downloads retrieves the self-hosted runner applications for all operating systems and architectures.
+// See https://docs.github.com/en/rest/actions/self-hosted-runners#list-runner-applications-for-a-repository
+// See https://docs.github.com/en/rest/actions/self-hosted-runners#list-runner-applications-for-an-organization
+func (s *RunnersService) Downloads(ctx context.Context) ([]RunnerApplication, *Response, error) {
+	url := s.basePath + "/runners/downloads"
+	req, err := s.client.NewRequest(ctx, "GET", url, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	apps := []RunnerApplication{}
+
+	resp, err := s.client.Do(req, &apps)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return apps, resp, nil
+}
@@ -0,0 +1,1217 @@
+package github
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	runnerBody = `{
+		"id": 23,
+		"name": "MBP",
+		"os": "macos",
+		"status": "online",
+		"busy": true,
+		"ephemeral": false,
+		"labels": [
+			{
+				"id": 5,
+				"name": "self-hosted",
+				"type": "read-only"
+			},
+			{
+				"id": 7,
+				"name": "gpu",
+				"type": "custom"
+			}
+		]
+	}`
+
+	runnersBody = `{
+		"total_count": 1,
+		"runners": [` + runnerBody + `]
+	}`
+
+	runnerLabelsBody = `{
+		"total_count": 2,
+		"labels": [
+			{
+				"id": 5,
+				"name": "self-hosted",
+				"type": "read-only"
+			},
+			{
+				"id": 7,
+				"name": "gpu",
+				"type": "custom"
+			}
+		]
+	}`
+
+	runnerTokenBody = `{
+		"token": "LLBF3JGZDX3P5PMEXLND6TS6FCWO6",
+		"expires_at": "2020-01-22T12:13:35Z"
+	}`
+
+	runnerDownloadsBody = `[
+		{
+			"os": "linux",
+			"architecture": "x64",
+			"download_url": "https://github.com/actions/runner/releases/download/v2.277.1/actions-runner-linux-x64-2.277.1.tar.gz",
+			"filename": "actions-runner-linux-x64-2.277.1.tar.gz",
+			"sha256_checksum": "02d710fc9e0008e641274bb7da7fde61f7c9aa1cbb541a2990d3450cc88f4e98"
+		}
+	]`
+)
+
+var (
+	runnerLabels = []RunnerLabel{
+		{ID: 5, Name: "self-hosted", Type: "read-only"},
+		{ID: 7, Name: "gpu", Type: "custom"},
+	}
+
+	runner = Runner{
+		ID:     23,
+		Name:   "MBP",
+		OS:     "macos",
+		Status: "online",
+		Busy:   true,
+		Labels: runnerLabels,
+	}
+
+	runnerToken = RunnerToken{
+		Token:     "LLBF3JGZDX3P5PMEXLND6TS6FCWO6",
+		ExpiresAt: parseGitHubTime("2020-01-22T12:13:35Z"),
+	}
+
+	runnerApplication = RunnerApplication{
+		OS:             "linux",
+		Architecture:   "x64",
+		DownloadURL:    "https://github.com/actions/runner/releases/download/v2.277.1/actions-runner-linux-x64-2.277.1.tar.gz",
+		Filename:       "actions-runner-linux-x64-2.277.1.tar.gz",
+		SHA256Checksum: "02d710fc9e0008e641274bb7da7fde61f7c9aa1cbb541a2990d3450cc88f4e98",
+	}
+)
+
+func TestRunnersService_List(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	tests := []struct {
+		name             string
+		mockResponses    []MockResponse
+		s                *RunnersService
+		ctx              context.Context
+		pageSize         int
+		pageNo           int
+		filter           RunnersFilter
+		expectedRunners  []Runner
+		expectedResponse *Response
+		expectedError    string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &RunnersService{
+				client:   c,
+				basePath: "/repos/octocat/Hello-World/actions",
+			},
+			ctx:           nil,
+			pageSize:      10,
+			pageNo:        1,
+			filter:        RunnersFilter{Name: "MBP"},
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/actions/runners", 401, http.Header{}, `{
+					"message": "Bad credentials"
+				}`},
+			},
+			s: &RunnersService{
+				client:   c,
+				basePath: "/repos/octocat/Hello-World/actions",
+			},
+			ctx:           context.Background(),
+			pageSize:      10,
+			pageNo:        1,
+			filter:        RunnersFilter{Name: "MBP"},
+			expectedError: `GET /repos/octocat/Hello-World/actions/runners: 401 Bad credentials`,
+		},
+		{
+			name: "InvalidResponse",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/actions/runners", 200, http.Header{}, `{`},
+			},
+			s: &RunnersService{
+				client:   c,
+				basePath: "/repos/octocat/Hello-World/actions",
+			},
+			ctx:           context.Background(),
+			pageSize:      10,
+			pageNo:        1,
+			filter:        RunnersFilter{Name: "MBP"},
+			expectedError: `unexpected EOF`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/actions/runners", 200, header, runnersBody},
+			},
+			s: &RunnersService{
+				client:   c,
+				basePath: "/repos/octocat/Hello-World/actions",
+			},
+			ctx:             context.Background(),
+			pageSize:        10,
+			pageNo:          1,
+			filter:          RunnersFilter{Name: "MBP"},
+			expectedRunners: []Runner{runner},
+			expectedResponse: &Response{
+				Pages: expectedPages,
+				Rate:  expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			defer ts.Close()
+
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			runners, resp, err := tc.s.List(tc.ctx, tc.pageSize, tc.pageNo, tc.filter)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, runners)
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedRunners, runners)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Pages, resp.Pages)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestRunnersService_Get(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	tests := []struct {
+		name             string
+		mockResponses    []MockResponse
+		s                *RunnersService
+		ctx              context.Context
+		runnerID         int
+		expectedRunner   *Runner
+		expectedResponse *Response
+		expectedError    string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &RunnersService{
+				client:   c,
+				basePath: "/repos/octocat/Hello-World/actions",
+			},
+			ctx:           nil,
+			runnerID:      23,
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/actions/runners/23", 401, http.Header{}, `{
+					"message": "Bad credentials"
+				}`},
+			},
+			s: &RunnersService{
+				client:   c,
+				basePath: "/repos/octocat/Hello-World/actions",
+			},
+			ctx:           context.Background(),
+			runnerID:      23,
+			expectedError: `GET /repos/octocat/Hello-World/actions/runners/23: 401 Bad credentials`,
+		},
+		{
+			name: "InvalidResponse",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/actions/runners/23", 200, http.Header{}, `{`},
+			},
+			s: &RunnersService{
+				client:   c,
+				basePath: "/repos/octocat/Hello-World/actions",
+			},
+			ctx:           context.Background(),
+			runnerID:      23,
+			expectedError: `unexpected EOF`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/actions/runners/23", 200, header, runnerBody},
+			},
+			s: &RunnersService{
+				client:   c,
+				basePath: "/repos/octocat/Hello-World/actions",
+			},
+			ctx:            context.Background(),
+			runnerID:       23,
+			expectedRunner: &runner,
+			expectedResponse: &Response{
+				Rate: expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			defer ts.Close()
+
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			r, resp, err := tc.s.Get(tc.ctx, tc.runnerID)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, r)
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedRunner, r)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestRunnersService_Delete(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	tests := []struct {
+		name             string
+		mockResponses    []MockResponse
+		s                *RunnersService
+		ctx              context.Context
+		runnerID         int
+		expectedResponse *Response
+		expectedError    string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &RunnersService{
+				client:   c,
+				basePath: "/repos/octocat/Hello-World/actions",
+			},
+			ctx:           nil,
+			runnerID:      23,
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"DELETE", "/repos/octocat/Hello-World/actions/runners/23", 401, http.Header{}, `{
+					"message": "Bad credentials"
+				}`},
+			},
+			s: &RunnersService{
+				client:   c,
+				basePath: "/repos/octocat/Hello-World/actions",
+			},
+			ctx:           context.Background(),
+			runnerID:      23,
+			expectedError: `DELETE /repos/octocat/Hello-World/actions/runners/23: 401 Bad credentials`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"DELETE", "/repos/octocat/Hello-World/actions/runners/23", 204, header, ``},
+			},
+			s: &RunnersService{
+				client:   c,
+				basePath: "/repos/octocat/Hello-World/actions",
+			},
+			ctx:      context.Background(),
+			runnerID: 23,
+			expectedResponse: &Response{
+				Rate: expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			defer ts.Close()
+
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			resp, err := tc.s.Delete(tc.ctx, tc.runnerID)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestRunnersService_Labels(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	tests := []struct {
+		name             string
+		mockResponses    []MockResponse
+		s                *RunnersService
+		ctx              context.Context
+		runnerID         int
+		expectedLabels   []RunnerLabel
+		expectedResponse *Response
+		expectedError    string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &RunnersService{
+				client:   c,
+				basePath: "/repos/octocat/Hello-World/actions",
+			},
+			ctx:           nil,
+			runnerID:      23,
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/actions/runners/23/labels", 401, http.Header{}, `{
+					"message": "Bad credentials"
+				}`},
+			},
+			s: &RunnersService{
+				client:   c,
+				basePath: "/repos/octocat/Hello-World/actions",
+			},
+			ctx:           context.Background(),
+			runnerID:      23,
+			expectedError: `GET /repos/octocat/Hello-World/actions/runners/23/labels: 401 Bad credentials`,
+		},
+		{
+			name: "InvalidResponse",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/actions/runners/23/labels", 200, http.Header{}, `{`},
+			},
+			s: &RunnersService{
+				client:   c,
+				basePath: "/repos/octocat/Hello-World/actions",
+			},
+			ctx:           context.Background(),
+			runnerID:      23,
+			expectedError: `unexpected EOF`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/actions/runners/23/labels", 200, header, runnerLabelsBody},
+			},
+			s: &RunnersService{
+				client:   c,
+				basePath: "/repos/octocat/Hello-World/actions",
+			},
+			ctx:            context.Background(),
+			runnerID:       23,
+			expectedLabels: runnerLabels,
+			expectedResponse: &Response{
+				Rate: expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			defer ts.Close()
+
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			labels, resp, err := tc.s.Labels(tc.ctx, tc.runnerID)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, labels)
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedLabels, labels)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestRunnersService_AddLabels(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	tests := []struct {
+		name                 string
+		mockResponses        []MockResponse
+		s                    *RunnersService
+		ctx                  context.Context
+		runnerID             int
+		labels               []string
+		expectedRunnerLabels []RunnerLabel
+		expectedResponse     *Response
+		expectedError        string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &RunnersService{
+				client:   c,
+				basePath: "/repos/octocat/Hello-World/actions",
+			},
+			ctx:           nil,
+			runnerID:      23,
+			labels:        []string{"gpu"},
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"POST", "/repos/octocat/Hello-World/actions/runners/23/labels", 401, http.Header{}, `{
+					"message": "Bad credentials"
+				}`},
+			},
+			s: &RunnersService{
+				client:   c,
+				basePath: "/repos/octocat/Hello-World/actions",
+			},
+			ctx:           context.Background(),
+			runnerID:      23,
+			labels:        []string{"gpu"},
+			expectedError: `POST /repos/octocat/Hello-World/actions/runners/23/labels: 401 Bad credentials`,
+		},
+		{
+			name: "InvalidResponse",
+			mockResponses: []MockResponse{
+				{"POST", "/repos/octocat/Hello-World/actions/runners/23/labels", 200, http.Header{}, `{`},
+			},
+			s: &RunnersService{
+				client:   c,
+				basePath: "/repos/octocat/Hello-World/actions",
+			},
+			ctx:           context.Background(),
+			runnerID:      23,
+			labels:        []string{"gpu"},
+			expectedError: `unexpected EOF`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"POST", "/repos/octocat/Hello-World/actions/runners/23/labels", 200, header, runnerLabelsBody},
+			},
+			s: &RunnersService{
+				client:   c,
+				basePath: "/repos/octocat/Hello-World/actions",
+			},
+			ctx:                  context.Background(),
+			runnerID:             23,
+			labels:               []string{"gpu"},
+			expectedRunnerLabels: runnerLabels,
+			expectedResponse: &Response{
+				Rate: expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			defer ts.Close()
+
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			ls, resp, err := tc.s.AddLabels(tc.ctx, tc.runnerID, tc.labels)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, ls)
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedRunnerLabels, ls)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestRunnersService_SetLabels(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	tests := []struct {
+		name                 string
+		mockResponses        []MockResponse
+		s                    *RunnersService
+		ctx                  context.Context
+		runnerID             int
+		labels               []string
+		expectedRunnerLabels []RunnerLabel
+		expectedResponse     *Response
+		expectedError        string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &RunnersService{
+				client:   c,
+				basePath: "/repos/octocat/Hello-World/actions",
+			},
+			ctx:           nil,
+			runnerID:      23,
+			labels:        []string{"gpu"},
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"PUT", "/repos/octocat/Hello-World/actions/runners/23/labels", 401, http.Header{}, `{
+					"message": "Bad credentials"
+				}`},
+			},
+			s: &RunnersService{
+				client:   c,
+				basePath: "/repos/octocat/Hello-World/actions",
+			},
+			ctx:           context.Background(),
+			runnerID:      23,
+			labels:        []string{"gpu"},
+			expectedError: `PUT /repos/octocat/Hello-World/actions/runners/23/labels: 401 Bad credentials`,
+		},
+		{
+			name: "InvalidResponse",
+			mockResponses: []MockResponse{
+				{"PUT", "/repos/octocat/Hello-World/actions/runners/23/labels", 200, http.Header{}, `{`},
+			},
+			s: &RunnersService{
+				client:   c,
+				basePath: "/repos/octocat/Hello-World/actions",
+			},
+			ctx:           context.Background(),
+			runnerID:      23,
+			labels:        []string{"gpu"},
+			expectedError: `unexpected EOF`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"PUT", "/repos/octocat/Hello-World/actions/runners/23/labels", 200, header, runnerLabelsBody},
+			},
+			s: &RunnersService{
+				client:   c,
+				basePath: "/repos/octocat/Hello-World/actions",
+			},
+			ctx:                  context.Background(),
+			runnerID:             23,
+			labels:               []string{"gpu"},
+			expectedRunnerLabels: runnerLabels,
+			expectedResponse: &Response{
+				Rate: expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			defer ts.Close()
+
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			ls, resp, err := tc.s.SetLabels(tc.ctx, tc.runnerID, tc.labels)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, ls)
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedRunnerLabels, ls)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestRunnersService_RemoveLabel(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	tests := []struct {
+		name             string
+		mockResponses    []MockResponse
+		s                *RunnersService
+		ctx              context.Context
+		runnerID         int
+		label            string
+		expectedLabels   []RunnerLabel
+		expectedResponse *Response
+		expectedError    string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &RunnersService{
+				client:   c,
+				basePath: "/repos/octocat/Hello-World/actions",
+			},
+			ctx:           nil,
+			runnerID:      23,
+			label:         "gpu",
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"DELETE", "/repos/octocat/Hello-World/actions/runners/23/labels/gpu", 401, http.Header{}, `{
+					"message": "Bad credentials"
+				}`},
+			},
+			s: &RunnersService{
+				client:   c,
+				basePath: "/repos/octocat/Hello-World/actions",
+			},
+			ctx:           context.Background(),
+			runnerID:      23,
+			label:         "gpu",
+			expectedError: `DELETE /repos/octocat/Hello-World/actions/runners/23/labels/gpu: 401 Bad credentials`,
+		},
+		{
+			name: "InvalidResponse",
+			mockResponses: []MockResponse{
+				{"DELETE", "/repos/octocat/Hello-World/actions/runners/23/labels/gpu", 200, http.Header{}, `{`},
+			},
+			s: &RunnersService{
+				client:   c,
+				basePath: "/repos/octocat/Hello-World/actions",
+			},
+			ctx:           context.Background(),
+			runnerID:      23,
+			label:         "gpu",
+			expectedError: `unexpected EOF`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"DELETE", "/repos/octocat/Hello-World/actions/runners/23/labels/gpu", 200, header, runnerLabelsBody},
+			},
+			s: &RunnersService{
+				client:   c,
+				basePath: "/repos/octocat/Hello-World/actions",
+			},
+			ctx:            context.Background(),
+			runnerID:       23,
+			label:          "gpu",
+			expectedLabels: runnerLabels,
+			expectedResponse: &Response{
+				Rate: expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			defer ts.Close()
+
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			labels, resp, err := tc.s.RemoveLabel(tc.ctx, tc.runnerID, tc.label)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, labels)
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedLabels, labels)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestRunnersService_RemoveCustomLabels(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	tests := []struct {
+		name             string
+		mockResponses    []MockResponse
+		s                *RunnersService
+		ctx              context.Context
+		runnerID         int
+		expectedLabels   []RunnerLabel
+		expectedResponse *Response
+		expectedError    string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &RunnersService{
+				client:   c,
+				basePath: "/repos/octocat/Hello-World/actions",
+			},
+			ctx:           nil,
+			runnerID:      23,
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"DELETE", "/repos/octocat/Hello-World/actions/runners/23/labels", 401, http.Header{}, `{
+					"message": "Bad credentials"
+				}`},
+			},
+			s: &RunnersService{
+				client:   c,
+				basePath: "/repos/octocat/Hello-World/actions",
+			},
+			ctx:           context.Background(),
+			runnerID:      23,
+			expectedError: `DELETE /repos/octocat/Hello-World/actions/runners/23/labels: 401 Bad credentials`,
+		},
+		{
+			name: "InvalidResponse",
+			mockResponses: []MockResponse{
+				{"DELETE", "/repos/octocat/Hello-World/actions/runners/23/labels", 200, http.Header{}, `{`},
+			},
+			s: &RunnersService{
+				client:   c,
+				basePath: "/repos/octocat/Hello-World/actions",
+			},
+			ctx:           context.Background(),
+			runnerID:      23,
+			expectedError: `unexpected EOF`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"DELETE", "/repos/octocat/Hello-World/actions/runners/23/labels", 200, header, runnerLabelsBody},
+			},
+			s: &RunnersService{
+				client:   c,
+				basePath: "/repos/octocat/Hello-World/actions",
+			},
+			ctx:            context.Background(),
+			runnerID:       23,
+			expectedLabels: runnerLabels,
+			expectedResponse: &Response{
+				Rate: expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			defer ts.Close()
+
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			labels, resp, err := tc.s.RemoveCustomLabels(tc.ctx, tc.runnerID)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, labels)
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedLabels, labels)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestRunnersService_RegistrationToken(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	tests := []struct {
+		name             string
+		mockResponses    []MockResponse
+		s                *RunnersService
+		ctx              context.Context
+		expectedToken    *RunnerToken
+		expectedResponse *Response
+		expectedError    string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &RunnersService{
+				client:   c,
+				basePath: "/repos/octocat/Hello-World/actions",
+			},
+			ctx:           nil,
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"POST", "/repos/octocat/Hello-World/actions/runners/registration-token", 401, http.Header{}, `{
+					"message": "Bad credentials"
+				}`},
+			},
+			s: &RunnersService{
+				client:   c,
+				basePath: "/repos/octocat/Hello-World/actions",
+			},
+			ctx:           context.Background(),
+			expectedError: `POST /repos/octocat/Hello-World/actions/runners/registration-token: 401 Bad credentials`,
+		},
+		{
+			name: "InvalidResponse",
+			mockResponses: []MockResponse{
+				{"POST", "/repos/octocat/Hello-World/actions/runners/registration-token", 201, http.Header{}, `{`},
+			},
+			s: &RunnersService{
+				client:   c,
+				basePath: "/repos/octocat/Hello-World/actions",
+			},
+			ctx:           context.Background(),
+			expectedError: `unexpected EOF`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"POST", "/repos/octocat/Hello-World/actions/runners/registration-token", 201, header, runnerTokenBody},
+			},
+			s: &RunnersService{
+				client:   c,
+				basePath: "/repos/octocat/Hello-World/actions",
+			},
+			ctx:           context.Background(),
+			expectedToken: &runnerToken,
+			expectedResponse: &Response{
+				Rate: expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			defer ts.Close()
+
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			token, resp, err := tc.s.RegistrationToken(tc.ctx)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, token)
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedToken, token)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestRunnersService_RemoveToken(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	tests := []struct {
+		name             string
+		mockResponses    []MockResponse
+		s                *RunnersService
+		ctx              context.Context
+		expectedToken    *RunnerToken
+		expectedResponse *Response
+		expectedError    string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &RunnersService{
+				client:   c,
+				basePath: "/repos/octocat/Hello-World/actions",
+			},
+			ctx:           nil,
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"POST", "/repos/octocat/Hello-World/actions/runners/remove-token", 401, http.Header{}, `{
+					"message": "Bad credentials"
+				}`},
+			},
+			s: &RunnersService{
+				client:   c,
+				basePath: "/repos/octocat/Hello-World/actions",
+			},
+			ctx:           context.Background(),
+			expectedError: `POST /repos/octocat/Hello-World/actions/runners/remove-token: 401 Bad credentials`,
+		},
+		{
+			name: "InvalidResponse",
+			mockResponses: []MockResponse{
+				{"POST", "/repos/octocat/Hello-World/actions/runners/remove-token", 201, http.Header{}, `{`},
+			},
+			s: &RunnersService{
+				client:   c,
+				basePath: "/repos/octocat/Hello-World/actions",
+			},
+			ctx:           context.Background(),
+			expectedError: `unexpected EOF`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"POST", "/repos/octocat/Hello-World/actions/runners/remove-token", 201, header, runnerTokenBody},
+			},
+			s: &RunnersService{
+				client:   c,
+				basePath: "/repos/octocat/Hello-World/actions",
+			},
+			ctx:           context.Background(),
+			expectedToken: &runnerToken,
+			expectedResponse: &Response{
+				Rate: expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			defer ts.Close()
+
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			token, resp, err := tc.s.RemoveToken(tc.ctx)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, token)
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedToken, token)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestRunnersService_Downloads(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	tests := []struct {
+		name             string
+		mockResponses    []MockResponse
+		s                *RunnersService
+		ctx              context.Context
+		expectedApps     []RunnerApplication
+		expectedResponse *Response
+		expectedError    string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &RunnersService{
+				client:   c,
+				basePath: "/repos/octocat/Hello-World/actions",
+			},
+			ctx:           nil,
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/actions/runners/downloads", 401, http.Header{}, `{
+					"message": "Bad credentials"
+				}`},
+			},
+			s: &RunnersService{
+				client:   c,
+				basePath: "/repos/octocat/Hello-World/actions",
+			},
+			ctx:           context.Background(),
+			expectedError: `GET /repos/octocat/Hello-World/actions/runners/downloads: 401 Bad credentials`,
+		},
+		{
+			name: "InvalidResponse",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/actions/runners/downloads", 200, http.Header{}, `{`},
+			},
+			s: &RunnersService{
+				client:   c,
+				basePath: "/repos/octocat/Hello-World/actions",
+			},
+			ctx:           context.Background(),
+			expectedError: `unexpected EOF`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/actions/runners/downloads", 200, header, runnerDownloadsBody},
+			},
+			s: &RunnersService{
+				client:   c,
+				basePath: "/repos/octocat/Hello-World/actions",
+			},
+			ctx:          context.Background(),
+			expectedApps: []RunnerApplication{runnerApplication},
+			expectedResponse: &Response{
+				Rate: expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			defer ts.Close()
+
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			apps, resp, err := tc.s.Downloads(tc.ctx)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, apps)
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedApps, apps)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestRunnersService_RegistrationTokenRateLimit(t *testing.T) {
+	reset := Epoch(time.Now().Add(time.Hour).Unix())
+
+	c := &Client{
+		httpClient: &http.Client{},
+		rates: map[rateGroup]Rate{
+			rateGroupActionsRunnerRegistration: {
+				Resource:  "actions_runner_registration",
+				Limit:     10000,
+				Used:      10000,
+				Remaining: 0,
+				Reset:     reset,
+			},
+		},
+		apiURL: publicAPIURL,
+	}
+
+	s := &RunnersService{
+		client:   c,
+		basePath: "/orgs/octo-org/actions",
+	}
+
+	ts := newHTTPTestServer(
+		MockResponse{"POST", "/orgs/octo-org/actions/runners/registration-token", 201, header, runnerTokenBody},
+		MockResponse{"POST", "/orgs/octo-org/actions/runners/remove-token", 201, header, runnerTokenBody},
+	)
+	defer ts.Close()
+
+	c.apiURL, _ = url.Parse(ts.URL)
+
+	// Registration tokens are rate limited separately, so the request is not even sent.
+	token, resp, err := s.RegistrationToken(context.Background())
+	assert.Nil(t, token)
+	assert.Nil(t, resp)
+	assert.IsType(t, &RateLimitError{}, err)
+
+	// Other requests are still tracked in the core rate limit.
+	token, resp, err = s.RemoveToken(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, &runnerToken, token)
+	assert.NotNil(t, resp)
+	assert.Equal(t, expectedRate, c.rates[rateGroupCore])
+	assert.Equal(t, reset, c.rates[rateGroupActionsRunnerRegistration].Reset)
+}
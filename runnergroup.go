@@ -0,0 +1,303 @@
+package github
+
+import (
+	"context"
+	"fmt"
+)
+
+// RunnerGroupsService provides GitHub APIs for self-hosted runner groups in an organization.
+// Runner groups are not available for repositories.
+// See https://docs.github.com/en/rest/actions/self-hosted-runner-groups
+type RunnerGroupsService struct {
+	client   *Client
+	basePath string
+}
+
+type (
+	// RunnerGroup is a GitHub Actions self-hosted runner group object.
+	RunnerGroup struct {
+		ID                       int              `json:"id"`
+		Name                     string           `json:"name"`
+		Visibility               SecretVisibility `json:"visibility"`
+		Default                  bool             `json:"default"`
+		Inherited                bool             `json:"inherited"`
+		AllowsPublicRepositories bool             `json:"allows_public_repositories"`
+		RestrictedToWorkflows    bool             `json:"restricted_to_workflows"`
+		SelectedWorkflows        []string         `json:"selected_workflows"`
+		RunnersURL               string           `json:"runners_url"`
+		SelectedRepositoriesURL  string           `json:"selected_repositories_url,omitempty"`
+	}
+
+	// RunnerGroupParams is used for creating or updating a self-hosted runner group.
+	RunnerGroupParams struct {
+		Name                     string           `json:"name,omitempty"`
+		Visibility               SecretVisibility `json:"visibility,omitempty"`
+		SelectedRepositoryIDs    []int            `json:"selected_repository_ids,omitempty"` // Only when creating a group
+		Runners                  []int            `json:"runners,omitempty"`                 // Only when creating a group
+		AllowsPublicRepositories bool             `json:"allows_public_repositories"`
+		RestrictedToWorkflows    bool             `json:"restricted_to_workflows"`
+		SelectedWorkflows        []string         `json:"selected_workflows,omitempty"`
+	}
+)
+
+// List retrieves all self-hosted runner groups of an organization page by page.
+// See https://docs.github.com/en/rest/actions/self-hosted-runner-groups#list-self-hosted-runner-groups-for-an-organization
+func (s *RunnerGroupsService) List(ctx context.Context, pageSize, pageNo int) ([]RunnerGroup, *Response, error) {
+	url := s.basePath + "/runner-groups"
+	req, err := s.client.NewPageRequest(ctx, "GET", url, pageSize, pageNo, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	body := new(struct {
+		TotalCount   int           `json:"total_count"`
+		RunnerGroups []RunnerGroup `json:"runner_groups"`
+	})
+
+	resp, err := s.client.Do(req, body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return body.RunnerGroups, resp, nil
+}
+
+// Get retrieves a self-hosted runner group of an organization by its id.
+// See https://docs.github.com/en/rest/actions/self-hosted-runner-groups#get-a-self-hosted-runner-group-for-an-organization
+func (s *RunnerGroupsService) Get(ctx context.Context, groupID int) (*RunnerGroup, *Response, error) {
+	url := fmt.Sprintf("%s/runner-groups/%d", s.basePath, groupID)
+	req, err := s.client.NewRequest(ctx, "GET", url, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	group := new(RunnerGroup)
+
+	resp, err := s.client.Do(req, group)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return group, resp, nil
+}
+
+// Create creates a new self-hosted runner group in an organization.
+// See https://docs.github.com/en/rest/actions/self-hosted-runner-groups#create-a-self-hosted-runner-group-for-an-organization
+func (s *RunnerGroupsService) Create(ctx context.Context, params RunnerGroupParams) (*RunnerGroup, *Response, error) {
+	url := s.basePath + "/runner-groups"
+	req, err := s.client.NewRequest(ctx, "POST", url, params)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	group := new(RunnerGroup)
+
+	resp, err := s.client.Do(req, group)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return group, resp, nil
+}
+
+// Update updates a self-hosted runner group of an organization by its id.
+// See https://docs.github.com/en/rest/actions/self-hosted-runner-groups#update-a-self-hosted-runner-group-for-an-organization
+func (s *RunnerGroupsService) Update(ctx context.Context, groupID int, params RunnerGroupParams) (*RunnerGroup, *Response, error) {
+	url := fmt.Sprintf("%s/runner-groups/%d", s.basePath, groupID)
+	req, err := s.client.NewRequest(ctx, "PATCH", url, params)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	group := new(RunnerGroup)
+
+	resp, err := s.client.Do(req, group)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return group, resp, nil
+}
+
+// Delete deletes a self-hosted runner group of an organization by its id.
+// See https://docs.github.com/en/rest/actions/self-hosted-runner-groups#delete-a-self-hosted-runner-group-from-an-organization
+func (s *RunnerGroupsService) Delete(ctx context.Context, groupID int) (*Response, error) {
+	url := fmt.Sprintf("%s/runner-groups/%d", s.basePath, groupID)
+	req, err := s.client.NewRequest(ctx, "DELETE", url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// Runners retrieves all self-hosted runners in a runner group page by page.
+// See https://docs.github.com/en/rest/actions/self-hosted-runner-groups#list-self-hosted-runners-in-a-group-for-an-organization
+func (s *RunnerGroupsService) Runners(ctx context.Context, groupID, pageSize, pageNo int) ([]Runner, *Response, error) {
+	url := fmt.Sprintf("%s/runner-groups/%d/runners", s.basePath, groupID)
+	req, err := s.client.NewPageRequest(ctx, "GET", url, pageSize, pageNo, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	body := new(struct {
+		TotalCount int      `json:"total_count"`
+		Runners    []Runner `json:"runners"`
+	})
+
+	resp, err := s.client.Do(req, body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return body.Runners, resp, nil
+}
+
+// SetRunners replaces all self-hosted runners in a runner group.
+// See https://docs.github.com/en/rest/actions/self-hosted-runner-groups#set-self-hosted-runners-in-a-group-for-an-organization
+func (s *RunnerGroupsService) SetRunners(ctx context.Context, groupID int, runnerIDs []int) (*Response, error) {
+	url := fmt.Sprintf("%s/runner-groups/%d/runners", s.basePath, groupID)
+	body := struct {
+		Runners []int `json:"runners"`
+	}{
+		Runners: runnerIDs,
+	}
+
+	if body.Runners == nil {
+		body.Runners = []int{}
+	}
+
+	req, err := s.client.NewRequest(ctx, "PUT", url, body)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// AddRunner adds a self-hosted runner to a runner group.
+// See https://docs.github.com/en/rest/actions/self-hosted-runner-groups#add-a-self-hosted-runner-to-a-group-for-an-organization
+func (s *RunnerGroupsService) AddRunner(ctx context.Context, groupID, runnerID int) (*Response, error) {
+	url := fmt.Sprintf("%s/runner-groups/%d/runners/%d", s.basePath, groupID, runnerID)
+	req, err := s.client.NewRequest(ctx, "PUT", url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// RemoveRunner removes a self-hosted runner from a runner group and moves it to the default group.
+// See https://docs.github.com/en/rest/actions/self-hosted-runner-groups#remove-a-self-hosted-runner-from-a-group-for-an-organization
+func (s *RunnerGroupsService) RemoveRunner(ctx context.Context, groupID, runnerID int) (*Response, error) {
+	url := fmt.Sprintf("%s/runner-groups/%d/runners/%d", s.basePath, groupID, runnerID)
+	req, err := s.client.NewRequest(ctx, "DELETE", url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// Repos retrieves all repositories that can access a runner group with the selected visibility page by page.
+// See https://docs.github.com/en/rest/actions/self-hosted-runner-groups#list-repository-access-to-a-self-hosted-runner-group-in-an-organization
+func (s *RunnerGroupsService) Repos(ctx context.Context, groupID, pageSize, pageNo int) ([]Repository, *Response, error) {
+	url := fmt.Sprintf("%s/runner-groups/%d/repositories", s.basePath, groupID)
+	req, err := s.client.NewPageRequest(ctx, "GET", url, pageSize, pageNo, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	body := new(struct {
+		TotalCount   int          `json:"total_count"`
+		Repositories []Repository `json:"repositories"`
+	})
+
+	resp, err := s.client.Do(req, body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return body.Repositories, resp, nil
+}
+
+// SetRepos replaces all repositories that can access a runner group with the selected visibility.
+// See https://docs.github.com/en/rest/actions/self-hosted-runner-groups#set-repository-access-for-a-self-hosted-runner-group-in-an-organization
+func (s *RunnerGroupsService) SetRepos(ctx context.Context, groupID int, repoIDs []int) (*Response, error) {
+	url := fmt.Sprintf("%s/runner-groups/%d/repositories", s.basePath, groupID)
+	body := struct {
+		SelectedRepositoryIDs []int `json:"selected_repository_ids"`
+	}{
+		SelectedRepositoryIDs: repoIDs,
+	}
+
+	if body.SelectedRepositoryIDs == nil {
+		body.SelectedRepositoryIDs = []int{}
+	}
+
+	req, err := s.client.NewRequest(ctx, "PUT", url, body)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// AddRepo adds a repository to the repositories that can access a runner group with the selected visibility.
+// See https://docs.github.com/en/rest/actions/self-hosted-runner-groups#add-repository-access-to-a-self-hosted-runner-group-in-an-organization
+func (s *RunnerGroupsService) AddRepo(ctx context.Context, groupID, repoID int) (*Response, error) {
+	url := fmt.Sprintf("%s/runner-groups/%d/repositories/%d", s.basePath, groupID, repoID)
+	req, err := s.client.NewRequest(ctx, "PUT", url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// RemoveRepo removes a repository from the repositories that can access a runner group with the selected visibility.
+// See https://docs.github.com/en/rest/actions/self-hosted-runner-groups#remove-repository-access-to-a-self-hosted-runner-group-in-an-organization
+func (s *RunnerGroupsService) RemoveRepo(ctx context.Context, groupID, repoID int) (*Response, error) {
+	url := fmt.Sprintf("%s/runner-groups/%d/repositories/%d", s.basePath, groupID, repoID)
+	req, err := s.client.NewRequest(ctx, "DELETE", url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
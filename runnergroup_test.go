@@ -0,0 +1,1259 @@
+package github
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	runnerGroupBody = `{
+		"id": 2,
+		"name": "octo-runner-group",
+		"visibility": "selected",
+		"default": false,
+		"inherited": false,
+		"allows_public_repositories": false,
+		"restricted_to_workflows": true,
+		"selected_workflows": [
+			"octo-org/octo-repo/.github/workflows/deploy.yaml@refs/heads/main"
+		],
+		"runners_url": "https://api.github.com/orgs/octo-org/actions/runner-groups/2/runners",
+		"selected_repositories_url": "https://api.github.com/orgs/octo-org/actions/runner-groups/2/repositories"
+	}`
+
+	runnerGroupsBody = `{
+		"total_count": 1,
+		"runner_groups": [` + runnerGroupBody + `]
+	}`
+)
+
+var (
+	runnerGroup = RunnerGroup{
+		ID:                      2,
+		Name:                    "octo-runner-group",
+		Visibility:              SecretVisibilitySelected,
+		RestrictedToWorkflows:   true,
+		SelectedWorkflows:       []string{"octo-org/octo-repo/.github/workflows/deploy.yaml@refs/heads/main"},
+		RunnersURL:              "https://api.github.com/orgs/octo-org/actions/runner-groups/2/runners",
+		SelectedRepositoriesURL: "https://api.github.com/orgs/octo-org/actions/runner-groups/2/repositories",
+	}
+)
+
+func TestRunnerGroupsService_List(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	tests := []struct {
+		name             string
+		mockResponses    []MockResponse
+		s                *RunnerGroupsService
+		ctx              context.Context
+		pageSize         int
+		pageNo           int
+		expectedGroups   []RunnerGroup
+		expectedResponse *Response
+		expectedError    string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &RunnerGroupsService{
+				client:   c,
+				basePath: "/orgs/octo-org/actions",
+			},
+			ctx:           nil,
+			pageSize:      10,
+			pageNo:        1,
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"GET", "/orgs/octo-org/actions/runner-groups", 401, http.Header{}, `{
+					"message": "Bad credentials"
+				}`},
+			},
+			s: &RunnerGroupsService{
+				client:   c,
+				basePath: "/orgs/octo-org/actions",
+			},
+			ctx:           context.Background(),
+			pageSize:      10,
+			pageNo:        1,
+			expectedError: `GET /orgs/octo-org/actions/runner-groups: 401 Bad credentials`,
+		},
+		{
+			name: "InvalidResponse",
+			mockResponses: []MockResponse{
+				{"GET", "/orgs/octo-org/actions/runner-groups", 200, http.Header{}, `{`},
+			},
+			s: &RunnerGroupsService{
+				client:   c,
+				basePath: "/orgs/octo-org/actions",
+			},
+			ctx:           context.Background(),
+			pageSize:      10,
+			pageNo:        1,
+			expectedError: `unexpected EOF`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"GET", "/orgs/octo-org/actions/runner-groups", 200, header, runnerGroupsBody},
+			},
+			s: &RunnerGroupsService{
+				client:   c,
+				basePath: "/orgs/octo-org/actions",
+			},
+			ctx:            context.Background(),
+			pageSize:       10,
+			pageNo:         1,
+			expectedGroups: []RunnerGroup{runnerGroup},
+			expectedResponse: &Response{
+				Pages: expectedPages,
+				Rate:  expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			defer ts.Close()
+
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			groups, resp, err := tc.s.List(tc.ctx, tc.pageSize, tc.pageNo)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, groups)
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedGroups, groups)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Pages, resp.Pages)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestRunnerGroupsService_Get(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	tests := []struct {
+		name             string
+		mockResponses    []MockResponse
+		s                *RunnerGroupsService
+		ctx              context.Context
+		groupID          int
+		expectedGroup    *RunnerGroup
+		expectedResponse *Response
+		expectedError    string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &RunnerGroupsService{
+				client:   c,
+				basePath: "/orgs/octo-org/actions",
+			},
+			ctx:           nil,
+			groupID:       2,
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"GET", "/orgs/octo-org/actions/runner-groups/2", 401, http.Header{}, `{
+					"message": "Bad credentials"
+				}`},
+			},
+			s: &RunnerGroupsService{
+				client:   c,
+				basePath: "/orgs/octo-org/actions",
+			},
+			ctx:           context.Background(),
+			groupID:       2,
+			expectedError: `GET /orgs/octo-org/actions/runner-groups/2: 401 Bad credentials`,
+		},
+		{
+			name: "InvalidResponse",
+			mockResponses: []MockResponse{
+				{"GET", "/orgs/octo-org/actions/runner-groups/2", 200, http.Header{}, `{`},
+			},
+			s: &RunnerGroupsService{
+				client:   c,
+				basePath: "/orgs/octo-org/actions",
+			},
+			ctx:           context.Background(),
+			groupID:       2,
+			expectedError: `unexpected EOF`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"GET", "/orgs/octo-org/actions/runner-groups/2", 200, header, runnerGroupBody},
+			},
+			s: &RunnerGroupsService{
+				client:   c,
+				basePath: "/orgs/octo-org/actions",
+			},
+			ctx:           context.Background(),
+			groupID:       2,
+			expectedGroup: &runnerGroup,
+			expectedResponse: &Response{
+				Rate: expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			defer ts.Close()
+
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			group, resp, err := tc.s.Get(tc.ctx, tc.groupID)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, group)
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedGroup, group)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestRunnerGroupsService_Create(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	tests := []struct {
+		name             string
+		mockResponses    []MockResponse
+		s                *RunnerGroupsService
+		ctx              context.Context
+		params           RunnerGroupParams
+		expectedGroup    *RunnerGroup
+		expectedResponse *Response
+		expectedError    string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &RunnerGroupsService{
+				client:   c,
+				basePath: "/orgs/octo-org/actions",
+			},
+			ctx:           nil,
+			params:        RunnerGroupParams{Name: "octo-runner-group", Visibility: SecretVisibilitySelected},
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"POST", "/orgs/octo-org/actions/runner-groups", 401, http.Header{}, `{
+					"message": "Bad credentials"
+				}`},
+			},
+			s: &RunnerGroupsService{
+				client:   c,
+				basePath: "/orgs/octo-org/actions",
+			},
+			ctx:           context.Background(),
+			params:        RunnerGroupParams{Name: "octo-runner-group", Visibility: SecretVisibilitySelected},
+			expectedError: `POST /orgs/octo-org/actions/runner-groups: 401 Bad credentials`,
+		},
+		{
+			name: "InvalidResponse",
+			mockResponses: []MockResponse{
+				{"POST", "/orgs/octo-org/actions/runner-groups", 201, http.Header{}, `{`},
+			},
+			s: &RunnerGroupsService{
+				client:   c,
+				basePath: "/orgs/octo-org/actions",
+			},
+			ctx:           context.Background(),
+			params:        RunnerGroupParams{Name: "octo-runner-group", Visibility: SecretVisibilitySelected},
+			expectedError: `unexpected EOF`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"POST", "/orgs/octo-org/actions/runner-groups", 201, header, runnerGroupBody},
+			},
+			s: &RunnerGroupsService{
+				client:   c,
+				basePath: "/orgs/octo-org/actions",
+			},
+			ctx:           context.Background(),
+			params:        RunnerGroupParams{Name: "octo-runner-group", Visibility: SecretVisibilitySelected},
+			expectedGroup: &runnerGroup,
+			expectedResponse: &Response{
+				Rate: expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			defer ts.Close()
+
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			group, resp, err := tc.s.Create(tc.ctx, tc.params)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, group)
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedGroup, group)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestRunnerGroupsService_Update(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	tests := []struct {
+		name             string
+		mockResponses    []MockResponse
+		s                *RunnerGroupsService
+		ctx              context.Context
+		groupID          int
+		params           RunnerGroupParams
+		expectedGroup    *RunnerGroup
+		expectedResponse *Response
+		expectedError    string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &RunnerGroupsService{
+				client:   c,
+				basePath: "/orgs/octo-org/actions",
+			},
+			ctx:           nil,
+			groupID:       2,
+			params:        RunnerGroupParams{Name: "octo-runner-group", Visibility: SecretVisibilitySelected},
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"PATCH", "/orgs/octo-org/actions/runner-groups/2", 401, http.Header{}, `{
+					"message": "Bad credentials"
+				}`},
+			},
+			s: &RunnerGroupsService{
+				client:   c,
+				basePath: "/orgs/octo-org/actions",
+			},
+			ctx:           context.Background(),
+			groupID:       2,
+			params:        RunnerGroupParams{Name: "octo-runner-group", Visibility: SecretVisibilitySelected},
+			expectedError: `PATCH /orgs/octo-org/actions/runner-groups/2: 401 Bad credentials`,
+		},
+		{
+			name: "InvalidResponse",
+			mockResponses: []MockResponse{
+				{"PATCH", "/orgs/octo-org/actions/runner-groups/2", 200, http.Header{}, `{`},
+			},
+			s: &RunnerGroupsService{
+				client:   c,
+				basePath: "/orgs/octo-org/actions",
+			},
+			ctx:           context.Background(),
+			groupID:       2,
+			params:        RunnerGroupParams{Name: "octo-runner-group", Visibility: SecretVisibilitySelected},
+			expectedError: `unexpected EOF`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"PATCH", "/orgs/octo-org/actions/runner-groups/2", 200, header, runnerGroupBody},
+			},
+			s: &RunnerGroupsService{
+				client:   c,
+				basePath: "/orgs/octo-org/actions",
+			},
+			ctx:           context.Background(),
+			groupID:       2,
+			params:        RunnerGroupParams{Name: "octo-runner-group", Visibility: SecretVisibilitySelected},
+			expectedGroup: &runnerGroup,
+			expectedResponse: &Response{
+				Rate: expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			defer ts.Close()
+
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			group, resp, err := tc.s.Update(tc.ctx, tc.groupID, tc.params)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, group)
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedGroup, group)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestRunnerGroupsService_Delete(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	tests := []struct {
+		name             string
+		mockResponses    []MockResponse
+		s                *RunnerGroupsService
+		ctx              context.Context
+		groupID          int
+		expectedResponse *Response
+		expectedError    string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &RunnerGroupsService{
+				client:   c,
+				basePath: "/orgs/octo-org/actions",
+			},
+			ctx:           nil,
+			groupID:       2,
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"DELETE", "/orgs/octo-org/actions/runner-groups/2", 401, http.Header{}, `{
+					"message": "Bad credentials"
+				}`},
+			},
+			s: &RunnerGroupsService{
+				client:   c,
+				basePath: "/orgs/octo-org/actions",
+			},
+			ctx:           context.Background(),
+			groupID:       2,
+			expectedError: `DELETE /orgs/octo-org/actions/runner-groups/2: 401 Bad credentials`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"DELETE", "/orgs/octo-org/actions/runner-groups/2", 204, header, ``},
+			},
+			s: &RunnerGroupsService{
+				client:   c,
+				basePath: "/orgs/octo-org/actions",
+			},
+			ctx:     context.Background(),
+			groupID: 2,
+			expectedResponse: &Response{
+				Rate: expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			defer ts.Close()
+
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			resp, err := tc.s.Delete(tc.ctx, tc.groupID)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestRunnerGroupsService_Runners(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	tests := []struct {
+		name             string
+		mockResponses    []MockResponse
+		s                *RunnerGroupsService
+		ctx              context.Context
+		groupID          int
+		pageSize         int
+		pageNo           int
+		expectedRunners  []Runner
+		expectedResponse *Response
+		expectedError    string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &RunnerGroupsService{
+				client:   c,
+				basePath: "/orgs/octo-org/actions",
+			},
+			ctx:           nil,
+			groupID:       2,
+			pageSize:      10,
+			pageNo:        1,
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"GET", "/orgs/octo-org/actions/runner-groups/2/runners", 401, http.Header{}, `{
+					"message": "Bad credentials"
+				}`},
+			},
+			s: &RunnerGroupsService{
+				client:   c,
+				basePath: "/orgs/octo-org/actions",
+			},
+			ctx:           context.Background(),
+			groupID:       2,
+			pageSize:      10,
+			pageNo:        1,
+			expectedError: `GET /orgs/octo-org/actions/runner-groups/2/runners: 401 Bad credentials`,
+		},
+		{
+			name: "InvalidResponse",
+			mockResponses: []MockResponse{
+				{"GET", "/orgs/octo-org/actions/runner-groups/2/runners", 200, http.Header{}, `{`},
+			},
+			s: &RunnerGroupsService{
+				client:   c,
+				basePath: "/orgs/octo-org/actions",
+			},
+			ctx:           context.Background(),
+			groupID:       2,
+			pageSize:      10,
+			pageNo:        1,
+			expectedError: `unexpected EOF`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"GET", "/orgs/octo-org/actions/runner-groups/2/runners", 200, header, runnersBody},
+			},
+			s: &RunnerGroupsService{
+				client:   c,
+				basePath: "/orgs/octo-org/actions",
+			},
+			ctx:             context.Background(),
+			groupID:         2,
+			pageSize:        10,
+			pageNo:          1,
+			expectedRunners: []Runner{runner},
+			expectedResponse: &Response{
+				Pages: expectedPages,
+				Rate:  expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			defer ts.Close()
+
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			runners, resp, err := tc.s.Runners(tc.ctx, tc.groupID, tc.pageSize, tc.pageNo)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, runners)
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedRunners, runners)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Pages, resp.Pages)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestRunnerGroupsService_SetRunners(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	tests := []struct {
+		name             string
+		mockResponses    []MockResponse
+		s                *RunnerGroupsService
+		ctx              context.Context
+		groupID          int
+		runnerIDs        []int
+		expectedResponse *Response
+		expectedError    string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &RunnerGroupsService{
+				client:   c,
+				basePath: "/orgs/octo-org/actions",
+			},
+			ctx:           nil,
+			groupID:       2,
+			runnerIDs:     []int{23},
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"PUT", "/orgs/octo-org/actions/runner-groups/2/runners", 401, http.Header{}, `{
+					"message": "Bad credentials"
+				}`},
+			},
+			s: &RunnerGroupsService{
+				client:   c,
+				basePath: "/orgs/octo-org/actions",
+			},
+			ctx:           context.Background(),
+			groupID:       2,
+			runnerIDs:     []int{23},
+			expectedError: `PUT /orgs/octo-org/actions/runner-groups/2/runners: 401 Bad credentials`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"PUT", "/orgs/octo-org/actions/runner-groups/2/runners", 204, header, ``},
+			},
+			s: &RunnerGroupsService{
+				client:   c,
+				basePath: "/orgs/octo-org/actions",
+			},
+			ctx:       context.Background(),
+			groupID:   2,
+			runnerIDs: []int{23},
+			expectedResponse: &Response{
+				Rate: expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			defer ts.Close()
+
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			resp, err := tc.s.SetRunners(tc.ctx, tc.groupID, tc.runnerIDs)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestRunnerGroupsService_AddRunner(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	tests := []struct {
+		name             string
+		mockResponses    []MockResponse
+		s                *RunnerGroupsService
+		ctx              context.Context
+		groupID          int
+		runnerID         int
+		expectedResponse *Response
+		expectedError    string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &RunnerGroupsService{
+				client:   c,
+				basePath: "/orgs/octo-org/actions",
+			},
+			ctx:           nil,
+			groupID:       2,
+			runnerID:      23,
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"PUT", "/orgs/octo-org/actions/runner-groups/2/runners/23", 401, http.Header{}, `{
+					"message": "Bad credentials"
+				}`},
+			},
+			s: &RunnerGroupsService{
+				client:   c,
+				basePath: "/orgs/octo-org/actions",
+			},
+			ctx:           context.Background(),
+			groupID:       2,
+			runnerID:      23,
+			expectedError: `PUT /orgs/octo-org/actions/runner-groups/2/runners/23: 401 Bad credentials`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"PUT", "/orgs/octo-org/actions/runner-groups/2/runners/23", 204, header, ``},
+			},
+			s: &RunnerGroupsService{
+				client:   c,
+				basePath: "/orgs/octo-org/actions",
+			},
+			ctx:      context.Background(),
+			groupID:  2,
+			runnerID: 23,
+			expectedResponse: &Response{
+				Rate: expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			defer ts.Close()
+
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			resp, err := tc.s.AddRunner(tc.ctx, tc.groupID, tc.runnerID)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestRunnerGroupsService_RemoveRunner(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	tests := []struct {
+		name             string
+		mockResponses    []MockResponse
+		s                *RunnerGroupsService
+		ctx              context.Context
+		groupID          int
+		runnerID         int
+		expectedResponse *Response
+		expectedError    string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &RunnerGroupsService{
+				client:   c,
+				basePath: "/orgs/octo-org/actions",
+			},
+			ctx:           nil,
+			groupID:       2,
+			runnerID:      23,
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"DELETE", "/orgs/octo-org/actions/runner-groups/2/runners/23", 401, http.Header{}, `{
+					"message": "Bad credentials"
+				}`},
+			},
+			s: &RunnerGroupsService{
+				client:   c,
+				basePath: "/orgs/octo-org/actions",
+			},
+			ctx:           context.Background(),
+			groupID:       2,
+			runnerID:      23,
+			expectedError: `DELETE /orgs/octo-org/actions/runner-groups/2/runners/23: 401 Bad credentials`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"DELETE", "/orgs/octo-org/actions/runner-groups/2/runners/23", 204, header, ``},
+			},
+			s: &RunnerGroupsService{
+				client:   c,
+				basePath: "/orgs/octo-org/actions",
+			},
+			ctx:      context.Background(),
+			groupID:  2,
+			runnerID: 23,
+			expectedResponse: &Response{
+				Rate: expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			defer ts.Close()
+
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			resp, err := tc.s.RemoveRunner(tc.ctx, tc.groupID, tc.runnerID)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestRunnerGroupsService_Repos(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	tests := []struct {
+		name             string
+		mockResponses    []MockResponse
+		s                *RunnerGroupsService
+		ctx              context.Context
+		groupID          int
+		pageSize         int
+		pageNo           int
+		expectedRepos    []Repository
+		expectedResponse *Response
+		expectedError    string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &RunnerGroupsService{
+				client:   c,
+				basePath: "/orgs/octo-org/actions",
+			},
+			ctx:           nil,
+			groupID:       2,
+			pageSize:      10,
+			pageNo:        1,
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"GET", "/orgs/octo-org/actions/runner-groups/2/repositories", 401, http.Header{}, `{
+					"message": "Bad credentials"
+				}`},
+			},
+			s: &RunnerGroupsService{
+				client:   c,
+				basePath: "/orgs/octo-org/actions",
+			},
+			ctx:           context.Background(),
+			groupID:       2,
+			pageSize:      10,
+			pageNo:        1,
+			expectedError: `GET /orgs/octo-org/actions/runner-groups/2/repositories: 401 Bad credentials`,
+		},
+		{
+			name: "InvalidResponse",
+			mockResponses: []MockResponse{
+				{"GET", "/orgs/octo-org/actions/runner-groups/2/repositories", 200, http.Header{}, `{`},
+			},
+			s: &RunnerGroupsService{
+				client:   c,
+				basePath: "/orgs/octo-org/actions",
+			},
+			ctx:           context.Background(),
+			groupID:       2,
+			pageSize:      10,
+			pageNo:        1,
+			expectedError: `unexpected EOF`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"GET", "/orgs/octo-org/actions/runner-groups/2/repositories", 200, header, selectedReposBody},
+			},
+			s: &RunnerGroupsService{
+				client:   c,
+				basePath: "/orgs/octo-org/actions",
+			},
+			ctx:           context.Background(),
+			groupID:       2,
+			pageSize:      10,
+			pageNo:        1,
+			expectedRepos: []Repository{repository},
+			expectedResponse: &Response{
+				Pages: expectedPages,
+				Rate:  expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			defer ts.Close()
+
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			repos, resp, err := tc.s.Repos(tc.ctx, tc.groupID, tc.pageSize, tc.pageNo)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, repos)
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedRepos, repos)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Pages, resp.Pages)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestRunnerGroupsService_SetRepos(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	tests := []struct {
+		name             string
+		mockResponses    []MockResponse
+		s                *RunnerGroupsService
+		ctx              context.Context
+		groupID          int
+		repoIDs          []int
+		expectedResponse *Response
+		expectedError    string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &RunnerGroupsService{
+				client:   c,
+				basePath: "/orgs/octo-org/actions",
+			},
+			ctx:           nil,
+			groupID:       2,
+			repoIDs:       []int{1296269},
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"PUT", "/orgs/octo-org/actions/runner-groups/2/repositories", 401, http.Header{}, `{
+					"message": "Bad credentials"
+				}`},
+			},
+			s: &RunnerGroupsService{
+				client:   c,
+				basePath: "/orgs/octo-org/actions",
+			},
+			ctx:           context.Background(),
+			groupID:       2,
+			repoIDs:       []int{1296269},
+			expectedError: `PUT /orgs/octo-org/actions/runner-groups/2/repositories: 401 Bad credentials`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"PUT", "/orgs/octo-org/actions/runner-groups/2/repositories", 204, header, ``},
+			},
+			s: &RunnerGroupsService{
+				client:   c,
+				basePath: "/orgs/octo-org/actions",
+			},
+			ctx:     context.Background(),
+			groupID: 2,
+			repoIDs: []int{1296269},
+			expectedResponse: &Response{
+				Rate: expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			defer ts.Close()
+
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			resp, err := tc.s.SetRepos(tc.ctx, tc.groupID, tc.repoIDs)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestRunnerGroupsService_AddRepo(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	tests := []struct {
+		name             string
+		mockResponses    []MockResponse
+		s                *RunnerGroupsService
+		ctx              context.Context
+		groupID          int
+		repoID           int
+		expectedResponse *Response
+		expectedError    string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &RunnerGroupsService{
+				client:   c,
+				basePath: "/orgs/octo-org/actions",
+			},
+			ctx:           nil,
+			groupID:       2,
+			repoID:        1296269,
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"PUT", "/orgs/octo-org/actions/runner-groups/2/repositories/1296269", 401, http.Header{}, `{
+					"message": "Bad credentials"
+				}`},
+			},
+			s: &RunnerGroupsService{
+				client:   c,
+				basePath: "/orgs/octo-org/actions",
+			},
+			ctx:           context.Background(),
+			groupID:       2,
+			repoID:        1296269,
+			expectedError: `PUT /orgs/octo-org/actions/runner-groups/2/repositories/1296269: 401 Bad credentials`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"PUT", "/orgs/octo-org/actions/runner-groups/2/repositories/1296269", 204, header, ``},
+			},
+			s: &RunnerGroupsService{
+				client:   c,
+				basePath: "/orgs/octo-org/actions",
+			},
+			ctx:     context.Background(),
+			groupID: 2,
+			repoID:  1296269,
+			expectedResponse: &Response{
+				Rate: expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			defer ts.Close()
+
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			resp, err := tc.s.AddRepo(tc.ctx, tc.groupID, tc.repoID)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestRunnerGroupsService_RemoveRepo(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	tests := []struct {
+		name             string
+		mockResponses    []MockResponse
+		s                *RunnerGroupsService
+		ctx              context.Context
+		groupID          int
+		repoID           int
+		expectedResponse *Response
+		expectedError    string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &RunnerGroupsService{
+				client:   c,
+				basePath: "/orgs/octo-org/actions",
+			},
+			ctx:           nil,
+			groupID:       2,
+			repoID:        1296269,
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"DELETE", "/orgs/octo-org/actions/runner-groups/2/repositories/1296269", 401, http.Header{}, `{
+					"message": "Bad credentials"
+				}`},
+			},
+			s: &RunnerGroupsService{
+				client:   c,
+				basePath: "/orgs/octo-org/actions",
+			},
+			ctx:           context.Background(),
+			groupID:       2,
+			repoID:        1296269,
+			expectedError: `DELETE /orgs/octo-org/actions/runner-groups/2/repositories/1296269: 401 Bad credentials`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"DELETE", "/orgs/octo-org/actions/runner-groups/2/repositories/1296269", 204, header, ``},
+			},
+			s: &RunnerGroupsService{
+				client:   c,
+				basePath: "/orgs/octo-org/actions",
+			},
+			ctx:     context.Background(),
+			groupID: 2,
+			repoID:  1296269,
+			expectedResponse: &Response{
+				Rate: expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			defer ts.Close()
+
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			resp, err := tc.s.RemoveRepo(tc.ctx, tc.groupID, tc.repoID)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
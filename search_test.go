@@ -165,6 +165,7 @@ var (
 				Description:   "This your first repo!",
 				Topics:        []string{"octocat", "api"},
 				Private:       false,
+				Visibility:    VisibilityPublic,
 				Fork:          false,
 				Archived:      false,
 				Disabled:      false,
@@ -0,0 +1,327 @@
+package github
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"sync"
+	"time"
+
+	"golang.org/x/crypto/nacl/box"
+)
+
+// SecretsService provides GitHub APIs for GitHub Actions secrets in a repository, an environment, or an organization.
+// Secret values are encrypted locally with the public key of the repository, environment, or organization,
+// so only the plaintext values need to be provided.
+// See https://docs.github.com/en/rest/actions/secrets
+type SecretsService struct {
+	client   *Client
+	basePath string
+
+	keyMutex sync.Mutex
+	key      *SecretsPublicKey
+}
+
+// SecretVisibility determines which repositories can access an organization secret.
+type SecretVisibility string
+
+const (
+	// SecretVisibilityAll makes an organization secret accessible to all repositories.
+	SecretVisibilityAll SecretVisibility = "all"
+	// SecretVisibilityPrivate makes an organization secret accessible to private and internal repositories.
+	SecretVisibilityPrivate SecretVisibility = "private"
+	// SecretVisibilitySelected makes an organization secret accessible to the selected repositories only.
+	SecretVisibilitySelected SecretVisibility = "selected"
+)
+
+type (
+	// SecretsPublicKey is the public key for encrypting secrets.
+	SecretsPublicKey struct {
+		KeyID string `json:"key_id"`
+		Key   string `json:"key"` // Base64-encoded
+	}
+
+	// Secret is a GitHub Actions secret object.
+	// The value of a secret is never returned.
+	Secret struct {
+		Name                    string           `json:"name"`
+		Visibility              SecretVisibility `json:"visibility,omitempty"`                // Only for organization secrets
+		SelectedRepositoriesURL string           `json:"selected_repositories_url,omitempty"` // Only for organization secrets
+		CreatedAt               time.Time        `json:"created_at"`
+		UpdatedAt               time.Time        `json:"updated_at"`
+	}
+
+	// SecretParams is used for creating or updating a GitHub Actions secret.
+	SecretParams struct {
+		Name  string
+		Value string
+		// Visibility and SelectedRepositoryIDs are only for organization secrets.
+		Visibility            SecretVisibility
+		SelectedRepositoryIDs []int
+	}
+)
+
+// EnvironmentSecrets returns a service providing GitHub APIs for the secrets of a deployment environment in the repository.
+func (s *RepoService) EnvironmentSecrets(environment string) *SecretsService {
+	return &SecretsService{
+		client:   s.client,
+		basePath: fmt.Sprintf("/repos/%s/%s/environments/%s", s.owner, s.repo, escapePath(environment)),
+	}
+}
+
+// PublicKey retrieves the public key for encrypting secrets.
+// See https://docs.github.com/en/rest/actions/secrets#get-a-repository-public-key
+// See https://docs.github.com/en/rest/actions/secrets#get-an-environment-public-key
+// See https://docs.github.com/en/rest/actions/secrets#get-an-organization-public-key
+func (s *SecretsService) PublicKey(ctx context.Context) (*SecretsPublicKey, *Response, error) {
+	url := s.basePath + "/secrets/public-key"
+	req, err := s.client.NewRequest(ctx, "GET", url, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	key := new(SecretsPublicKey)
+
+	resp, err := s.client.Do(req, key)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return key, resp, nil
+}
+
+// publicKey returns the cached public key for encrypting secrets or retrieves it if not cached yet.
+func (s *SecretsService) publicKey(ctx context.Context) (*SecretsPublicKey, error) {
+	s.keyMutex.Lock()
+	defer s.keyMutex.Unlock()
+
+	if s.key == nil {
+		key, _, err := s.PublicKey(ctx)
+		if err != nil {
+			return nil, err
+		}
+		s.key = key
+	}
+
+	return s.key, nil
+}
+
+// resetPublicKey drops the cached public key, so it will be retrieved again.
+func (s *SecretsService) resetPublicKey() {
+	s.keyMutex.Lock()
+	s.key = nil
+	s.keyMutex.Unlock()
+}
+
+// encryptSecret encrypts a secret value with a public key using a libsodium sealed box.
+func encryptSecret(key *SecretsPublicKey, value string) (string, error) {
+	publicKey, err := base64.StdEncoding.DecodeString(key.Key)
+	if err != nil {
+		return "", fmt.Errorf("invalid public key %s: %s", key.KeyID, err)
+	}
+
+	if len(publicKey) != 32 {
+		return "", fmt.Errorf("invalid public key %s: %d bytes", key.KeyID, len(publicKey))
+	}
+
+	sealed, err := box.SealAnonymous(nil, []byte(value), (*[32]byte)(publicKey), rand.Reader)
+	if err != nil {
+		return "", err
+	}
+
+	return base64.StdEncoding.EncodeToString(sealed), nil
+}
+
+// List retrieves all secrets page by page.
+// See https://docs.github.com/en/rest/actions/secrets#list-repository-secrets
+// See https://docs.github.com/en/rest/actions/secrets#list-environment-secrets
+// See https://docs.github.com/en/rest/actions/secrets#list-organization-secrets
+func (s *SecretsService) List(ctx context.Context, pageSize, pageNo int) ([]Secret, *Response, error) {
+	url := s.basePath + "/secrets"
+	req, err := s.client.NewPageRequest(ctx, "GET", url, pageSize, pageNo, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	body := new(struct {
+		TotalCount int      `json:"total_count"`
+		Secrets    []Secret `json:"secrets"`
+	})
+
+	resp, err := s.client.Do(req, body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return body.Secrets, resp, nil
+}
+
+// Get retrieves a secret by its name without its value.
+// See https://docs.github.com/en/rest/actions/secrets#get-a-repository-secret
+// See https://docs.github.com/en/rest/actions/secrets#get-an-environment-secret
+// See https://docs.github.com/en/rest/actions/secrets#get-an-organization-secret
+func (s *SecretsService) Get(ctx context.Context, name string) (*Secret, *Response, error) {
+	url := fmt.Sprintf("%s/secrets/%s", s.basePath, name)
+	req, err := s.client.NewRequest(ctx, "GET", url, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	secret := new(Secret)
+
+	resp, err := s.client.Do(req, secret)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return secret, resp, nil
+}
+
+// Put creates or updates a secret.
+// The value is encrypted with the public key, which is retrieved once and cached.
+// The response status code is 201 if the secret is created and 204 if it is updated.
+// See https://docs.github.com/en/rest/actions/secrets#create-or-update-a-repository-secret
+// See https://docs.github.com/en/rest/actions/secrets#create-or-update-an-environment-secret
+// See https://docs.github.com/en/rest/actions/secrets#create-or-update-an-organization-secret
+func (s *SecretsService) Put(ctx context.Context, params SecretParams) (*Response, error) {
+	key, err := s.publicKey(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	encryptedValue, err := encryptSecret(key, params.Value)
+	if err != nil {
+		s.resetPublicKey()
+		return nil, err
+	}
+
+	url := fmt.Sprintf("%s/secrets/%s", s.basePath, params.Name)
+	body := struct {
+		EncryptedValue        string           `json:"encrypted_value"`
+		KeyID                 string           `json:"key_id"`
+		Visibility            SecretVisibility `json:"visibility,omitempty"`
+		SelectedRepositoryIDs []int            `json:"selected_repository_ids,omitempty"`
+	}{
+		EncryptedValue:        encryptedValue,
+		KeyID:                 key.KeyID,
+		Visibility:            params.Visibility,
+		SelectedRepositoryIDs: params.SelectedRepositoryIDs,
+	}
+
+	req, err := s.client.NewRequest(ctx, "PUT", url, body)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		// The public key may have been rotated.
+		s.resetPublicKey()
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// Delete deletes a secret by its name.
+// See https://docs.github.com/en/rest/actions/secrets#delete-a-repository-secret
+// See https://docs.github.com/en/rest/actions/secrets#delete-an-environment-secret
+// See https://docs.github.com/en/rest/actions/secrets#delete-an-organization-secret
+func (s *SecretsService) Delete(ctx context.Context, name string) (*Response, error) {
+	url := fmt.Sprintf("%s/secrets/%s", s.basePath, name)
+	req, err := s.client.NewRequest(ctx, "DELETE", url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// SelectedRepos retrieves all repositories that can access an organization secret with the selected visibility page by page.
+// See https://docs.github.com/en/rest/actions/secrets#list-selected-repositories-for-an-organization-secret
+func (s *SecretsService) SelectedRepos(ctx context.Context, name string, pageSize, pageNo int) ([]Repository, *Response, error) {
+	url := fmt.Sprintf("%s/secrets/%s/repositories", s.basePath, name)
+	req, err := s.client.NewPageRequest(ctx, "GET", url, pageSize, pageNo, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	body := new(struct {
+		TotalCount   int          `json:"total_count"`
+		Repositories []Repository `json:"repositories"`
+	})
+
+	resp, err := s.client.Do(req, body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return body.Repositories, resp, nil
+}
+
+// SetSelectedRepos replaces all repositories that can access an organization secret with the selected visibility.
+// See https://docs.github.com/en/rest/actions/secrets#set-selected-repositories-for-an-organization-secret
+func (s *SecretsService) SetSelectedRepos(ctx context.Context, name string, repoIDs []int) (*Response, error) {
+	url := fmt.Sprintf("%s/secrets/%s/repositories", s.basePath, name)
+	body := struct {
+		SelectedRepositoryIDs []int `json:"selected_repository_ids"`
+	}{
+		SelectedRepositoryIDs: repoIDs,
+	}
+
+	if body.SelectedRepositoryIDs == nil {
+		body.SelectedRepositoryIDs = []int{}
+	}
+
+	req, err := s.client.NewRequest(ctx, "PUT", url, body)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// AddSelectedRepo adds a repository to the repositories that can access an organization secret with the selected visibility.
+// See https://docs.github.com/en/rest/actions/secrets#add-selected-repository-to-an-organization-secret
+func (s *SecretsService) AddSelectedRepo(ctx context.Context, name string, repoID int) (*Response, error) {
+	url := fmt.Sprintf("%s/secrets/%s/repositories/%d", s.basePath, name, repoID)
+	req, err := s.client.NewRequest(ctx, "PUT", url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// RemoveSelectedRepo removes a repository from the repositories that can access an organization secret with the selected visibility.
+// See https://docs.github.com/en/rest/actions/secrets#remove-selected-repository-from-an-organization-secret
+func (s *SecretsService) RemoveSelectedRepo(ctx context.Context, name string, repoID int) (*Response, error) {
+	url := fmt.Sprintf("%s/secrets/%s/repositories/%d", s.basePath, name, repoID)
+	req, err := s.client.NewRequest(ctx, "DELETE", url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
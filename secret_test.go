@@ -0,0 +1,1025 @@
+package github
+
+import (
+	"context"
+	"encoding/base64"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/crypto/nacl/box"
+)
+
+const (
+	secretsPublicKeyBody = `{
+		"key_id": "012345678912345678",
+		"key": "3p7bfXt9wbTTW2HC7OQ1Nz+DQ8hbeGdNrfx+FG+IK08="
+	}`
+
+	secretBody = `{
+		"name": "GH_TOKEN",
+		"created_at": "2019-08-10T14:59:22Z",
+		"updated_at": "2020-01-10T14:59:22Z"
+	}`
+
+	secretsBody = `{
+		"total_count": 1,
+		"secrets": [` + secretBody + `]
+	}`
+
+	orgSecretBody = `{
+		"name": "GH_TOKEN",
+		"visibility": "selected",
+		"selected_repositories_url": "https://api.github.com/orgs/octo-org/actions/secrets/GH_TOKEN/repositories",
+		"created_at": "2019-08-10T14:59:22Z",
+		"updated_at": "2020-01-10T14:59:22Z"
+	}`
+
+	selectedReposBody = `{
+		"total_count": 1,
+		"repositories": [` + repositoryBody + `]
+	}`
+)
+
+var (
+	secretsPublicKey = SecretsPublicKey{
+		KeyID: "012345678912345678",
+		Key:   "3p7bfXt9wbTTW2HC7OQ1Nz+DQ8hbeGdNrfx+FG+IK08=",
+	}
+
+	secret = Secret{
+		Name:      "GH_TOKEN",
+		CreatedAt: parseGitHubTime("2019-08-10T14:59:22Z"),
+		UpdatedAt: parseGitHubTime("2020-01-10T14:59:22Z"),
+	}
+
+	orgSecret = Secret{
+		Name:                    "GH_TOKEN",
+		Visibility:              SecretVisibilitySelected,
+		SelectedRepositoriesURL: "https://api.github.com/orgs/octo-org/actions/secrets/GH_TOKEN/repositories",
+		CreatedAt:               parseGitHubTime("2019-08-10T14:59:22Z"),
+		UpdatedAt:               parseGitHubTime("2020-01-10T14:59:22Z"),
+	}
+)
+
+func TestRepoService_EnvironmentSecrets(t *testing.T) {
+	c := &Client{}
+	s := &RepoService{
+		client: c,
+		owner:  "octocat",
+		repo:   "Hello-World",
+	}
+
+	secrets := s.EnvironmentSecrets("production/us-east")
+
+	assert.NotNil(t, secrets)
+	assert.Equal(t, c, secrets.client)
+	assert.Equal(t, "/repos/octocat/Hello-World/environments/production/us-east", secrets.basePath)
+}
+
+func TestEncryptSecret(t *testing.T) {
+	// The key pair of secretsPublicKey (Bob's key pair from the NaCl test vectors).
+	var recipientPublicKey, recipientPrivateKey [32]byte
+	copy(recipientPrivateKey[:], []byte{
+		0x5d, 0xab, 0x08, 0x7e, 0x62, 0x4a, 0x8a, 0x4b, 0x79, 0xe1, 0x7f, 0x8b, 0x83, 0x80, 0x0e, 0xe6,
+		0x6f, 0x3b, 0xb1, 0x29, 0x26, 0x18, 0xb6, 0xfd, 0x1c, 0x2f, 0x8b, 0x27, 0xff, 0x88, 0xe0, 0xeb,
+	})
+	publicKey, _ := base64.StdEncoding.DecodeString(secretsPublicKey.Key)
+	copy(recipientPublicKey[:], publicKey)
+
+	tests := []struct {
+		name          string
+		key           *SecretsPublicKey
+		value         string
+		expectedError string
+	}{
+		{
+			name: "InvalidBase64",
+			key: &SecretsPublicKey{
+				KeyID: "012345678912345678",
+				Key:   "not-base64!",
+			},
+			value:         "s3cr3t",
+			expectedError: "invalid public key 012345678912345678: illegal base64 data at input byte 3",
+		},
+		{
+			name: "InvalidKey",
+			key: &SecretsPublicKey{
+				KeyID: "012345678912345678",
+				Key:   "AAAA",
+			},
+			value:         "s3cr3t",
+			expectedError: "invalid public key 012345678912345678: 3 bytes",
+		},
+		{
+			name:  "Success",
+			key:   &secretsPublicKey,
+			value: "s3cr3t",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			encryptedValue, err := encryptSecret(tc.key, tc.value)
+
+			if tc.expectedError != "" {
+				assert.Empty(t, encryptedValue)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+
+				sealed, err := base64.StdEncoding.DecodeString(encryptedValue)
+				assert.NoError(t, err)
+
+				value, ok := box.OpenAnonymous(nil, sealed, &recipientPublicKey, &recipientPrivateKey)
+				assert.True(t, ok)
+				assert.Equal(t, tc.value, string(value))
+			}
+		})
+	}
+}
+
+func TestSecretsService_PublicKey(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	tests := []struct {
+		name             string
+		mockResponses    []MockResponse
+		s                *SecretsService
+		ctx              context.Context
+		expectedKey      *SecretsPublicKey
+		expectedResponse *Response
+		expectedError    string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &SecretsService{
+				client:   c,
+				basePath: "/repos/octocat/Hello-World/actions",
+			},
+			ctx:           nil,
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/actions/secrets/public-key", 401, http.Header{}, `{
+					"message": "Bad credentials"
+				}`},
+			},
+			s: &SecretsService{
+				client:   c,
+				basePath: "/repos/octocat/Hello-World/actions",
+			},
+			ctx:           context.Background(),
+			expectedError: `GET /repos/octocat/Hello-World/actions/secrets/public-key: 401 Bad credentials`,
+		},
+		{
+			name: "InvalidResponse",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/actions/secrets/public-key", 200, http.Header{}, `{`},
+			},
+			s: &SecretsService{
+				client:   c,
+				basePath: "/repos/octocat/Hello-World/actions",
+			},
+			ctx:           context.Background(),
+			expectedError: `unexpected EOF`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/actions/secrets/public-key", 200, header, secretsPublicKeyBody},
+			},
+			s: &SecretsService{
+				client:   c,
+				basePath: "/repos/octocat/Hello-World/actions",
+			},
+			ctx:         context.Background(),
+			expectedKey: &secretsPublicKey,
+			expectedResponse: &Response{
+				Rate: expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			defer ts.Close()
+
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			key, resp, err := tc.s.PublicKey(tc.ctx)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, key)
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedKey, key)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestSecretsService_List(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	tests := []struct {
+		name             string
+		mockResponses    []MockResponse
+		s                *SecretsService
+		ctx              context.Context
+		pageSize         int
+		pageNo           int
+		expectedSecrets  []Secret
+		expectedResponse *Response
+		expectedError    string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &SecretsService{
+				client:   c,
+				basePath: "/repos/octocat/Hello-World/actions",
+			},
+			ctx:           nil,
+			pageSize:      10,
+			pageNo:        1,
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/actions/secrets", 401, http.Header{}, `{
+					"message": "Bad credentials"
+				}`},
+			},
+			s: &SecretsService{
+				client:   c,
+				basePath: "/repos/octocat/Hello-World/actions",
+			},
+			ctx:           context.Background(),
+			pageSize:      10,
+			pageNo:        1,
+			expectedError: `GET /repos/octocat/Hello-World/actions/secrets: 401 Bad credentials`,
+		},
+		{
+			name: "InvalidResponse",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/actions/secrets", 200, http.Header{}, `{`},
+			},
+			s: &SecretsService{
+				client:   c,
+				basePath: "/repos/octocat/Hello-World/actions",
+			},
+			ctx:           context.Background(),
+			pageSize:      10,
+			pageNo:        1,
+			expectedError: `unexpected EOF`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/actions/secrets", 200, header, secretsBody},
+			},
+			s: &SecretsService{
+				client:   c,
+				basePath: "/repos/octocat/Hello-World/actions",
+			},
+			ctx:             context.Background(),
+			pageSize:        10,
+			pageNo:          1,
+			expectedSecrets: []Secret{secret},
+			expectedResponse: &Response{
+				Pages: expectedPages,
+				Rate:  expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			defer ts.Close()
+
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			secrets, resp, err := tc.s.List(tc.ctx, tc.pageSize, tc.pageNo)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, secrets)
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedSecrets, secrets)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Pages, resp.Pages)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestSecretsService_Get(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	tests := []struct {
+		name             string
+		mockResponses    []MockResponse
+		s                *SecretsService
+		ctx              context.Context
+		secretName       string
+		expectedSecret   *Secret
+		expectedResponse *Response
+		expectedError    string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &SecretsService{
+				client:   c,
+				basePath: "/repos/octocat/Hello-World/actions",
+			},
+			ctx:           nil,
+			secretName:    "GH_TOKEN",
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/actions/secrets/GH_TOKEN", 401, http.Header{}, `{
+					"message": "Bad credentials"
+				}`},
+			},
+			s: &SecretsService{
+				client:   c,
+				basePath: "/repos/octocat/Hello-World/actions",
+			},
+			ctx:           context.Background(),
+			secretName:    "GH_TOKEN",
+			expectedError: `GET /repos/octocat/Hello-World/actions/secrets/GH_TOKEN: 401 Bad credentials`,
+		},
+		{
+			name: "InvalidResponse",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/actions/secrets/GH_TOKEN", 200, http.Header{}, `{`},
+			},
+			s: &SecretsService{
+				client:   c,
+				basePath: "/repos/octocat/Hello-World/actions",
+			},
+			ctx:           context.Background(),
+			secretName:    "GH_TOKEN",
+			expectedError: `unexpected EOF`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/actions/secrets/GH_TOKEN", 200, header, secretBody},
+			},
+			s: &SecretsService{
+				client:   c,
+				basePath: "/repos/octocat/Hello-World/actions",
+			},
+			ctx:            context.Background(),
+			secretName:     "GH_TOKEN",
+			expectedSecret: &secret,
+			expectedResponse: &Response{
+				Rate: expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			defer ts.Close()
+
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			sec, resp, err := tc.s.Get(tc.ctx, tc.secretName)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, sec)
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedSecret, sec)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestSecretsService_Delete(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	tests := []struct {
+		name             string
+		mockResponses    []MockResponse
+		s                *SecretsService
+		ctx              context.Context
+		secretName       string
+		expectedResponse *Response
+		expectedError    string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &SecretsService{
+				client:   c,
+				basePath: "/repos/octocat/Hello-World/actions",
+			},
+			ctx:           nil,
+			secretName:    "GH_TOKEN",
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"DELETE", "/repos/octocat/Hello-World/actions/secrets/GH_TOKEN", 401, http.Header{}, `{
+					"message": "Bad credentials"
+				}`},
+			},
+			s: &SecretsService{
+				client:   c,
+				basePath: "/repos/octocat/Hello-World/actions",
+			},
+			ctx:           context.Background(),
+			secretName:    "GH_TOKEN",
+			expectedError: `DELETE /repos/octocat/Hello-World/actions/secrets/GH_TOKEN: 401 Bad credentials`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"DELETE", "/repos/octocat/Hello-World/actions/secrets/GH_TOKEN", 204, header, ``},
+			},
+			s: &SecretsService{
+				client:   c,
+				basePath: "/repos/octocat/Hello-World/actions",
+			},
+			ctx:        context.Background(),
+			secretName: "GH_TOKEN",
+			expectedResponse: &Response{
+				Rate: expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			defer ts.Close()
+
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			resp, err := tc.s.Delete(tc.ctx, tc.secretName)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestSecretsService_SelectedRepos(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	tests := []struct {
+		name             string
+		mockResponses    []MockResponse
+		s                *SecretsService
+		ctx              context.Context
+		secretName       string
+		pageSize         int
+		pageNo           int
+		expectedRepos    []Repository
+		expectedResponse *Response
+		expectedError    string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &SecretsService{
+				client:   c,
+				basePath: "/orgs/octo-org/actions",
+			},
+			ctx:           nil,
+			secretName:    "GH_TOKEN",
+			pageSize:      10,
+			pageNo:        1,
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"GET", "/orgs/octo-org/actions/secrets/GH_TOKEN/repositories", 401, http.Header{}, `{
+					"message": "Bad credentials"
+				}`},
+			},
+			s: &SecretsService{
+				client:   c,
+				basePath: "/orgs/octo-org/actions",
+			},
+			ctx:           context.Background(),
+			secretName:    "GH_TOKEN",
+			pageSize:      10,
+			pageNo:        1,
+			expectedError: `GET /orgs/octo-org/actions/secrets/GH_TOKEN/repositories: 401 Bad credentials`,
+		},
+		{
+			name: "InvalidResponse",
+			mockResponses: []MockResponse{
+				{"GET", "/orgs/octo-org/actions/secrets/GH_TOKEN/repositories", 200, http.Header{}, `{`},
+			},
+			s: &SecretsService{
+				client:   c,
+				basePath: "/orgs/octo-org/actions",
+			},
+			ctx:           context.Background(),
+			secretName:    "GH_TOKEN",
+			pageSize:      10,
+			pageNo:        1,
+			expectedError: `unexpected EOF`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"GET", "/orgs/octo-org/actions/secrets/GH_TOKEN/repositories", 200, header, selectedReposBody},
+			},
+			s: &SecretsService{
+				client:   c,
+				basePath: "/orgs/octo-org/actions",
+			},
+			ctx:           context.Background(),
+			secretName:    "GH_TOKEN",
+			pageSize:      10,
+			pageNo:        1,
+			expectedRepos: []Repository{repository},
+			expectedResponse: &Response{
+				Pages: expectedPages,
+				Rate:  expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			defer ts.Close()
+
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			repos, resp, err := tc.s.SelectedRepos(tc.ctx, tc.secretName, tc.pageSize, tc.pageNo)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, repos)
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedRepos, repos)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Pages, resp.Pages)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestSecretsService_SetSelectedRepos(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	tests := []struct {
+		name             string
+		mockResponses    []MockResponse
+		s                *SecretsService
+		ctx              context.Context
+		secretName       string
+		repoIDs          []int
+		expectedResponse *Response
+		expectedError    string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &SecretsService{
+				client:   c,
+				basePath: "/orgs/octo-org/actions",
+			},
+			ctx:           nil,
+			secretName:    "GH_TOKEN",
+			repoIDs:       []int{1296269},
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"PUT", "/orgs/octo-org/actions/secrets/GH_TOKEN/repositories", 401, http.Header{}, `{
+					"message": "Bad credentials"
+				}`},
+			},
+			s: &SecretsService{
+				client:   c,
+				basePath: "/orgs/octo-org/actions",
+			},
+			ctx:           context.Background(),
+			secretName:    "GH_TOKEN",
+			repoIDs:       []int{1296269},
+			expectedError: `PUT /orgs/octo-org/actions/secrets/GH_TOKEN/repositories: 401 Bad credentials`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"PUT", "/orgs/octo-org/actions/secrets/GH_TOKEN/repositories", 204, header, ``},
+			},
+			s: &SecretsService{
+				client:   c,
+				basePath: "/orgs/octo-org/actions",
+			},
+			ctx:        context.Background(),
+			secretName: "GH_TOKEN",
+			repoIDs:    []int{1296269},
+			expectedResponse: &Response{
+				Rate: expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			defer ts.Close()
+
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			resp, err := tc.s.SetSelectedRepos(tc.ctx, tc.secretName, tc.repoIDs)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestSecretsService_AddSelectedRepo(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	tests := []struct {
+		name             string
+		mockResponses    []MockResponse
+		s                *SecretsService
+		ctx              context.Context
+		secretName       string
+		repoID           int
+		expectedResponse *Response
+		expectedError    string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &SecretsService{
+				client:   c,
+				basePath: "/orgs/octo-org/actions",
+			},
+			ctx:           nil,
+			secretName:    "GH_TOKEN",
+			repoID:        1296269,
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"PUT", "/orgs/octo-org/actions/secrets/GH_TOKEN/repositories/1296269", 401, http.Header{}, `{
+					"message": "Bad credentials"
+				}`},
+			},
+			s: &SecretsService{
+				client:   c,
+				basePath: "/orgs/octo-org/actions",
+			},
+			ctx:           context.Background(),
+			secretName:    "GH_TOKEN",
+			repoID:        1296269,
+			expectedError: `PUT /orgs/octo-org/actions/secrets/GH_TOKEN/repositories/1296269: 401 Bad credentials`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"PUT", "/orgs/octo-org/actions/secrets/GH_TOKEN/repositories/1296269", 204, header, ``},
+			},
+			s: &SecretsService{
+				client:   c,
+				basePath: "/orgs/octo-org/actions",
+			},
+			ctx:        context.Background(),
+			secretName: "GH_TOKEN",
+			repoID:     1296269,
+			expectedResponse: &Response{
+				Rate: expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			defer ts.Close()
+
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			resp, err := tc.s.AddSelectedRepo(tc.ctx, tc.secretName, tc.repoID)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestSecretsService_RemoveSelectedRepo(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	tests := []struct {
+		name             string
+		mockResponses    []MockResponse
+		s                *SecretsService
+		ctx              context.Context
+		secretName       string
+		repoID           int
+		expectedResponse *Response
+		expectedError    string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &SecretsService{
+				client:   c,
+				basePath: "/orgs/octo-org/actions",
+			},
+			ctx:           nil,
+			secretName:    "GH_TOKEN",
+			repoID:        1296269,
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"DELETE", "/orgs/octo-org/actions/secrets/GH_TOKEN/repositories/1296269", 401, http.Header{}, `{
+					"message": "Bad credentials"
+				}`},
+			},
+			s: &SecretsService{
+				client:   c,
+				basePath: "/orgs/octo-org/actions",
+			},
+			ctx:           context.Background(),
+			secretName:    "GH_TOKEN",
+			repoID:        1296269,
+			expectedError: `DELETE /orgs/octo-org/actions/secrets/GH_TOKEN/repositories/1296269: 401 Bad credentials`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"DELETE", "/orgs/octo-org/actions/secrets/GH_TOKEN/repositories/1296269", 204, header, ``},
+			},
+			s: &SecretsService{
+				client:   c,
+				basePath: "/orgs/octo-org/actions",
+			},
+			ctx:        context.Background(),
+			secretName: "GH_TOKEN",
+			repoID:     1296269,
+			expectedResponse: &Response{
+				Rate: expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			defer ts.Close()
+
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			resp, err := tc.s.RemoveSelectedRepo(tc.ctx, tc.secretName, tc.repoID)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestSecretsService_Put(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	params := SecretParams{
+		Name:  "GH_TOKEN",
+		Value: "s3cr3t",
+	}
+
+	tests := []struct {
+		name               string
+		mockResponses      []MockResponse
+		s                  *SecretsService
+		ctx                context.Context
+		params             SecretParams
+		expectedStatusCode int
+		expectedKey        *SecretsPublicKey
+		expectedError      string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &SecretsService{
+				client:   c,
+				basePath: "/repos/octocat/Hello-World/actions",
+			},
+			ctx:           nil,
+			params:        params,
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "PublicKeyError",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/actions/secrets/public-key", 401, http.Header{}, `{
+					"message": "Bad credentials"
+				}`},
+			},
+			s: &SecretsService{
+				client:   c,
+				basePath: "/repos/octocat/Hello-World/actions",
+			},
+			ctx:           context.Background(),
+			params:        params,
+			expectedError: `GET /repos/octocat/Hello-World/actions/secrets/public-key: 401 Bad credentials`,
+		},
+		{
+			name: "InvalidPublicKey",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/actions/secrets/public-key", 200, http.Header{}, `{
+					"key_id": "012345678912345678",
+					"key": "AAAA"
+				}`},
+			},
+			s: &SecretsService{
+				client:   c,
+				basePath: "/repos/octocat/Hello-World/actions",
+			},
+			ctx:           context.Background(),
+			params:        params,
+			expectedError: `invalid public key 012345678912345678: 3 bytes`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/actions/secrets/public-key", 200, http.Header{}, secretsPublicKeyBody},
+				{"PUT", "/repos/octocat/Hello-World/actions/secrets/GH_TOKEN", 422, http.Header{}, `{
+					"message": "Bad request - key_id is invalid"
+				}`},
+			},
+			s: &SecretsService{
+				client:   c,
+				basePath: "/repos/octocat/Hello-World/actions",
+			},
+			ctx:           context.Background(),
+			params:        params,
+			expectedError: `PUT /repos/octocat/Hello-World/actions/secrets/GH_TOKEN: 422 Bad request - key_id is invalid`,
+		},
+		{
+			name: "Created",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/actions/secrets/public-key", 200, http.Header{}, secretsPublicKeyBody},
+				{"PUT", "/repos/octocat/Hello-World/actions/secrets/GH_TOKEN", 201, header, `{}`},
+			},
+			s: &SecretsService{
+				client:   c,
+				basePath: "/repos/octocat/Hello-World/actions",
+			},
+			ctx:                context.Background(),
+			params:             params,
+			expectedStatusCode: 201,
+			expectedKey:        &secretsPublicKey,
+		},
+		{
+			name: "UpdatedWithCachedKey",
+			mockResponses: []MockResponse{
+				{"PUT", "/orgs/octo-org/actions/secrets/GH_TOKEN", 204, header, ``},
+			},
+			s: &SecretsService{
+				client:   c,
+				basePath: "/orgs/octo-org/actions",
+				key:      &secretsPublicKey,
+			},
+			ctx: context.Background(),
+			params: SecretParams{
+				Name:                  "GH_TOKEN",
+				Value:                 "s3cr3t",
+				Visibility:            SecretVisibilitySelected,
+				SelectedRepositoryIDs: []int{1296269},
+			},
+			expectedStatusCode: 204,
+			expectedKey:        &secretsPublicKey,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			defer ts.Close()
+
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			resp, err := tc.s.Put(tc.ctx, tc.params)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+				assert.Nil(t, tc.s.key)
+			} else {
+				assert.NoError(t, err)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedStatusCode, resp.StatusCode)
+				assert.Equal(t, expectedRate, resp.Rate)
+				assert.Equal(t, tc.expectedKey, tc.s.key)
+			}
+		})
+	}
+}
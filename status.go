@@ -0,0 +1,118 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// StatusState is the state of a GitHub commit status.
+type StatusState string
+
+const (
+	// StatusPending is the state of a pending commit status.
+	StatusPending StatusState = "pending"
+	// StatusSuccess is the state of a successful commit status.
+	StatusSuccess StatusState = "success"
+	// StatusFailure is the state of a failed commit status.
+	StatusFailure StatusState = "failure"
+	// StatusError is the state of an errored commit status.
+	StatusError StatusState = "error"
+)
+
+type (
+	// StatusParams is used for creating a GitHub commit status.
+	// If Context is empty, the status is created for the default context.
+	StatusParams struct {
+		State       StatusState `json:"state"`
+		Context     string      `json:"context,omitempty"`
+		Description string      `json:"description,omitempty"`
+		TargetURL   string      `json:"target_url,omitempty"`
+	}
+
+	// Status is a GitHub commit status object.
+	Status struct {
+		ID          int         `json:"id"`
+		State       StatusState `json:"state"`
+		Context     string      `json:"context"`
+		Description string      `json:"description"`
+		TargetURL   string      `json:"target_url"`
+		AvatarURL   string      `json:"avatar_url"`
+		Creator     User        `json:"creator"`
+		URL         string      `json:"url"`
+		CreatedAt   time.Time   `json:"created_at"`
+		UpdatedAt   time.Time   `json:"updated_at"`
+	}
+
+	// CombinedStatus is the combined status of a commit.
+	// State is failure if any of the contexts report as error or failure,
+	// pending if there are no statuses or a context is pending, and success otherwise.
+	// Statuses only include the latest status for each context.
+	CombinedStatus struct {
+		State      StatusState `json:"state"`
+		SHA        string      `json:"sha"`
+		TotalCount int         `json:"total_count"`
+		Statuses   []Status    `json:"statuses"`
+		CommitURL  string      `json:"commit_url"`
+		URL        string      `json:"url"`
+	}
+)
+
+// CreateStatus creates a new commit status for a commit SHA.
+// See https://docs.github.com/en/rest/commits/statuses#create-a-commit-status
+func (s *RepoService) CreateStatus(ctx context.Context, sha string, params StatusParams) (*Status, *Response, error) {
+	url := fmt.Sprintf("/repos/%s/%s/statuses/%s", s.owner, s.repo, sha)
+	req, err := s.client.NewRequest(ctx, "POST", url, params)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	status := new(Status)
+
+	resp, err := s.client.Do(req, status)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return status, resp, nil
+}
+
+// ListStatuses retrieves all commit statuses for a reference (SHA, branch, or tag) page by page.
+// Statuses are returned in reverse chronological order and may include multiple statuses for the same context.
+// See https://docs.github.com/en/rest/commits/statuses#list-commit-statuses-for-a-reference
+func (s *RepoService) ListStatuses(ctx context.Context, ref string, pageSize, pageNo int) ([]Status, *Response, error) {
+	url := fmt.Sprintf("/repos/%s/%s/commits/%s/statuses", s.owner, s.repo, escapePath(ref))
+	req, err := s.client.NewPageRequest(ctx, "GET", url, pageSize, pageNo, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	statuses := []Status{}
+
+	resp, err := s.client.Do(req, &statuses)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return statuses, resp, nil
+}
+
+// CombinedStatus retrieves the combined status for a reference (SHA, branch, or tag).
+// The latest statuses for each context are returned page by page.
+// See https://docs.github.com/en/rest/commits/statuses#get-the-combined-status-for-a-specific-reference
+func (s *RepoService) CombinedStatus(ctx context.Context, ref string, pageSize, pageNo int) (*CombinedStatus, *Response, error) {
+	url := fmt.Sprintf("/repos/%s/%s/commits/%s/status", s.owner, s.repo, escapePath(ref))
+	req, err := s.client.NewPageRequest(ctx, "GET", url, pageSize, pageNo, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	status := new(CombinedStatus)
+
+	resp, err := s.client.Do(req, status)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return status, resp, nil
+}
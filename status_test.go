@@ -0,0 +1,444 @@
+package github
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	statusBody = `{
+		"id": 1,
+		"state": "success",
+		"context": "continuous-integration/runner",
+		"description": "Build has completed successfully",
+		"target_url": "https://ci.example.com/build/1000",
+		"avatar_url": "https://github.com/images/error/hubot_happy.gif",
+		"creator": {
+			"login": "octocat",
+			"id": 1,
+			"type": "User"
+		},
+		"url": "https://api.github.com/repos/octocat/Hello-World/statuses/6dcb09b5b57875f334f61aebed695e2e4193db5e",
+		"created_at": "2020-10-20T19:59:59Z",
+		"updated_at": "2020-10-20T19:59:59Z"
+	}`
+
+	statusesBody = `[
+		{
+			"id": 1,
+			"state": "success",
+			"context": "continuous-integration/runner",
+			"description": "Build has completed successfully",
+			"target_url": "https://ci.example.com/build/1000",
+			"avatar_url": "https://github.com/images/error/hubot_happy.gif",
+			"creator": {
+				"login": "octocat",
+				"id": 1,
+				"type": "User"
+			},
+			"url": "https://api.github.com/repos/octocat/Hello-World/statuses/6dcb09b5b57875f334f61aebed695e2e4193db5e",
+			"created_at": "2020-10-20T19:59:59Z",
+			"updated_at": "2020-10-20T19:59:59Z"
+		}
+	]`
+
+	combinedStatusBody = `{
+		"state": "success",
+		"sha": "6dcb09b5b57875f334f61aebed695e2e4193db5e",
+		"total_count": 1,
+		"statuses": [
+			{
+				"id": 1,
+				"state": "success",
+				"context": "continuous-integration/runner",
+				"description": "Build has completed successfully",
+				"target_url": "https://ci.example.com/build/1000",
+				"avatar_url": "https://github.com/images/error/hubot_happy.gif",
+				"creator": {
+					"login": "octocat",
+					"id": 1,
+					"type": "User"
+				},
+				"url": "https://api.github.com/repos/octocat/Hello-World/statuses/6dcb09b5b57875f334f61aebed695e2e4193db5e",
+				"created_at": "2020-10-20T19:59:59Z",
+				"updated_at": "2020-10-20T19:59:59Z"
+			}
+		],
+		"commit_url": "https://api.github.com/repos/octocat/Hello-World/6dcb09b5b57875f334f61aebed695e2e4193db5e",
+		"url": "https://api.github.com/repos/octocat/Hello-World/6dcb09b5b57875f334f61aebed695e2e4193db5e/status"
+	}`
+)
+
+var (
+	status = Status{
+		ID:          1,
+		State:       StatusSuccess,
+		Context:     "continuous-integration/runner",
+		Description: "Build has completed successfully",
+		TargetURL:   "https://ci.example.com/build/1000",
+		AvatarURL:   "https://github.com/images/error/hubot_happy.gif",
+		Creator: User{
+			ID:    1,
+			Login: "octocat",
+			Type:  "User",
+		},
+		URL:       "https://api.github.com/repos/octocat/Hello-World/statuses/6dcb09b5b57875f334f61aebed695e2e4193db5e",
+		CreatedAt: parseGitHubTime("2020-10-20T19:59:59Z"),
+		UpdatedAt: parseGitHubTime("2020-10-20T19:59:59Z"),
+	}
+
+	combinedStatus = CombinedStatus{
+		State:      StatusSuccess,
+		SHA:        "6dcb09b5b57875f334f61aebed695e2e4193db5e",
+		TotalCount: 1,
+		Statuses:   []Status{status},
+		CommitURL:  "https://api.github.com/repos/octocat/Hello-World/6dcb09b5b57875f334f61aebed695e2e4193db5e",
+		URL:        "https://api.github.com/repos/octocat/Hello-World/6dcb09b5b57875f334f61aebed695e2e4193db5e/status",
+	}
+)
+
+func TestRepoService_CreateStatus(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	params := StatusParams{
+		State:       StatusSuccess,
+		Context:     "continuous-integration/runner",
+		Description: "Build has completed successfully",
+		TargetURL:   "https://ci.example.com/build/1000",
+	}
+
+	tests := []struct {
+		name             string
+		mockResponses    []MockResponse
+		s                *RepoService
+		ctx              context.Context
+		sha              string
+		params           StatusParams
+		expectedStatus   *Status
+		expectedResponse *Response
+		expectedError    string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           nil,
+			sha:           "6dcb09b5b57875f334f61aebed695e2e4193db5e",
+			params:        params,
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"POST", "/repos/octocat/Hello-World/statuses/6dcb09b5b57875f334f61aebed695e2e4193db5e", 422, http.Header{}, `{
+					"message": "Validation Failed"
+				}`},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			sha:           "6dcb09b5b57875f334f61aebed695e2e4193db5e",
+			params:        params,
+			expectedError: `POST /repos/octocat/Hello-World/statuses/6dcb09b5b57875f334f61aebed695e2e4193db5e: 422 Validation Failed`,
+		},
+		{
+			name: "InvalidResponse",
+			mockResponses: []MockResponse{
+				{"POST", "/repos/octocat/Hello-World/statuses/6dcb09b5b57875f334f61aebed695e2e4193db5e", 201, http.Header{}, `{`},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			sha:           "6dcb09b5b57875f334f61aebed695e2e4193db5e",
+			params:        params,
+			expectedError: `unexpected EOF`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"POST", "/repos/octocat/Hello-World/statuses/6dcb09b5b57875f334f61aebed695e2e4193db5e", 201, header, statusBody},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:            context.Background(),
+			sha:            "6dcb09b5b57875f334f61aebed695e2e4193db5e",
+			params:         params,
+			expectedStatus: &status,
+			expectedResponse: &Response{
+				Rate: expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			defer ts.Close()
+
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			status, resp, err := tc.s.CreateStatus(tc.ctx, tc.sha, tc.params)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, status)
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedStatus, status)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestRepoService_ListStatuses(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	tests := []struct {
+		name             string
+		mockResponses    []MockResponse
+		s                *RepoService
+		ctx              context.Context
+		ref              string
+		pageSize         int
+		pageNo           int
+		expectedStatuses []Status
+		expectedResponse *Response
+		expectedError    string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           nil,
+			ref:           "main",
+			pageSize:      10,
+			pageNo:        1,
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/commits/main/statuses", 401, http.Header{}, `{
+					"message": "Bad credentials"
+				}`},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			ref:           "main",
+			pageSize:      10,
+			pageNo:        1,
+			expectedError: `GET /repos/octocat/Hello-World/commits/main/statuses: 401 Bad credentials`,
+		},
+		{
+			name: "InvalidResponse",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/commits/main/statuses", 200, http.Header{}, `{`},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			ref:           "main",
+			pageSize:      10,
+			pageNo:        1,
+			expectedError: `unexpected EOF`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/commits/main/statuses", 200, header, statusesBody},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:              context.Background(),
+			ref:              "main",
+			pageSize:         10,
+			pageNo:           1,
+			expectedStatuses: []Status{status},
+			expectedResponse: &Response{
+				Pages: expectedPages,
+				Rate:  expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			defer ts.Close()
+
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			statuses, resp, err := tc.s.ListStatuses(tc.ctx, tc.ref, tc.pageSize, tc.pageNo)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, statuses)
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedStatuses, statuses)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Pages, resp.Pages)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestRepoService_CombinedStatus(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	tests := []struct {
+		name             string
+		mockResponses    []MockResponse
+		s                *RepoService
+		ctx              context.Context
+		ref              string
+		pageSize         int
+		pageNo           int
+		expectedStatus   *CombinedStatus
+		expectedResponse *Response
+		expectedError    string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           nil,
+			ref:           "main",
+			pageSize:      100,
+			pageNo:        1,
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/commits/main/status", 401, http.Header{}, `{
+					"message": "Bad credentials"
+				}`},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			ref:           "main",
+			pageSize:      100,
+			pageNo:        1,
+			expectedError: `GET /repos/octocat/Hello-World/commits/main/status: 401 Bad credentials`,
+		},
+		{
+			name: "InvalidResponse",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/commits/main/status", 200, http.Header{}, `{`},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			ref:           "main",
+			pageSize:      100,
+			pageNo:        1,
+			expectedError: `unexpected EOF`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/commits/main/status", 200, header, combinedStatusBody},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:            context.Background(),
+			ref:            "main",
+			pageSize:       100,
+			pageNo:         1,
+			expectedStatus: &combinedStatus,
+			expectedResponse: &Response{
+				Pages: expectedPages,
+				Rate:  expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			defer ts.Close()
+
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			status, resp, err := tc.s.CombinedStatus(tc.ctx, tc.ref, tc.pageSize, tc.pageNo)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, status)
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedStatus, status)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Pages, resp.Pages)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
@@ -66,3 +66,21 @@ func (s *UserService) Get(ctx context.Context, username string) (*User, *Respons
 
 	return user, resp, nil
 }
+
+// CreateRepo creates a new repository for the authenticated user.
+// See https://docs.github.com/en/rest/repos/repos#create-a-repository-for-the-authenticated-user
+func (s *UserService) CreateRepo(ctx context.Context, params CreateRepoParams) (*Repository, *Response, error) {
+	req, err := s.client.NewRequest(ctx, "POST", "/user/repos", params)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	repository := new(Repository)
+
+	resp, err := s.client.Do(req, repository)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return repository, resp, nil
+}
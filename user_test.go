@@ -220,3 +220,103 @@ func TestUserService_Get(t *testing.T) {
 		})
 	}
 }
+
+func TestUserService_CreateRepo(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	params := CreateRepoParams{
+		Name:        "Hello-World",
+		Description: "This your first repo!",
+		Visibility:  VisibilityPublic,
+	}
+
+	tests := []struct {
+		name               string
+		mockResponses      []MockResponse
+		s                  *UserService
+		ctx                context.Context
+		params             CreateRepoParams
+		expectedRepository *Repository
+		expectedResponse   *Response
+		expectedError      string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &UserService{
+				client: c,
+			},
+			ctx:           nil,
+			params:        params,
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"POST", "/user/repos", 401, http.Header{}, `{
+					"message": "Bad credentials"
+				}`},
+			},
+			s: &UserService{
+				client: c,
+			},
+			ctx:           context.Background(),
+			params:        params,
+			expectedError: `POST /user/repos: 401 Bad credentials`,
+		},
+		{
+			name: "InvalidResponse",
+			mockResponses: []MockResponse{
+				{"POST", "/user/repos", 201, http.Header{}, `{`},
+			},
+			s: &UserService{
+				client: c,
+			},
+			ctx:           context.Background(),
+			params:        params,
+			expectedError: `unexpected EOF`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"POST", "/user/repos", 201, header, repositoryBody},
+			},
+			s: &UserService{
+				client: c,
+			},
+			ctx:                context.Background(),
+			params:             params,
+			expectedRepository: &repository,
+			expectedResponse: &Response{
+				Rate: expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			defer ts.Close()
+
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			repository, resp, err := tc.s.CreateRepo(tc.ctx, tc.params)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, repository)
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedRepository, repository)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
@@ -0,0 +1,398 @@
+package github
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+// maxVariablesPageSize is the maximum page size GitHub accepts for listing variables.
+const maxVariablesPageSize = 30
+
+// VariablesService provides GitHub APIs for GitHub Actions variables in a repository, an environment, or an organization.
+// See https://docs.github.com/en/rest/actions/variables
+type VariablesService struct {
+	client   *Client
+	basePath string
+}
+
+type (
+	// Variable is a GitHub Actions variable object.
+	Variable struct {
+		Name                    string           `json:"name"`
+		Value                   string           `json:"value"`
+		Visibility              SecretVisibility `json:"visibility,omitempty"`                // Only for organization variables
+		SelectedRepositoriesURL string           `json:"selected_repositories_url,omitempty"` // Only for organization variables
+		CreatedAt               time.Time        `json:"created_at"`
+		UpdatedAt               time.Time        `json:"updated_at"`
+	}
+
+	// VariableParams is used for creating or updating a GitHub Actions variable.
+	VariableParams struct {
+		Name  string `json:"name"`
+		Value string `json:"value"`
+		// Visibility and SelectedRepositoryIDs are only for organization variables.
+		Visibility            SecretVisibility `json:"visibility,omitempty"`
+		SelectedRepositoryIDs []int            `json:"selected_repository_ids,omitempty"`
+	}
+)
+
+// EnvironmentVariables returns a service providing GitHub APIs for the variables of a deployment environment in the repository.
+func (s *RepoService) EnvironmentVariables(environment string) *VariablesService {
+	return &VariablesService{
+		client:   s.client,
+		basePath: fmt.Sprintf("/repos/%s/%s/environments/%s", s.owner, s.repo, escapePath(environment)),
+	}
+}
+
+// VariableAction is the action planned for a variable when syncing variables.
+type VariableAction string
+
+const (
+	// VariableCreate means the variable does not exist and will be created.
+	VariableCreate VariableAction = "create"
+	// VariableUpdate means the variable exists with a different value and will be updated.
+	VariableUpdate VariableAction = "update"
+	// VariableDelete means the variable exists but is not desired and will be deleted.
+	VariableDelete VariableAction = "delete"
+)
+
+type (
+	// VariableChange is a planned change to a variable.
+	VariableChange struct {
+		Action   VariableAction `json:"action"`
+		Name     string         `json:"name"`
+		OldValue string         `json:"old_value,omitempty"`
+		NewValue string         `json:"new_value,omitempty"`
+		Applied  bool           `json:"applied"`
+	}
+
+	// VariablesPlan is the set of changes for syncing variables with a desired state.
+	VariablesPlan struct {
+		Changes   []VariableChange `json:"changes"`
+		Unchanged []string         `json:"unchanged"`
+	}
+)
+
+// Empty determines whether or not the plan has any changes.
+func (p *VariablesPlan) Empty() bool {
+	return len(p.Changes) == 0
+}
+
+// String returns a human-readable diff of the plan.
+func (p *VariablesPlan) String() string {
+	if p.Empty() {
+		return "No changes"
+	}
+
+	var b strings.Builder
+	for _, c := range p.Changes {
+		switch c.Action {
+		case VariableCreate:
+			fmt.Fprintf(&b, "+ %s = %q\n", c.Name, c.NewValue)
+		case VariableUpdate:
+			fmt.Fprintf(&b, "~ %s = %q -> %q\n", c.Name, c.OldValue, c.NewValue)
+		case VariableDelete:
+			fmt.Fprintf(&b, "- %s = %q\n", c.Name, c.OldValue)
+		}
+	}
+
+	return strings.TrimSuffix(b.String(), "\n")
+}
+
+// VariablesSyncOptions are used for syncing variables with a desired state.
+type VariablesSyncOptions struct {
+	// DryRun only computes the plan without applying it.
+	DryRun bool
+	// Visibility is the visibility of the created variables.
+	// It is required for organization variables and must be empty otherwise.
+	Visibility SecretVisibility
+}
+
+// planVariables computes the changes for turning the current variables into the desired variables.
+// Variable names are case-insensitive and GitHub stores them in uppercase,
+// so the names are compared and reported in uppercase.
+// The changes are sorted by name.
+func planVariables(current []Variable, desired map[string]string) *VariablesPlan {
+	plan := &VariablesPlan{
+		Changes:   []VariableChange{},
+		Unchanged: []string{},
+	}
+
+	existing := map[string]string{}
+	for _, v := range current {
+		existing[strings.ToUpper(v.Name)] = v.Value
+	}
+
+	desired = normalizeVariables(desired)
+
+	for name, value := range desired {
+		if old, ok := existing[name]; !ok {
+			plan.Changes = append(plan.Changes, VariableChange{Action: VariableCreate, Name: name, NewValue: value})
+		} else if old != value {
+			plan.Changes = append(plan.Changes, VariableChange{Action: VariableUpdate, Name: name, OldValue: old, NewValue: value})
+		} else {
+			plan.Unchanged = append(plan.Unchanged, name)
+		}
+	}
+
+	for name, old := range existing {
+		if _, ok := desired[name]; !ok {
+			plan.Changes = append(plan.Changes, VariableChange{Action: VariableDelete, Name: name, OldValue: old})
+		}
+	}
+
+	sort.Slice(plan.Changes, func(i, j int) bool {
+		return plan.Changes[i].Name < plan.Changes[j].Name
+	})
+	sort.Strings(plan.Unchanged)
+
+	return plan
+}
+
+// normalizeVariables returns a copy of a set of variables with the names in uppercase.
+func normalizeVariables(vars map[string]string) map[string]string {
+	normalized := make(map[string]string, len(vars))
+	for name, value := range vars {
+		normalized[strings.ToUpper(name)] = value
+	}
+
+	return normalized
+}
+
+// List retrieves all variables page by page.
+// See https://docs.github.com/en/rest/actions/variables#list-repository-variables
+// See https://docs.github.com/en/rest/actions/variables#list-environment-variables
+// See https://docs.github.com/en/rest/actions/variables#list-organization-variables
+func (s *VariablesService) List(ctx context.Context, pageSize, pageNo int) ([]Variable, *Response, error) {
+	url := s.basePath + "/variables"
+	req, err := s.client.NewPageRequest(ctx, "GET", url, pageSize, pageNo, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	body := new(struct {
+		TotalCount int        `json:"total_count"`
+		Variables  []Variable `json:"variables"`
+	})
+
+	resp, err := s.client.Do(req, body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return body.Variables, resp, nil
+}
+
+// Get retrieves a variable by its name.
+// See https://docs.github.com/en/rest/actions/variables#get-a-repository-variable
+// See https://docs.github.com/en/rest/actions/variables#get-an-environment-variable
+// See https://docs.github.com/en/rest/actions/variables#get-an-organization-variable
+func (s *VariablesService) Get(ctx context.Context, name string) (*Variable, *Response, error) {
+	url := fmt.Sprintf("%s/variables/%s", s.basePath, name)
+	req, err := s.client.NewRequest(ctx, "GET", url, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	variable := new(Variable)
+
+	resp, err := s.client.Do(req, variable)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return variable, resp, nil
+}
+
+// Create creates a new variable.
+// See https://docs.github.com/en/rest/actions/variables#create-a-repository-variable
+// See https://docs.github.com/en/rest/actions/variables#create-an-environment-variable
+// See https://docs.github.com/en/rest/actions/variables#create-an-organization-variable
+func (s *VariablesService) Create(ctx context.Context, params VariableParams) (*Response, error) {
+	url := s.basePath + "/variables"
+	req, err := s.client.NewRequest(ctx, "POST", url, params)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// Update updates a variable by its name.
+// If the name in params is different, the variable is renamed.
+// See https://docs.github.com/en/rest/actions/variables#update-a-repository-variable
+// See https://docs.github.com/en/rest/actions/variables#update-an-environment-variable
+// See https://docs.github.com/en/rest/actions/variables#update-an-organization-variable
+func (s *VariablesService) Update(ctx context.Context, name string, params VariableParams) (*Response, error) {
+	url := fmt.Sprintf("%s/variables/%s", s.basePath, name)
+	req, err := s.client.NewRequest(ctx, "PATCH", url, params)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// Delete deletes a variable by its name.
+// See https://docs.github.com/en/rest/actions/variables#delete-a-repository-variable
+// See https://docs.github.com/en/rest/actions/variables#delete-an-environment-variable
+// See https://docs.github.com/en/rest/actions/variables#delete-an-organization-variable
+func (s *VariablesService) Delete(ctx context.Context, name string) (*Response, error) {
+	url := fmt.Sprintf("%s/variables/%s", s.basePath, name)
+	req, err := s.client.NewRequest(ctx, "DELETE", url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// SelectedRepos retrieves all repositories that can access an organization variable with the selected visibility page by page.
+// See https://docs.github.com/en/rest/actions/variables#list-selected-repositories-for-an-organization-variable
+func (s *VariablesService) SelectedRepos(ctx context.Context, name string, pageSize, pageNo int) ([]Repository, *Response, error) {
+	url := fmt.Sprintf("%s/variables/%s/repositories", s.basePath, name)
+	req, err := s.client.NewPageRequest(ctx, "GET", url, pageSize, pageNo, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	body := new(struct {
+		TotalCount   int          `json:"total_count"`
+		Repositories []Repository `json:"repositories"`
+	})
+
+	resp, err := s.client.Do(req, body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return body.Repositories, resp, nil
+}
+
+// SetSelectedRepos replaces all repositories that can access an organization variable with the selected visibility.
+// See https://docs.github.com/en/rest/actions/variables#set-selected-repositories-for-an-organization-variable
+func (s *VariablesService) SetSelectedRepos(ctx context.Context, name string, repoIDs []int) (*Response, error) {
+	url := fmt.Sprintf("%s/variables/%s/repositories", s.basePath, name)
+	body := struct {
+		SelectedRepositoryIDs []int `json:"selected_repository_ids"`
+	}{
+		SelectedRepositoryIDs: repoIDs,
+	}
+
+	if body.SelectedRepositoryIDs == nil {
+		body.SelectedRepositoryIDs = []int{}
+	}
+
+	req, err := s.client.NewRequest(ctx, "PUT", url, body)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// AddSelectedRepo adds a repository to the repositories that can access an organization variable with the selected visibility.
+// See https://docs.github.com/en/rest/actions/variables#add-selected-repository-to-an-organization-variable
+func (s *VariablesService) AddSelectedRepo(ctx context.Context, name string, repoID int) (*Response, error) {
+	url := fmt.Sprintf("%s/variables/%s/repositories/%d", s.basePath, name, repoID)
+	req, err := s.client.NewRequest(ctx, "PUT", url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// RemoveSelectedRepo removes a repository from the repositories that can access an organization variable with the selected visibility.
+// See https://docs.github.com/en/rest/actions/variables#remove-selected-repository-from-an-organization-variable
+func (s *VariablesService) RemoveSelectedRepo(ctx context.Context, name string, repoID int) (*Response, error) {
+	url := fmt.Sprintf("%s/variables/%s/repositories/%d", s.basePath, name, repoID)
+	req, err := s.client.NewRequest(ctx, "DELETE", url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// Sync makes the variables match a desired set of names and values.
+// It creates the missing variables, updates the variables with different values, and deletes the variables not desired.
+// The plan is computed against the current variables and returned even if applying it fails,
+// so the changes applied so far can be reported. With the DryRun option, the plan is only computed.
+// Variable names are case-insensitive, so desired names that only differ in case are rejected.
+func (s *VariablesService) Sync(ctx context.Context, desired map[string]string, opts VariablesSyncOptions) (*VariablesPlan, error) {
+	if normalized := normalizeVariables(desired); len(normalized) != len(desired) {
+		return nil, errors.New("desired variable names must be unique regardless of case")
+	}
+
+	current := []Variable{}
+	for pageNo := 1; pageNo != 0; {
+		page, resp, err := s.List(ctx, maxVariablesPageSize, pageNo)
+		if err != nil {
+			return nil, err
+		}
+		current = append(current, page...)
+		pageNo = resp.Pages.Next
+	}
+
+	plan := planVariables(current, desired)
+	if opts.DryRun {
+		return plan, nil
+	}
+
+	for i := range plan.Changes {
+		c := &plan.Changes[i]
+		var err error
+
+		switch c.Action {
+		case VariableCreate:
+			_, err = s.Create(ctx, VariableParams{Name: c.Name, Value: c.NewValue, Visibility: opts.Visibility})
+		case VariableUpdate:
+			_, err = s.Update(ctx, c.Name, VariableParams{Name: c.Name, Value: c.NewValue})
+		case VariableDelete:
+			_, err = s.Delete(ctx, c.Name)
+		}
+
+		if err != nil {
+			return plan, fmt.Errorf("%s %s: %w", c.Action, c.Name, err)
+		}
+
+		c.Applied = true
+	}
+
+	return plan, nil
+}
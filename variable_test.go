@@ -0,0 +1,1172 @@
+package github
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	variableBody = `{
+		"name": "USERNAME",
+		"value": "octocat",
+		"created_at": "2019-08-10T14:59:22Z",
+		"updated_at": "2020-01-10T14:59:22Z"
+	}`
+
+	variablesBody = `{
+		"total_count": 1,
+		"variables": [` + variableBody + `]
+	}`
+
+	syncVariablesBody = `{
+		"total_count": 3,
+		"variables": [
+			{
+				"name": "ENVIRONMENT",
+				"value": "staging",
+				"created_at": "2019-08-10T14:59:22Z",
+				"updated_at": "2020-01-10T14:59:22Z"
+			},
+			{
+				"name": "LEGACY",
+				"value": "true",
+				"created_at": "2019-08-10T14:59:22Z",
+				"updated_at": "2020-01-10T14:59:22Z"
+			},
+			{
+				"name": "USERNAME",
+				"value": "octocat",
+				"created_at": "2019-08-10T14:59:22Z",
+				"updated_at": "2020-01-10T14:59:22Z"
+			}
+		]
+	}`
+)
+
+var (
+	variable = Variable{
+		Name:      "USERNAME",
+		Value:     "octocat",
+		CreatedAt: parseGitHubTime("2019-08-10T14:59:22Z"),
+		UpdatedAt: parseGitHubTime("2020-01-10T14:59:22Z"),
+	}
+
+	desiredVariables = map[string]string{
+		"ENVIRONMENT": "production",
+		"REGION":      "us-east-1",
+		"USERNAME":    "octocat",
+	}
+)
+
+func TestRepoService_EnvironmentVariables(t *testing.T) {
+	c := &Client{}
+	s := &RepoService{
+		client: c,
+		owner:  "octocat",
+		repo:   "Hello-World",
+	}
+
+	variables := s.EnvironmentVariables("production")
+
+	assert.NotNil(t, variables)
+	assert.Equal(t, c, variables.client)
+	assert.Equal(t, "/repos/octocat/Hello-World/environments/production", variables.basePath)
+}
+
+func TestPlanVariables(t *testing.T) {
+	tests := []struct {
+		name         string
+		current      []Variable
+		desired      map[string]string
+		expectedPlan *VariablesPlan
+	}{
+		{
+			name:    "NoChanges",
+			current: []Variable{variable},
+			desired: map[string]string{"USERNAME": "octocat"},
+			expectedPlan: &VariablesPlan{
+				Changes:   []VariableChange{},
+				Unchanged: []string{"USERNAME"},
+			},
+		},
+		{
+			name: "Changes",
+			current: []Variable{
+				{Name: "USERNAME", Value: "octocat"},
+				{Name: "LEGACY", Value: "true"},
+				{Name: "ENVIRONMENT", Value: "staging"},
+			},
+			desired: desiredVariables,
+			expectedPlan: &VariablesPlan{
+				Changes: []VariableChange{
+					{Action: VariableUpdate, Name: "ENVIRONMENT", OldValue: "staging", NewValue: "production"},
+					{Action: VariableDelete, Name: "LEGACY", OldValue: "true"},
+					{Action: VariableCreate, Name: "REGION", NewValue: "us-east-1"},
+				},
+				Unchanged: []string{"USERNAME"},
+			},
+		},
+		{
+			name: "CaseInsensitive",
+			current: []Variable{
+				{Name: "USERNAME", Value: "octocat"},
+				{Name: "ENVIRONMENT", Value: "staging"},
+			},
+			desired: map[string]string{
+				"username":    "octocat",
+				"Environment": "production",
+				"region":      "us-east-1",
+			},
+			expectedPlan: &VariablesPlan{
+				Changes: []VariableChange{
+					{Action: VariableUpdate, Name: "ENVIRONMENT", OldValue: "staging", NewValue: "production"},
+					{Action: VariableCreate, Name: "REGION", NewValue: "us-east-1"},
+				},
+				Unchanged: []string{"USERNAME"},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			plan := planVariables(tc.current, tc.desired)
+			assert.Equal(t, tc.expectedPlan, plan)
+		})
+	}
+}
+
+func TestVariablesPlan_Empty(t *testing.T) {
+	tests := []struct {
+		name          string
+		p             *VariablesPlan
+		expectedEmpty bool
+	}{
+		{
+			name:          "Empty",
+			p:             &VariablesPlan{},
+			expectedEmpty: true,
+		},
+		{
+			name: "NotEmpty",
+			p: &VariablesPlan{
+				Changes: []VariableChange{
+					{Action: VariableCreate, Name: "REGION", NewValue: "us-east-1"},
+				},
+			},
+			expectedEmpty: false,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expectedEmpty, tc.p.Empty())
+		})
+	}
+}
+
+func TestVariablesPlan_String(t *testing.T) {
+	tests := []struct {
+		name           string
+		p              *VariablesPlan
+		expectedString string
+	}{
+		{
+			name: "NoChanges",
+			p: &VariablesPlan{
+				Unchanged: []string{"USERNAME"},
+			},
+			expectedString: "No changes",
+		},
+		{
+			name: "Changes",
+			p: &VariablesPlan{
+				Changes: []VariableChange{
+					{Action: VariableUpdate, Name: "ENVIRONMENT", OldValue: "staging", NewValue: "production"},
+					{Action: VariableDelete, Name: "LEGACY", OldValue: "true"},
+					{Action: VariableCreate, Name: "REGION", NewValue: "us-east-1"},
+				},
+			},
+			expectedString: `~ ENVIRONMENT = "staging" -> "production"
+- LEGACY = "true"
++ REGION = "us-east-1"`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expectedString, tc.p.String())
+		})
+	}
+}
+
+func TestVariablesService_List(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	tests := []struct {
+		name              string
+		mockResponses     []MockResponse
+		s                 *VariablesService
+		ctx               context.Context
+		pageSize          int
+		pageNo            int
+		expectedVariables []Variable
+		expectedResponse  *Response
+		expectedError     string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &VariablesService{
+				client:   c,
+				basePath: "/repos/octocat/Hello-World/actions",
+			},
+			ctx:           nil,
+			pageSize:      10,
+			pageNo:        1,
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/actions/variables", 401, http.Header{}, `{
+					"message": "Bad credentials"
+				}`},
+			},
+			s: &VariablesService{
+				client:   c,
+				basePath: "/repos/octocat/Hello-World/actions",
+			},
+			ctx:           context.Background(),
+			pageSize:      10,
+			pageNo:        1,
+			expectedError: `GET /repos/octocat/Hello-World/actions/variables: 401 Bad credentials`,
+		},
+		{
+			name: "InvalidResponse",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/actions/variables", 200, http.Header{}, `{`},
+			},
+			s: &VariablesService{
+				client:   c,
+				basePath: "/repos/octocat/Hello-World/actions",
+			},
+			ctx:           context.Background(),
+			pageSize:      10,
+			pageNo:        1,
+			expectedError: `unexpected EOF`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/actions/variables", 200, header, variablesBody},
+			},
+			s: &VariablesService{
+				client:   c,
+				basePath: "/repos/octocat/Hello-World/actions",
+			},
+			ctx:               context.Background(),
+			pageSize:          10,
+			pageNo:            1,
+			expectedVariables: []Variable{variable},
+			expectedResponse: &Response{
+				Pages: expectedPages,
+				Rate:  expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			defer ts.Close()
+
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			variables, resp, err := tc.s.List(tc.ctx, tc.pageSize, tc.pageNo)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, variables)
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedVariables, variables)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Pages, resp.Pages)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestVariablesService_Get(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	tests := []struct {
+		name             string
+		mockResponses    []MockResponse
+		s                *VariablesService
+		ctx              context.Context
+		variableName     string
+		expectedVariable *Variable
+		expectedResponse *Response
+		expectedError    string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &VariablesService{
+				client:   c,
+				basePath: "/repos/octocat/Hello-World/actions",
+			},
+			ctx:           nil,
+			variableName:  "USERNAME",
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/actions/variables/USERNAME", 401, http.Header{}, `{
+					"message": "Bad credentials"
+				}`},
+			},
+			s: &VariablesService{
+				client:   c,
+				basePath: "/repos/octocat/Hello-World/actions",
+			},
+			ctx:           context.Background(),
+			variableName:  "USERNAME",
+			expectedError: `GET /repos/octocat/Hello-World/actions/variables/USERNAME: 401 Bad credentials`,
+		},
+		{
+			name: "InvalidResponse",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/actions/variables/USERNAME", 200, http.Header{}, `{`},
+			},
+			s: &VariablesService{
+				client:   c,
+				basePath: "/repos/octocat/Hello-World/actions",
+			},
+			ctx:           context.Background(),
+			variableName:  "USERNAME",
+			expectedError: `unexpected EOF`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/actions/variables/USERNAME", 200, header, variableBody},
+			},
+			s: &VariablesService{
+				client:   c,
+				basePath: "/repos/octocat/Hello-World/actions",
+			},
+			ctx:              context.Background(),
+			variableName:     "USERNAME",
+			expectedVariable: &variable,
+			expectedResponse: &Response{
+				Rate: expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			defer ts.Close()
+
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			v, resp, err := tc.s.Get(tc.ctx, tc.variableName)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, v)
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedVariable, v)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestVariablesService_Create(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	tests := []struct {
+		name             string
+		mockResponses    []MockResponse
+		s                *VariablesService
+		ctx              context.Context
+		params           VariableParams
+		expectedResponse *Response
+		expectedError    string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &VariablesService{
+				client:   c,
+				basePath: "/repos/octocat/Hello-World/actions",
+			},
+			ctx:           nil,
+			params:        VariableParams{Name: "USERNAME", Value: "octocat"},
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"POST", "/repos/octocat/Hello-World/actions/variables", 401, http.Header{}, `{
+					"message": "Bad credentials"
+				}`},
+			},
+			s: &VariablesService{
+				client:   c,
+				basePath: "/repos/octocat/Hello-World/actions",
+			},
+			ctx:           context.Background(),
+			params:        VariableParams{Name: "USERNAME", Value: "octocat"},
+			expectedError: `POST /repos/octocat/Hello-World/actions/variables: 401 Bad credentials`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"POST", "/repos/octocat/Hello-World/actions/variables", 201, header, `{}`},
+			},
+			s: &VariablesService{
+				client:   c,
+				basePath: "/repos/octocat/Hello-World/actions",
+			},
+			ctx:    context.Background(),
+			params: VariableParams{Name: "USERNAME", Value: "octocat"},
+			expectedResponse: &Response{
+				Rate: expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			defer ts.Close()
+
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			resp, err := tc.s.Create(tc.ctx, tc.params)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestVariablesService_Update(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	tests := []struct {
+		name             string
+		mockResponses    []MockResponse
+		s                *VariablesService
+		ctx              context.Context
+		variableName     string
+		params           VariableParams
+		expectedResponse *Response
+		expectedError    string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &VariablesService{
+				client:   c,
+				basePath: "/repos/octocat/Hello-World/actions",
+			},
+			ctx:           nil,
+			variableName:  "USERNAME",
+			params:        VariableParams{Name: "USERNAME", Value: "monalisa"},
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"PATCH", "/repos/octocat/Hello-World/actions/variables/USERNAME", 401, http.Header{}, `{
+					"message": "Bad credentials"
+				}`},
+			},
+			s: &VariablesService{
+				client:   c,
+				basePath: "/repos/octocat/Hello-World/actions",
+			},
+			ctx:           context.Background(),
+			variableName:  "USERNAME",
+			params:        VariableParams{Name: "USERNAME", Value: "monalisa"},
+			expectedError: `PATCH /repos/octocat/Hello-World/actions/variables/USERNAME: 401 Bad credentials`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"PATCH", "/repos/octocat/Hello-World/actions/variables/USERNAME", 204, header, ``},
+			},
+			s: &VariablesService{
+				client:   c,
+				basePath: "/repos/octocat/Hello-World/actions",
+			},
+			ctx:          context.Background(),
+			variableName: "USERNAME",
+			params:       VariableParams{Name: "USERNAME", Value: "monalisa"},
+			expectedResponse: &Response{
+				Rate: expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			defer ts.Close()
+
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			resp, err := tc.s.Update(tc.ctx, tc.variableName, tc.params)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestVariablesService_Delete(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	tests := []struct {
+		name             string
+		mockResponses    []MockResponse
+		s                *VariablesService
+		ctx              context.Context
+		variableName     string
+		expectedResponse *Response
+		expectedError    string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &VariablesService{
+				client:   c,
+				basePath: "/repos/octocat/Hello-World/actions",
+			},
+			ctx:           nil,
+			variableName:  "USERNAME",
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"DELETE", "/repos/octocat/Hello-World/actions/variables/USERNAME", 401, http.Header{}, `{
+					"message": "Bad credentials"
+				}`},
+			},
+			s: &VariablesService{
+				client:   c,
+				basePath: "/repos/octocat/Hello-World/actions",
+			},
+			ctx:           context.Background(),
+			variableName:  "USERNAME",
+			expectedError: `DELETE /repos/octocat/Hello-World/actions/variables/USERNAME: 401 Bad credentials`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"DELETE", "/repos/octocat/Hello-World/actions/variables/USERNAME", 204, header, ``},
+			},
+			s: &VariablesService{
+				client:   c,
+				basePath: "/repos/octocat/Hello-World/actions",
+			},
+			ctx:          context.Background(),
+			variableName: "USERNAME",
+			expectedResponse: &Response{
+				Rate: expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			defer ts.Close()
+
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			resp, err := tc.s.Delete(tc.ctx, tc.variableName)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestVariablesService_SelectedRepos(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	tests := []struct {
+		name             string
+		mockResponses    []MockResponse
+		s                *VariablesService
+		ctx              context.Context
+		variableName     string
+		pageSize         int
+		pageNo           int
+		expectedRepos    []Repository
+		expectedResponse *Response
+		expectedError    string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &VariablesService{
+				client:   c,
+				basePath: "/orgs/octo-org/actions",
+			},
+			ctx:           nil,
+			variableName:  "USERNAME",
+			pageSize:      10,
+			pageNo:        1,
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"GET", "/orgs/octo-org/actions/variables/USERNAME/repositories", 401, http.Header{}, `{
+					"message": "Bad credentials"
+				}`},
+			},
+			s: &VariablesService{
+				client:   c,
+				basePath: "/orgs/octo-org/actions",
+			},
+			ctx:           context.Background(),
+			variableName:  "USERNAME",
+			pageSize:      10,
+			pageNo:        1,
+			expectedError: `GET /orgs/octo-org/actions/variables/USERNAME/repositories: 401 Bad credentials`,
+		},
+		{
+			name: "InvalidResponse",
+			mockResponses: []MockResponse{
+				{"GET", "/orgs/octo-org/actions/variables/USERNAME/repositories", 200, http.Header{}, `{`},
+			},
+			s: &VariablesService{
+				client:   c,
+				basePath: "/orgs/octo-org/actions",
+			},
+			ctx:           context.Background(),
+			variableName:  "USERNAME",
+			pageSize:      10,
+			pageNo:        1,
+			expectedError: `unexpected EOF`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"GET", "/orgs/octo-org/actions/variables/USERNAME/repositories", 200, header, selectedReposBody},
+			},
+			s: &VariablesService{
+				client:   c,
+				basePath: "/orgs/octo-org/actions",
+			},
+			ctx:           context.Background(),
+			variableName:  "USERNAME",
+			pageSize:      10,
+			pageNo:        1,
+			expectedRepos: []Repository{repository},
+			expectedResponse: &Response{
+				Pages: expectedPages,
+				Rate:  expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			defer ts.Close()
+
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			repos, resp, err := tc.s.SelectedRepos(tc.ctx, tc.variableName, tc.pageSize, tc.pageNo)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, repos)
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedRepos, repos)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Pages, resp.Pages)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestVariablesService_SetSelectedRepos(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	tests := []struct {
+		name             string
+		mockResponses    []MockResponse
+		s                *VariablesService
+		ctx              context.Context
+		variableName     string
+		repoIDs          []int
+		expectedResponse *Response
+		expectedError    string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &VariablesService{
+				client:   c,
+				basePath: "/orgs/octo-org/actions",
+			},
+			ctx:           nil,
+			variableName:  "USERNAME",
+			repoIDs:       []int{1296269},
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"PUT", "/orgs/octo-org/actions/variables/USERNAME/repositories", 401, http.Header{}, `{
+					"message": "Bad credentials"
+				}`},
+			},
+			s: &VariablesService{
+				client:   c,
+				basePath: "/orgs/octo-org/actions",
+			},
+			ctx:           context.Background(),
+			variableName:  "USERNAME",
+			repoIDs:       []int{1296269},
+			expectedError: `PUT /orgs/octo-org/actions/variables/USERNAME/repositories: 401 Bad credentials`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"PUT", "/orgs/octo-org/actions/variables/USERNAME/repositories", 204, header, ``},
+			},
+			s: &VariablesService{
+				client:   c,
+				basePath: "/orgs/octo-org/actions",
+			},
+			ctx:          context.Background(),
+			variableName: "USERNAME",
+			repoIDs:      []int{1296269},
+			expectedResponse: &Response{
+				Rate: expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			defer ts.Close()
+
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			resp, err := tc.s.SetSelectedRepos(tc.ctx, tc.variableName, tc.repoIDs)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestVariablesService_AddSelectedRepo(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	tests := []struct {
+		name             string
+		mockResponses    []MockResponse
+		s                *VariablesService
+		ctx              context.Context
+		variableName     string
+		repoID           int
+		expectedResponse *Response
+		expectedError    string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &VariablesService{
+				client:   c,
+				basePath: "/orgs/octo-org/actions",
+			},
+			ctx:           nil,
+			variableName:  "USERNAME",
+			repoID:        1296269,
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"PUT", "/orgs/octo-org/actions/variables/USERNAME/repositories/1296269", 401, http.Header{}, `{
+					"message": "Bad credentials"
+				}`},
+			},
+			s: &VariablesService{
+				client:   c,
+				basePath: "/orgs/octo-org/actions",
+			},
+			ctx:           context.Background(),
+			variableName:  "USERNAME",
+			repoID:        1296269,
+			expectedError: `PUT /orgs/octo-org/actions/variables/USERNAME/repositories/1296269: 401 Bad credentials`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"PUT", "/orgs/octo-org/actions/variables/USERNAME/repositories/1296269", 204, header, ``},
+			},
+			s: &VariablesService{
+				client:   c,
+				basePath: "/orgs/octo-org/actions",
+			},
+			ctx:          context.Background(),
+			variableName: "USERNAME",
+			repoID:       1296269,
+			expectedResponse: &Response{
+				Rate: expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			defer ts.Close()
+
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			resp, err := tc.s.AddSelectedRepo(tc.ctx, tc.variableName, tc.repoID)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestVariablesService_RemoveSelectedRepo(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	tests := []struct {
+		name             string
+		mockResponses    []MockResponse
+		s                *VariablesService
+		ctx              context.Context
+		variableName     string
+		repoID           int
+		expectedResponse *Response
+		expectedError    string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &VariablesService{
+				client:   c,
+				basePath: "/orgs/octo-org/actions",
+			},
+			ctx:           nil,
+			variableName:  "USERNAME",
+			repoID:        1296269,
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"DELETE", "/orgs/octo-org/actions/variables/USERNAME/repositories/1296269", 401, http.Header{}, `{
+					"message": "Bad credentials"
+				}`},
+			},
+			s: &VariablesService{
+				client:   c,
+				basePath: "/orgs/octo-org/actions",
+			},
+			ctx:           context.Background(),
+			variableName:  "USERNAME",
+			repoID:        1296269,
+			expectedError: `DELETE /orgs/octo-org/actions/variables/USERNAME/repositories/1296269: 401 Bad credentials`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"DELETE", "/orgs/octo-org/actions/variables/USERNAME/repositories/1296269", 204, header, ``},
+			},
+			s: &VariablesService{
+				client:   c,
+				basePath: "/orgs/octo-org/actions",
+			},
+			ctx:          context.Background(),
+			variableName: "USERNAME",
+			repoID:       1296269,
+			expectedResponse: &Response{
+				Rate: expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			defer ts.Close()
+
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			resp, err := tc.s.RemoveSelectedRepo(tc.ctx, tc.variableName, tc.repoID)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestVariablesService_Sync(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	plannedChanges := []VariableChange{
+		{Action: VariableUpdate, Name: "ENVIRONMENT", OldValue: "staging", NewValue: "production"},
+		{Action: VariableDelete, Name: "LEGACY", OldValue: "true"},
+		{Action: VariableCreate, Name: "REGION", NewValue: "us-east-1"},
+	}
+
+	appliedChanges := []VariableChange{
+		{Action: VariableUpdate, Name: "ENVIRONMENT", OldValue: "staging", NewValue: "production", Applied: true},
+		{Action: VariableDelete, Name: "LEGACY", OldValue: "true", Applied: true},
+		{Action: VariableCreate, Name: "REGION", NewValue: "us-east-1", Applied: true},
+	}
+
+	tests := []struct {
+		name          string
+		mockResponses []MockResponse
+		s             *VariablesService
+		ctx           context.Context
+		desired       map[string]string
+		opts          VariablesSyncOptions
+		expectedPlan  *VariablesPlan
+		expectedError string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &VariablesService{
+				client:   c,
+				basePath: "/repos/octocat/Hello-World/actions",
+			},
+			ctx:           nil,
+			desired:       desiredVariables,
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name:          "DuplicateNames",
+			mockResponses: []MockResponse{},
+			s: &VariablesService{
+				client:   c,
+				basePath: "/repos/octocat/Hello-World/actions",
+			},
+			ctx:           context.Background(),
+			desired:       map[string]string{"REGION": "us-east-1", "region": "eu-west-1"},
+			expectedError: `desired variable names must be unique regardless of case`,
+		},
+		{
+			name: "ListError",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/actions/variables", 401, http.Header{}, `{
+					"message": "Bad credentials"
+				}`},
+			},
+			s: &VariablesService{
+				client:   c,
+				basePath: "/repos/octocat/Hello-World/actions",
+			},
+			ctx:           context.Background(),
+			desired:       desiredVariables,
+			expectedError: `GET /repos/octocat/Hello-World/actions/variables: 401 Bad credentials`,
+		},
+		{
+			name: "DryRun",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/actions/variables", 200, http.Header{}, syncVariablesBody},
+			},
+			s: &VariablesService{
+				client:   c,
+				basePath: "/repos/octocat/Hello-World/actions",
+			},
+			ctx:     context.Background(),
+			desired: desiredVariables,
+			opts: VariablesSyncOptions{
+				DryRun: true,
+			},
+			expectedPlan: &VariablesPlan{
+				Changes:   plannedChanges,
+				Unchanged: []string{"USERNAME"},
+			},
+		},
+		{
+			name: "ApplyError",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/actions/variables", 200, http.Header{}, syncVariablesBody},
+				{"PATCH", "/repos/octocat/Hello-World/actions/variables/ENVIRONMENT", 204, http.Header{}, ``},
+				{"DELETE", "/repos/octocat/Hello-World/actions/variables/LEGACY", 403, http.Header{}, `{
+					"message": "Resource not accessible by integration"
+				}`},
+			},
+			s: &VariablesService{
+				client:   c,
+				basePath: "/repos/octocat/Hello-World/actions",
+			},
+			ctx:     context.Background(),
+			desired: desiredVariables,
+			expectedPlan: &VariablesPlan{
+				Changes: []VariableChange{
+					{Action: VariableUpdate, Name: "ENVIRONMENT", OldValue: "staging", NewValue: "production", Applied: true},
+					{Action: VariableDelete, Name: "LEGACY", OldValue: "true"},
+					{Action: VariableCreate, Name: "REGION", NewValue: "us-east-1"},
+				},
+				Unchanged: []string{"USERNAME"},
+			},
+			expectedError: `delete LEGACY: DELETE /repos/octocat/Hello-World/actions/variables/LEGACY: 403 Resource not accessible by integration`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"GET", "/orgs/octo-org/actions/variables", 200, http.Header{}, syncVariablesBody},
+				{"PATCH", "/orgs/octo-org/actions/variables/ENVIRONMENT", 204, http.Header{}, ``},
+				{"DELETE", "/orgs/octo-org/actions/variables/LEGACY", 204, http.Header{}, ``},
+				{"POST", "/orgs/octo-org/actions/variables", 201, http.Header{}, `{}`},
+			},
+			s: &VariablesService{
+				client:   c,
+				basePath: "/orgs/octo-org/actions",
+			},
+			ctx:     context.Background(),
+			desired: desiredVariables,
+			opts: VariablesSyncOptions{
+				Visibility: SecretVisibilityPrivate,
+			},
+			expectedPlan: &VariablesPlan{
+				Changes:   appliedChanges,
+				Unchanged: []string{"USERNAME"},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			defer ts.Close()
+
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			plan, err := tc.s.Sync(tc.ctx, tc.desired, tc.opts)
+
+			if tc.expectedError != "" {
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tc.expectedPlan, plan)
+		})
+	}
+}
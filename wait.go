@@ -0,0 +1,242 @@
+package github
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+// ErrChecksFailed occurs when one or more checks or statuses on a reference have failed.
+var ErrChecksFailed = errors.New("checks failed")
+
+// Sources of a CheckResult.
+const (
+	CheckSourceCheckRun = "check_run"
+	CheckSourceStatus   = "status"
+)
+
+// WaitOptions are used for waiting for the checks and statuses on a reference.
+type WaitOptions struct {
+	// Required is the names of check runs and status contexts to wait for.
+	// A required name that has not been reported yet is considered pending.
+	// If empty, all check runs and statuses reported on the reference are waited for,
+	// and the wait does not finish until at least one check run or status is reported.
+	Required []string
+	// FailFast stops waiting as soon as a check run or status fails.
+	FailFast bool
+}
+
+// CheckResult is the state of a single check run or status context on a reference.
+// For check runs, State is derived from the status and conclusion of the check run.
+type CheckResult struct {
+	Name       string
+	Source     string // Either check_run or status
+	State      StatusState
+	Conclusion CheckConclusion // Only for completed check runs
+	URL        string
+}
+
+// ChecksSummary is the summary of all check runs and statuses on a reference.
+type ChecksSummary struct {
+	Results []CheckResult
+}
+
+// Completed determines whether or not all check runs and statuses have finished.
+func (s ChecksSummary) Completed() bool {
+	for _, r := range s.Results {
+		if r.State == StatusPending {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Failed returns the check runs and statuses that have failed.
+func (s ChecksSummary) Failed() []CheckResult {
+	failed := []CheckResult{}
+	for _, r := range s.Results {
+		if r.State == StatusFailure || r.State == StatusError {
+			failed = append(failed, r)
+		}
+	}
+
+	return failed
+}
+
+// checkRunState maps the status and conclusion of a check run to a commit status state.
+func checkRunState(run CheckRun) StatusState {
+	if run.Status != CheckStatusCompleted {
+		return StatusPending
+	}
+
+	switch run.Conclusion {
+	case ConclusionSuccess, ConclusionNeutral, ConclusionSkipped:
+		return StatusSuccess
+	default:
+		return StatusFailure
+	}
+}
+
+func newChecksSummary(runs []CheckRun, statuses []Status, required []string) *ChecksSummary {
+	// A check run and a status may have the same name, so results are not keyed by name.
+	results := make([]CheckResult, 0, len(runs)+len(statuses))
+
+	for _, run := range runs {
+		results = append(results, CheckResult{
+			Name:       run.Name,
+			Source:     CheckSourceCheckRun,
+			State:      checkRunState(run),
+			Conclusion: run.Conclusion,
+			URL:        run.HTMLURL,
+		})
+	}
+
+	for _, status := range statuses {
+		results = append(results, CheckResult{
+			Name:   status.Context,
+			Source: CheckSourceStatus,
+			State:  status.State,
+			URL:    status.TargetURL,
+		})
+	}
+
+	summary := &ChecksSummary{
+		Results: []CheckResult{},
+	}
+
+	if len(required) == 0 {
+		summary.Results = append(summary.Results, results...)
+	} else {
+		for _, name := range required {
+			found := false
+			for _, r := range results {
+				if r.Name == name {
+					summary.Results = append(summary.Results, r)
+					found = true
+				}
+			}
+
+			if !found {
+				summary.Results = append(summary.Results, CheckResult{
+					Name:  name,
+					State: StatusPending,
+				})
+			}
+		}
+	}
+
+	sort.SliceStable(summary.Results, func(i, j int) bool {
+		if summary.Results[i].Name != summary.Results[j].Name {
+			return summary.Results[i].Name < summary.Results[j].Name
+		}
+		return summary.Results[i].Source < summary.Results[j].Source
+	})
+
+	return summary
+}
+
+// rateLimitWait returns how long to wait before retrying if an error is caused by rate limiting.
+func rateLimitWait(err error) (time.Duration, bool) {
+	var rateLimitErr *RateLimitError
+	if errors.As(err, &rateLimitErr) {
+		return time.Until(rateLimitErr.Rate.Reset.Time()), true
+	}
+
+	var abuseErr *RateLimitAbuseError
+	if errors.As(err, &abuseErr) {
+		return abuseErr.RetryAfter, true
+	}
+
+	return 0, false
+}
+
+// WaitForChecks waits until all check runs and statuses on a reference (SHA, branch, or tag) are completed.
+// It polls the latest check runs and the combined status with an exponential backoff.
+// If the rate limit is exceeded, it waits until the rate limit is reset and continues polling.
+// If any check run or status fails, the summary is returned along with an error wrapping ErrChecksFailed.
+// If the context is cancelled, the last known summary is returned along with the context error.
+func (s *RepoService) WaitForChecks(ctx context.Context, ref string, opts WaitOptions) (*ChecksSummary, error) {
+	var summary *ChecksSummary
+
+	err := poll(ctx, pollInitialInterval, pollMaxInterval, func() (bool, error) {
+		runs, statuses, err := s.latestChecks(ctx, ref)
+		if err != nil {
+			if d, ok := rateLimitWait(err); ok {
+				timer := time.NewTimer(d)
+				defer timer.Stop()
+
+				select {
+				case <-ctx.Done():
+					return false, ctx.Err()
+				case <-timer.C:
+					return false, nil
+				}
+			}
+			return false, err
+		}
+
+		summary = newChecksSummary(runs, statuses, opts.Required)
+
+		if opts.FailFast && len(summary.Failed()) > 0 {
+			return true, nil
+		}
+
+		// Nothing has been reported yet, so the checks may not have been registered on the reference yet.
+		if len(summary.Results) == 0 {
+			return false, nil
+		}
+
+		return summary.Completed(), nil
+	})
+
+	if err != nil {
+		if ctx != nil && ctx.Err() != nil {
+			return summary, ctx.Err()
+		}
+		return nil, err
+	}
+
+	if failed := summary.Failed(); len(failed) > 0 {
+		names := make([]string, len(failed))
+		for i, r := range failed {
+			names[i] = r.Name
+		}
+		return summary, fmt.Errorf("%w: %s", ErrChecksFailed, strings.Join(names, ", "))
+	}
+
+	return summary, nil
+}
+
+// latestChecks retrieves all of the latest check runs and statuses on a reference.
+func (s *RepoService) latestChecks(ctx context.Context, ref string) ([]CheckRun, []Status, error) {
+	runs := []CheckRun{}
+	filter := CheckRunsFilter{
+		Filter: "latest",
+	}
+
+	for pageNo := 1; pageNo != 0; {
+		page, resp, err := s.Checks.ListRuns(ctx, ref, 100, pageNo, filter)
+		if err != nil {
+			return nil, nil, err
+		}
+		runs = append(runs, page...)
+		pageNo = resp.Pages.Next
+	}
+
+	statuses := []Status{}
+
+	for pageNo := 1; pageNo != 0; {
+		combined, resp, err := s.CombinedStatus(ctx, ref, 100, pageNo)
+		if err != nil {
+			return nil, nil, err
+		}
+		statuses = append(statuses, combined.Statuses...)
+		pageNo = resp.Pages.Next
+	}
+
+	return runs, statuses, nil
+}
@@ -0,0 +1,543 @@
+package github
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	pendingRunsBody = `{
+		"total_count": 1,
+		"check_runs": [
+			{ "id": 1, "name": "build", "status": "in_progress", "html_url": "https://github.com/octocat/Hello-World/runs/1" }
+		]
+	}`
+
+	successRunsBody = `{
+		"total_count": 2,
+		"check_runs": [
+			{ "id": 1, "name": "build", "status": "completed", "conclusion": "success", "html_url": "https://github.com/octocat/Hello-World/runs/1" },
+			{ "id": 2, "name": "lint", "status": "completed", "conclusion": "skipped", "html_url": "https://github.com/octocat/Hello-World/runs/2" }
+		]
+	}`
+
+	failureRunsBody = `{
+		"total_count": 1,
+		"check_runs": [
+			{ "id": 1, "name": "build", "status": "completed", "conclusion": "failure", "html_url": "https://github.com/octocat/Hello-World/runs/1" }
+		]
+	}`
+
+	pendingStatusBody = `{
+		"state": "pending",
+		"sha": "6dcb09b5b57875f334f61aebed695e2e4193db5e",
+		"total_count": 1,
+		"statuses": [
+			{ "id": 1, "state": "pending", "context": "ci/deploy", "target_url": "https://ci.example.com/1" }
+		]
+	}`
+
+	successStatusBody = `{
+		"state": "success",
+		"sha": "6dcb09b5b57875f334f61aebed695e2e4193db5e",
+		"total_count": 1,
+		"statuses": [
+			{ "id": 1, "state": "success", "context": "ci/deploy", "target_url": "https://ci.example.com/1" }
+		]
+	}`
+
+	emptyStatusBody = `{
+		"state": "pending",
+		"sha": "6dcb09b5b57875f334f61aebed695e2e4193db5e",
+		"total_count": 0,
+		"statuses": []
+	}`
+)
+
+var (
+	buildSuccess = CheckResult{
+		Name:       "build",
+		Source:     CheckSourceCheckRun,
+		State:      StatusSuccess,
+		Conclusion: ConclusionSuccess,
+		URL:        "https://github.com/octocat/Hello-World/runs/1",
+	}
+
+	buildFailure = CheckResult{
+		Name:       "build",
+		Source:     CheckSourceCheckRun,
+		State:      StatusFailure,
+		Conclusion: ConclusionFailure,
+		URL:        "https://github.com/octocat/Hello-World/runs/1",
+	}
+
+	deployPending = CheckResult{
+		Name:   "ci/deploy",
+		Source: CheckSourceStatus,
+		State:  StatusPending,
+		URL:    "https://ci.example.com/1",
+	}
+
+	deploySuccess = CheckResult{
+		Name:   "ci/deploy",
+		Source: CheckSourceStatus,
+		State:  StatusSuccess,
+		URL:    "https://ci.example.com/1",
+	}
+
+	lintSkipped = CheckResult{
+		Name:       "lint",
+		Source:     CheckSourceCheckRun,
+		State:      StatusSuccess,
+		Conclusion: ConclusionSkipped,
+		URL:        "https://github.com/octocat/Hello-World/runs/2",
+	}
+)
+
+func TestChecksSummary_Completed(t *testing.T) {
+	tests := []struct {
+		name              string
+		s                 ChecksSummary
+		expectedCompleted bool
+	}{
+		{
+			name:              "Empty",
+			s:                 ChecksSummary{},
+			expectedCompleted: true,
+		},
+		{
+			name: "Pending",
+			s: ChecksSummary{
+				Results: []CheckResult{buildSuccess, deployPending},
+			},
+			expectedCompleted: false,
+		},
+		{
+			name: "Completed",
+			s: ChecksSummary{
+				Results: []CheckResult{buildFailure, deploySuccess},
+			},
+			expectedCompleted: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expectedCompleted, tc.s.Completed())
+		})
+	}
+}
+
+func TestChecksSummary_Failed(t *testing.T) {
+	tests := []struct {
+		name           string
+		s              ChecksSummary
+		expectedFailed []CheckResult
+	}{
+		{
+			name:           "Empty",
+			s:              ChecksSummary{},
+			expectedFailed: []CheckResult{},
+		},
+		{
+			name: "Failed",
+			s: ChecksSummary{
+				Results: []CheckResult{buildFailure, deployPending},
+			},
+			expectedFailed: []CheckResult{buildFailure},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expectedFailed, tc.s.Failed())
+		})
+	}
+}
+
+func TestRepoService_WaitForChecks(t *testing.T) {
+	initialInterval, maxInterval := pollInitialInterval, pollMaxInterval
+	pollInitialInterval, pollMaxInterval = time.Millisecond, time.Millisecond
+	t.Cleanup(func() {
+		pollInitialInterval, pollMaxInterval = initialInterval, maxInterval
+	})
+
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	cancelledCtx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	timeoutCtx, cancelTimeout := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancelTimeout()
+
+	rateLimitHeader := http.Header{
+		headerRateLimit:     {"5000"},
+		headerRateUsed:      {"5000"},
+		headerRateRemaining: {"0"},
+		headerRateReset:     {"1605083281"},
+	}
+
+	tests := []struct {
+		name            string
+		mockResponses   []MockResponse
+		s               *RepoService
+		ctx             context.Context
+		ref             string
+		opts            WaitOptions
+		expectedSummary *ChecksSummary
+		expectedError   string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+				Checks: &ChecksService{
+					client: c,
+					owner:  "octocat",
+					repo:   "Hello-World",
+				},
+			},
+			ctx:           nil,
+			ref:           "main",
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "ChecksError",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/commits/main/check-runs", 401, http.Header{}, `{
+					"message": "Bad credentials"
+				}`},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+				Checks: &ChecksService{
+					client: c,
+					owner:  "octocat",
+					repo:   "Hello-World",
+				},
+			},
+			ctx:           context.Background(),
+			ref:           "main",
+			expectedError: `GET /repos/octocat/Hello-World/commits/main/check-runs: 401 Bad credentials`,
+		},
+		{
+			name: "StatusError",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/commits/main/check-runs", 200, http.Header{}, successRunsBody},
+				{"GET", "/repos/octocat/Hello-World/commits/main/status", 401, http.Header{}, `{
+					"message": "Bad credentials"
+				}`},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+				Checks: &ChecksService{
+					client: c,
+					owner:  "octocat",
+					repo:   "Hello-World",
+				},
+			},
+			ctx:           context.Background(),
+			ref:           "main",
+			expectedError: `GET /repos/octocat/Hello-World/commits/main/status: 401 Bad credentials`,
+		},
+		{
+			name: "ContextCancelled",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/commits/main/check-runs", 200, http.Header{}, pendingRunsBody},
+				{"GET", "/repos/octocat/Hello-World/commits/main/status", 200, http.Header{}, pendingStatusBody},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+				Checks: &ChecksService{
+					client: c,
+					owner:  "octocat",
+					repo:   "Hello-World",
+				},
+			},
+			ctx:           cancelledCtx,
+			ref:           "main",
+			expectedError: `context canceled`,
+		},
+		{
+			name: "ContextTimeout",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/commits/main/check-runs", 200, http.Header{}, successRunsBody},
+				{"GET", "/repos/octocat/Hello-World/commits/main/status", 200, http.Header{}, pendingStatusBody},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+				Checks: &ChecksService{
+					client: c,
+					owner:  "octocat",
+					repo:   "Hello-World",
+				},
+			},
+			ctx: timeoutCtx,
+			ref: "main",
+			expectedSummary: &ChecksSummary{
+				Results: []CheckResult{buildSuccess, deployPending, lintSkipped},
+			},
+			expectedError: `context deadline exceeded`,
+		},
+		{
+			name: "NoChecksYet",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/commits/main/check-runs", 200, http.Header{}, `{ "total_count": 0, "check_runs": [] }`},
+				{"GET", "/repos/octocat/Hello-World/commits/main/check-runs", 200, http.Header{}, successRunsBody},
+				{"GET", "/repos/octocat/Hello-World/commits/main/status", 200, http.Header{}, emptyStatusBody},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+				Checks: &ChecksService{
+					client: c,
+					owner:  "octocat",
+					repo:   "Hello-World",
+				},
+			},
+			ctx: context.Background(),
+			ref: "main",
+			expectedSummary: &ChecksSummary{
+				Results: []CheckResult{buildSuccess, lintSkipped},
+			},
+		},
+		{
+			name: "StatusPages",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/commits/main/check-runs", 200, http.Header{}, `{ "total_count": 0, "check_runs": [] }`},
+				{"GET", "/repos/octocat/Hello-World/commits/main/status", 200, http.Header{
+					headerLink: {`<https://api.github.com/repos/octocat/Hello-World/commits/main/status?per_page=100&page=2>; rel="next"`},
+				}, successStatusBody},
+				{"GET", "/repos/octocat/Hello-World/commits/main/status", 200, http.Header{}, `{
+					"state": "pending",
+					"sha": "6dcb09b5b57875f334f61aebed695e2e4193db5e",
+					"total_count": 2,
+					"statuses": [
+						{ "id": 2, "state": "success", "context": "ci/test", "target_url": "https://ci.example.com/2" }
+					]
+				}`},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+				Checks: &ChecksService{
+					client: c,
+					owner:  "octocat",
+					repo:   "Hello-World",
+				},
+			},
+			ctx: context.Background(),
+			ref: "main",
+			opts: WaitOptions{
+				Required: []string{"ci/test"},
+			},
+			expectedSummary: &ChecksSummary{
+				Results: []CheckResult{
+					{Name: "ci/test", Source: CheckSourceStatus, State: StatusSuccess, URL: "https://ci.example.com/2"},
+				},
+			},
+		},
+		{
+			name: "SameName",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/commits/main/check-runs", 200, http.Header{}, successRunsBody},
+				{"GET", "/repos/octocat/Hello-World/commits/main/status", 200, http.Header{}, `{
+					"state": "failure",
+					"sha": "6dcb09b5b57875f334f61aebed695e2e4193db5e",
+					"total_count": 1,
+					"statuses": [
+						{ "id": 1, "state": "failure", "context": "build", "target_url": "https://ci.example.com/1" }
+					]
+				}`},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+				Checks: &ChecksService{
+					client: c,
+					owner:  "octocat",
+					repo:   "Hello-World",
+				},
+			},
+			ctx: context.Background(),
+			ref: "main",
+			opts: WaitOptions{
+				Required: []string{"build"},
+			},
+			expectedSummary: &ChecksSummary{
+				Results: []CheckResult{
+					buildSuccess,
+					{Name: "build", Source: CheckSourceStatus, State: StatusFailure, URL: "https://ci.example.com/1"},
+				},
+			},
+			expectedError: `checks failed: build`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/commits/main/check-runs", 200, http.Header{}, pendingRunsBody},
+				{"GET", "/repos/octocat/Hello-World/commits/main/check-runs", 200, http.Header{}, successRunsBody},
+				{"GET", "/repos/octocat/Hello-World/commits/main/status", 200, http.Header{}, pendingStatusBody},
+				{"GET", "/repos/octocat/Hello-World/commits/main/status", 200, http.Header{}, pendingStatusBody},
+				{"GET", "/repos/octocat/Hello-World/commits/main/status", 200, http.Header{}, successStatusBody},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+				Checks: &ChecksService{
+					client: c,
+					owner:  "octocat",
+					repo:   "Hello-World",
+				},
+			},
+			ctx: context.Background(),
+			ref: "main",
+			expectedSummary: &ChecksSummary{
+				Results: []CheckResult{buildSuccess, deploySuccess, lintSkipped},
+			},
+		},
+		{
+			name: "RateLimited",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/commits/main/check-runs", 403, rateLimitHeader, `{
+					"message": "API rate limit exceeded"
+				}`},
+				{"GET", "/repos/octocat/Hello-World/commits/main/check-runs", 200, http.Header{}, successRunsBody},
+				{"GET", "/repos/octocat/Hello-World/commits/main/status", 200, http.Header{}, successStatusBody},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+				Checks: &ChecksService{
+					client: c,
+					owner:  "octocat",
+					repo:   "Hello-World",
+				},
+			},
+			ctx: context.Background(),
+			ref: "main",
+			expectedSummary: &ChecksSummary{
+				Results: []CheckResult{buildSuccess, deploySuccess, lintSkipped},
+			},
+		},
+		{
+			name: "Required",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/commits/main/check-runs", 200, http.Header{}, `{ "total_count": 0, "check_runs": [] }`},
+				{"GET", "/repos/octocat/Hello-World/commits/main/check-runs", 200, http.Header{}, successRunsBody},
+				{"GET", "/repos/octocat/Hello-World/commits/main/status", 200, http.Header{}, pendingStatusBody},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+				Checks: &ChecksService{
+					client: c,
+					owner:  "octocat",
+					repo:   "Hello-World",
+				},
+			},
+			ctx: context.Background(),
+			ref: "main",
+			opts: WaitOptions{
+				Required: []string{"lint", "build"},
+			},
+			expectedSummary: &ChecksSummary{
+				Results: []CheckResult{buildSuccess, lintSkipped},
+			},
+		},
+		{
+			name: "Failed",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/commits/main/check-runs", 200, http.Header{}, failureRunsBody},
+				{"GET", "/repos/octocat/Hello-World/commits/main/status", 200, http.Header{}, pendingStatusBody},
+				{"GET", "/repos/octocat/Hello-World/commits/main/status", 200, http.Header{}, successStatusBody},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+				Checks: &ChecksService{
+					client: c,
+					owner:  "octocat",
+					repo:   "Hello-World",
+				},
+			},
+			ctx: context.Background(),
+			ref: "main",
+			expectedSummary: &ChecksSummary{
+				Results: []CheckResult{buildFailure, deploySuccess},
+			},
+			expectedError: `checks failed: build`,
+		},
+		{
+			name: "FailFast",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/commits/main/check-runs", 200, http.Header{}, failureRunsBody},
+				{"GET", "/repos/octocat/Hello-World/commits/main/status", 200, http.Header{}, pendingStatusBody},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+				Checks: &ChecksService{
+					client: c,
+					owner:  "octocat",
+					repo:   "Hello-World",
+				},
+			},
+			ctx: context.Background(),
+			ref: "main",
+			opts: WaitOptions{
+				FailFast: true,
+			},
+			expectedSummary: &ChecksSummary{
+				Results: []CheckResult{buildFailure, deployPending},
+			},
+			expectedError: `checks failed: build`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			defer ts.Close()
+
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			summary, err := tc.s.WaitForChecks(tc.ctx, tc.ref, tc.opts)
+
+			assert.Equal(t, tc.expectedSummary, summary)
+			if tc.expectedError != "" {
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,836 @@
+package github
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// maxWorkflowInputs is the maximum number of inputs GitHub accepts for a workflow dispatch.
+const maxWorkflowInputs = 25
+
+// WorkflowService provides GitHub APIs for GitHub Actions workflows, workflow runs, and jobs in a repository.
+// See https://docs.github.com/en/rest/actions/workflows
+type WorkflowService struct {
+	client      *Client
+	owner, repo string
+}
+
+// WorkflowState is the state of a GitHub Actions workflow.
+type WorkflowState string
+
+const (
+	// WorkflowActive is the state of an enabled workflow.
+	WorkflowActive WorkflowState = "active"
+	// WorkflowDeleted is the state of a workflow whose file has been removed.
+	WorkflowDeleted WorkflowState = "deleted"
+	// WorkflowDisabledFork is the state of a workflow disabled in a fork.
+	WorkflowDisabledFork WorkflowState = "disabled_fork"
+	// WorkflowDisabledInactivity is the state of a workflow disabled due to repository inactivity.
+	WorkflowDisabledInactivity WorkflowState = "disabled_inactivity"
+	// WorkflowDisabledManually is the state of a workflow disabled by a user.
+	WorkflowDisabledManually WorkflowState = "disabled_manually"
+)
+
+// Workflow is a GitHub Actions workflow object.
+type Workflow struct {
+	ID        int           `json:"id"`
+	Name      string        `json:"name"`
+	Path      string        `json:"path"`
+	State     WorkflowState `json:"state"`
+	URL       string        `json:"url"`
+	HTMLURL   string        `json:"html_url"`
+	BadgeURL  string        `json:"badge_url"`
+	CreatedAt time.Time     `json:"created_at"`
+	UpdatedAt time.Time     `json:"updated_at"`
+}
+
+// WorkflowRun is a GitHub Actions workflow run object.
+type WorkflowRun struct {
+	ID              int             `json:"id"`
+	Name            string          `json:"name"`
+	DisplayTitle    string          `json:"display_title"`
+	RunNumber       int             `json:"run_number"`
+	RunAttempt      int             `json:"run_attempt"`
+	Event           string          `json:"event"`
+	Status          string          `json:"status"` // Either requested, queued, pending, waiting, in_progress, or completed
+	Conclusion      CheckConclusion `json:"conclusion"`
+	WorkflowID      int             `json:"workflow_id"`
+	HeadBranch      string          `json:"head_branch"`
+	HeadSHA         string          `json:"head_sha"`
+	Actor           User            `json:"actor"`
+	TriggeringActor User            `json:"triggering_actor"`
+	URL             string          `json:"url"`
+	HTMLURL         string          `json:"html_url"`
+	JobsURL         string          `json:"jobs_url"`
+	LogsURL         string          `json:"logs_url"`
+	CreatedAt       time.Time       `json:"created_at"`
+	UpdatedAt       time.Time       `json:"updated_at"`
+	RunStartedAt    *time.Time      `json:"run_started_at"`
+}
+
+// WorkflowRunsFilter are used for fetching WorkflowRuns.
+// Status can be a run status (i.e. in_progress) or a conclusion (i.e. failure).
+// Created is a date range such as >=2020-10-20T00:00:00Z or 2020-10-01..2020-10-31.
+type WorkflowRunsFilter struct {
+	Actor               string
+	Branch              string
+	Event               string
+	Status              string
+	Created             string
+	HeadSHA             string
+	ExcludePullRequests bool
+}
+
+type (
+	// Job is a GitHub Actions workflow job object.
+	Job struct {
+		ID              int             `json:"id"`
+		RunID           int             `json:"run_id"`
+		RunAttempt      int             `json:"run_attempt"`
+		WorkflowName    string          `json:"workflow_name"`
+		Name            string          `json:"name"`
+		HeadBranch      string          `json:"head_branch"`
+		HeadSHA         string          `json:"head_sha"`
+		Status          CheckStatus     `json:"status"`
+		Conclusion      CheckConclusion `json:"conclusion"`
+		Labels          []string        `json:"labels"`
+		RunnerID        int             `json:"runner_id"`
+		RunnerName      string          `json:"runner_name"`
+		RunnerGroupID   int             `json:"runner_group_id"`
+		RunnerGroupName string          `json:"runner_group_name"`
+		Steps           []JobStep       `json:"steps"`
+		URL             string          `json:"url"`
+		HTMLURL         string          `json:"html_url"`
+		RunURL          string          `json:"run_url"`
+		CheckRunURL     string          `json:"check_run_url"`
+		CreatedAt       time.Time       `json:"created_at"`
+		StartedAt       time.Time       `json:"started_at"`
+		CompletedAt     *time.Time      `json:"completed_at"`
+	}
+
+	// JobStep is a step of a GitHub Actions workflow job.
+	JobStep struct {
+		Number      int             `json:"number"`
+		Name        string          `json:"name"`
+		Status      CheckStatus     `json:"status"`
+		Conclusion  CheckConclusion `json:"conclusion"`
+		StartedAt   *time.Time      `json:"started_at"`
+		CompletedAt *time.Time      `json:"completed_at"`
+	}
+)
+
+// JobsFilter are used for fetching Jobs.
+// Filter is either latest (jobs from the latest run attempt) or all (jobs from all run attempts).
+type JobsFilter struct {
+	Filter string
+}
+
+type (
+	// JobUsage is the billable time of a single job.
+	JobUsage struct {
+		JobID      int   `json:"job_id"`
+		DurationMS int64 `json:"duration_ms"`
+	}
+
+	// RunnerUsage is the billable time on an operating system.
+	RunnerUsage struct {
+		TotalMS int64      `json:"total_ms"`
+		Jobs    int        `json:"jobs,omitempty"`
+		JobRuns []JobUsage `json:"job_runs,omitempty"`
+	}
+
+	// WorkflowUsage is the billable time of a workflow in the current billing cycle.
+	// Billable is keyed by operating system (UBUNTU, MACOS, or WINDOWS).
+	WorkflowUsage struct {
+		Billable map[string]RunnerUsage `json:"billable"`
+	}
+
+	// WorkflowRunUsage is the billable time and the total duration of a workflow run.
+	// Billable is keyed by operating system (UBUNTU, MACOS, or WINDOWS).
+	WorkflowRunUsage struct {
+		Billable      map[string]RunnerUsage `json:"billable"`
+		RunDurationMS int64                  `json:"run_duration_ms"`
+	}
+)
+
+// InputType is the type of a workflow_dispatch input.
+type InputType string
+
+const (
+	// InputString is a free-form string input.
+	InputString InputType = "string"
+	// InputBoolean is a true or false input.
+	InputBoolean InputType = "boolean"
+	// InputNumber is a numeric input.
+	InputNumber InputType = "number"
+	// InputChoice is an input restricted to a list of options.
+	InputChoice InputType = "choice"
+	// InputEnvironment is an input naming a deployment environment.
+	InputEnvironment InputType = "environment"
+)
+
+// WorkflowInput is the definition of a workflow_dispatch input as declared in a workflow file.
+type WorkflowInput struct {
+	Type     InputType
+	Required bool
+	Options  []string // Only for choice inputs
+}
+
+// ErrInvalidInputs occurs when the inputs for a workflow dispatch do not match the workflow input definitions.
+var ErrInvalidInputs = errors.New("invalid workflow inputs")
+
+// ValidateInputs verifies a set of inputs for a workflow dispatch against the workflow input definitions.
+// Boolean and number inputs can be given as Go values or as strings.
+func ValidateInputs(definitions map[string]WorkflowInput, inputs map[string]interface{}) error {
+	problems := []string{}
+
+	if len(inputs) > maxWorkflowInputs {
+		problems = append(problems, fmt.Sprintf("too many inputs (%d > %d)", len(inputs), maxWorkflowInputs))
+	}
+
+	for name, value := range inputs {
+		def, ok := definitions[name]
+		if !ok {
+			problems = append(problems, fmt.Sprintf("%s: unexpected input", name))
+			continue
+		}
+
+		if err := validateInput(def, value); err != nil {
+			problems = append(problems, fmt.Sprintf("%s: %s", name, err))
+		}
+	}
+
+	for name, def := range definitions {
+		if _, ok := inputs[name]; def.Required && !ok {
+			problems = append(problems, fmt.Sprintf("%s: required input is missing", name))
+		}
+	}
+
+	if len(problems) > 0 {
+		sort.Strings(problems)
+		return fmt.Errorf("%w: %s", ErrInvalidInputs, strings.Join(problems, "; "))
+	}
+
+	return nil
+}
+
+func validateInput(def WorkflowInput, value interface{}) error {
+	switch def.Type {
+	case InputBoolean:
+		switch v := value.(type) {
+		case bool:
+			return nil
+		case string:
+			if _, err := strconv.ParseBool(v); err == nil {
+				return nil
+			}
+		}
+		return fmt.Errorf("expected boolean, got %v", value)
+
+	case InputNumber:
+		switch v := value.(type) {
+		case int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64, float32, float64:
+			return nil
+		case string:
+			if _, err := strconv.ParseFloat(v, 64); err == nil {
+				return nil
+			}
+		}
+		return fmt.Errorf("expected number, got %v", value)
+
+	case InputChoice:
+		v, ok := value.(string)
+		if ok {
+			for _, option := range def.Options {
+				if v == option {
+					return nil
+				}
+			}
+		}
+		return fmt.Errorf("expected one of %s, got %v", strings.Join(def.Options, ", "), value)
+
+	default:
+		if _, ok := value.(string); !ok {
+			return fmt.Errorf("expected string, got %v", value)
+		}
+		return nil
+	}
+}
+
+// Dispatch triggers a repository_dispatch event to run workflows in the repository.
+// The client payload is available to workflows as github.event.client_payload.
+// See https://docs.github.com/en/rest/repos/repos#create-a-repository-dispatch-event
+func (s *RepoService) Dispatch(ctx context.Context, eventType string, clientPayload interface{}) (*Response, error) {
+	url := fmt.Sprintf("/repos/%s/%s/dispatches", s.owner, s.repo)
+	body := struct {
+		EventType     string      `json:"event_type"`
+		ClientPayload interface{} `json:"client_payload,omitempty"`
+	}{
+		EventType:     eventType,
+		ClientPayload: clientPayload,
+	}
+
+	req, err := s.client.NewRequest(ctx, "POST", url, body)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// Dispatch triggers a workflow_dispatch event for a workflow.
+// The workflow can be identified by its id or its file name (i.e. deploy.yml).
+// The ref is a branch or a tag name.
+// See https://docs.github.com/en/rest/actions/workflows#create-a-workflow-dispatch-event
+func (s *WorkflowService) Dispatch(ctx context.Context, workflow, ref string, inputs map[string]interface{}) (*Response, error) {
+	url := fmt.Sprintf("/repos/%s/%s/actions/workflows/%s/dispatches", s.owner, s.repo, escapePath(workflow))
+	body := struct {
+		Ref    string                 `json:"ref"`
+		Inputs map[string]interface{} `json:"inputs,omitempty"`
+	}{
+		Ref:    ref,
+		Inputs: inputs,
+	}
+
+	req, err := s.client.NewRequest(ctx, "POST", url, body)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// Runs retrieves all runs of a workflow page by page.
+// The workflow can be identified by its id or its file name (i.e. deploy.yml).
+// See https://docs.github.com/en/rest/actions/workflow-runs#list-workflow-runs-for-a-workflow
+func (s *WorkflowService) Runs(ctx context.Context, workflow string, pageSize, pageNo int, filter WorkflowRunsFilter) ([]WorkflowRun, *Response, error) {
+	url := fmt.Sprintf("/repos/%s/%s/actions/workflows/%s/runs", s.owner, s.repo, escapePath(workflow))
+	return s.listRuns(ctx, url, pageSize, pageNo, filter)
+}
+
+// AllRuns retrieves all runs of all workflows in the repository page by page.
+// See https://docs.github.com/en/rest/actions/workflow-runs#list-workflow-runs-for-a-repository
+func (s *WorkflowService) AllRuns(ctx context.Context, pageSize, pageNo int, filter WorkflowRunsFilter) ([]WorkflowRun, *Response, error) {
+	url := fmt.Sprintf("/repos/%s/%s/actions/runs", s.owner, s.repo)
+	return s.listRuns(ctx, url, pageSize, pageNo, filter)
+}
+
+func (s *WorkflowService) listRuns(ctx context.Context, url string, pageSize, pageNo int, filter WorkflowRunsFilter) ([]WorkflowRun, *Response, error) {
+	req, err := s.client.NewPageRequest(ctx, "GET", url, pageSize, pageNo, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	q := req.URL.Query()
+	if filter.Actor != "" {
+		q.Add("actor", filter.Actor)
+	}
+	if filter.Branch != "" {
+		q.Add("branch", filter.Branch)
+	}
+	if filter.Event != "" {
+		q.Add("event", filter.Event)
+	}
+	if filter.Status != "" {
+		q.Add("status", filter.Status)
+	}
+	if filter.Created != "" {
+		q.Add("created", filter.Created)
+	}
+	if filter.HeadSHA != "" {
+		q.Add("head_sha", filter.HeadSHA)
+	}
+	if filter.ExcludePullRequests {
+		q.Add("exclude_pull_requests", "true")
+	}
+	req.URL.RawQuery = q.Encode()
+
+	body := new(struct {
+		TotalCount   int           `json:"total_count"`
+		WorkflowRuns []WorkflowRun `json:"workflow_runs"`
+	})
+
+	resp, err := s.client.Do(req, body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return body.WorkflowRuns, resp, nil
+}
+
+// dispatchTimeout is the default time to wait for the run created by a workflow dispatch.
+var dispatchTimeout = 5 * time.Minute
+
+// ErrAmbiguousRun occurs when more than one workflow run matches a workflow dispatch without a marker.
+var ErrAmbiguousRun = errors.New("more than one workflow run matches the dispatch")
+
+// DispatchOptions are used for dispatching a workflow and finding the run it created.
+type DispatchOptions struct {
+	// MarkerInput is the name of a workflow input for injecting a unique marker.
+	// The workflow should include the input in its run-name (i.e. run-name: Deploy ${{ inputs.marker }}),
+	// so the created run can be identified reliably when the same workflow is dispatched concurrently.
+	// If empty, the run is only identified if it is the single run created by the authenticated user after the dispatch.
+	// Otherwise, ErrAmbiguousRun is returned.
+	// Without a marker, a concurrent dispatch of the same workflow by the same user can still be mistaken for this one.
+	MarkerInput string
+	// Timeout is the maximum time to wait for the created run.
+	// If zero, the deadline of the context is used, or five minutes if the context has no deadline.
+	Timeout time.Duration
+}
+
+// newMarker generates a random marker for correlating a workflow dispatch with its run.
+func newMarker() (string, error) {
+	b := make([]byte, 8)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(b), nil
+}
+
+// DispatchAndFind triggers a workflow_dispatch event for a workflow and waits until the created run is found.
+// Since the dispatch API does not return the run, runs are correlated on the creation time,
+// the authenticated user as the actor, and optionally an injected input marker.
+// If the run is not found before the timeout in the options, the context error is returned.
+func (s *WorkflowService) DispatchAndFind(ctx context.Context, workflow, ref string, inputs map[string]interface{}, opts DispatchOptions) (*WorkflowRun, error) {
+	users := &UserService{
+		client: s.client,
+	}
+
+	user, _, err := users.User(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var marker string
+	if opts.MarkerInput != "" {
+		if marker, err = newMarker(); err != nil {
+			return nil, err
+		}
+
+		withMarker := map[string]interface{}{}
+		for k, v := range inputs {
+			withMarker[k] = v
+		}
+		withMarker[opts.MarkerInput] = marker
+		inputs = withMarker
+	}
+
+	resp, err := s.Dispatch(ctx, workflow, ref, inputs)
+	if err != nil {
+		return nil, err
+	}
+
+	// Use the server time to avoid clock skew; the Date header has a precision of one second.
+	dispatchedAt := time.Now()
+	if t, err := http.ParseTime(resp.Header.Get("Date")); err == nil {
+		dispatchedAt = t
+	}
+	since := dispatchedAt.Add(-time.Second)
+
+	if timeout := opts.Timeout; timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	} else if _, ok := ctx.Deadline(); !ok {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, dispatchTimeout)
+		defer cancel()
+	}
+
+	filter := WorkflowRunsFilter{
+		Actor:   user.Login,
+		Event:   "workflow_dispatch",
+		Created: ">=" + since.UTC().Format(time.RFC3339),
+	}
+
+	var run *WorkflowRun
+
+	err = poll(ctx, pollInitialInterval, pollMaxInterval, func() (bool, error) {
+		runs, _, err := s.Runs(ctx, workflow, 100, 1, filter)
+		if err != nil {
+			if ctx.Err() != nil {
+				return false, ctx.Err()
+			}
+			return false, err
+		}
+
+		for i := range runs {
+			r := &runs[i]
+			if r.CreatedAt.Before(since) || !strings.EqualFold(r.Actor.Login, user.Login) {
+				continue
+			}
+			if marker != "" && !strings.Contains(r.DisplayTitle, marker) {
+				continue
+			}
+			if marker == "" && run != nil {
+				return false, ErrAmbiguousRun
+			}
+			if run == nil || r.CreatedAt.Before(run.CreatedAt) {
+				run = r
+			}
+		}
+
+		return run != nil, nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return run, nil
+}
+
+// List retrieves all workflows in the repository page by page.
+// See https://docs.github.com/en/rest/actions/workflows#list-repository-workflows
+func (s *WorkflowService) List(ctx context.Context, pageSize, pageNo int) ([]Workflow, *Response, error) {
+	url := fmt.Sprintf("/repos/%s/%s/actions/workflows", s.owner, s.repo)
+	req, err := s.client.NewPageRequest(ctx, "GET", url, pageSize, pageNo, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	body := new(struct {
+		TotalCount int        `json:"total_count"`
+		Workflows  []Workflow `json:"workflows"`
+	})
+
+	resp, err := s.client.Do(req, body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return body.Workflows, resp, nil
+}
+
+// Get retrieves a workflow by its id or its file name (i.e. deploy.yml).
+// See https://docs.github.com/en/rest/actions/workflows#get-a-workflow
+func (s *WorkflowService) Get(ctx context.Context, workflow string) (*Workflow, *Response, error) {
+	url := fmt.Sprintf("/repos/%s/%s/actions/workflows/%s", s.owner, s.repo, escapePath(workflow))
+	req, err := s.client.NewRequest(ctx, "GET", url, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	w := new(Workflow)
+
+	resp, err := s.client.Do(req, w)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return w, resp, nil
+}
+
+// Enable enables a workflow by its id or its file name (i.e. deploy.yml).
+// See https://docs.github.com/en/rest/actions/workflows#enable-a-workflow
+func (s *WorkflowService) Enable(ctx context.Context, workflow string) (*Response, error) {
+	url := fmt.Sprintf("/repos/%s/%s/actions/workflows/%s/enable", s.owner, s.repo, escapePath(workflow))
+	req, err := s.client.NewRequest(ctx, "PUT", url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// Disable disables a workflow by its id or its file name (i.e. deploy.yml).
+// See https://docs.github.com/en/rest/actions/workflows#disable-a-workflow
+func (s *WorkflowService) Disable(ctx context.Context, workflow string) (*Response, error) {
+	url := fmt.Sprintf("/repos/%s/%s/actions/workflows/%s/disable", s.owner, s.repo, escapePath(workflow))
+	req, err := s.client.NewRequest(ctx, "PUT", url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// Usage retrieves the billable time of a workflow in the current billing cycle.
+// See https://docs.github.com/en/rest/actions/workflows#get-workflow-usage
+func (s *WorkflowService) Usage(ctx context.Context, workflow string) (*WorkflowUsage, *Response, error) {
+	url := fmt.Sprintf("/repos/%s/%s/actions/workflows/%s/timing", s.owner, s.repo, escapePath(workflow))
+	req, err := s.client.NewRequest(ctx, "GET", url, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	usage := new(WorkflowUsage)
+
+	resp, err := s.client.Do(req, usage)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return usage, resp, nil
+}
+
+// Run retrieves a workflow run by its id.
+// See https://docs.github.com/en/rest/actions/workflow-runs#get-a-workflow-run
+func (s *WorkflowService) Run(ctx context.Context, runID int) (*WorkflowRun, *Response, error) {
+	url := fmt.Sprintf("/repos/%s/%s/actions/runs/%d", s.owner, s.repo, runID)
+	req, err := s.client.NewRequest(ctx, "GET", url, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	run := new(WorkflowRun)
+
+	resp, err := s.client.Do(req, run)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return run, resp, nil
+}
+
+// Rerun re-runs all jobs of a workflow run.
+// See https://docs.github.com/en/rest/actions/workflow-runs#re-run-a-workflow
+func (s *WorkflowService) Rerun(ctx context.Context, runID int, enableDebugLogging bool) (*Response, error) {
+	url := fmt.Sprintf("/repos/%s/%s/actions/runs/%d/rerun", s.owner, s.repo, runID)
+	body := struct {
+		EnableDebugLogging bool `json:"enable_debug_logging"`
+	}{
+		EnableDebugLogging: enableDebugLogging,
+	}
+
+	req, err := s.client.NewRequest(ctx, "POST", url, body)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// RerunFailedJobs re-runs the failed jobs of a workflow run and the jobs depending on them.
+// See https://docs.github.com/en/rest/actions/workflow-runs#re-run-failed-jobs-from-a-workflow-run
+func (s *WorkflowService) RerunFailedJobs(ctx context.Context, runID int, enableDebugLogging bool) (*Response, error) {
+	url := fmt.Sprintf("/repos/%s/%s/actions/runs/%d/rerun-failed-jobs", s.owner, s.repo, runID)
+	body := struct {
+		EnableDebugLogging bool `json:"enable_debug_logging"`
+	}{
+		EnableDebugLogging: enableDebugLogging,
+	}
+
+	req, err := s.client.NewRequest(ctx, "POST", url, body)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// Cancel cancels a workflow run.
+// See https://docs.github.com/en/rest/actions/workflow-runs#cancel-a-workflow-run
+func (s *WorkflowService) Cancel(ctx context.Context, runID int) (*Response, error) {
+	url := fmt.Sprintf("/repos/%s/%s/actions/runs/%d/cancel", s.owner, s.repo, runID)
+	req, err := s.client.NewRequest(ctx, "POST", url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// DeleteRun deletes a completed workflow run.
+// See https://docs.github.com/en/rest/actions/workflow-runs#delete-a-workflow-run
+func (s *WorkflowService) DeleteRun(ctx context.Context, runID int) (*Response, error) {
+	url := fmt.Sprintf("/repos/%s/%s/actions/runs/%d", s.owner, s.repo, runID)
+	req, err := s.client.NewRequest(ctx, "DELETE", url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// RunUsage retrieves the billable time and the total duration of a workflow run.
+// See https://docs.github.com/en/rest/actions/workflow-runs#get-workflow-run-usage
+func (s *WorkflowService) RunUsage(ctx context.Context, runID int) (*WorkflowRunUsage, *Response, error) {
+	url := fmt.Sprintf("/repos/%s/%s/actions/runs/%d/timing", s.owner, s.repo, runID)
+	req, err := s.client.NewRequest(ctx, "GET", url, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	usage := new(WorkflowRunUsage)
+
+	resp, err := s.client.Do(req, usage)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return usage, resp, nil
+}
+
+// RunLogs downloads the logs of a workflow run as a zip archive.
+// The API redirects to a short-lived URL that the archive is downloaded from.
+// See https://docs.github.com/en/rest/actions/workflow-runs#download-workflow-run-logs
+func (s *WorkflowService) RunLogs(ctx context.Context, runID int, w io.Writer) (*Response, error) {
+	url := fmt.Sprintf("/repos/%s/%s/actions/runs/%d/logs", s.owner, s.repo, runID)
+	req, err := s.client.NewRequest(ctx, "GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, w)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// DeleteRunLogs deletes all logs of a workflow run.
+// See https://docs.github.com/en/rest/actions/workflow-runs#delete-workflow-run-logs
+func (s *WorkflowService) DeleteRunLogs(ctx context.Context, runID int) (*Response, error) {
+	url := fmt.Sprintf("/repos/%s/%s/actions/runs/%d/logs", s.owner, s.repo, runID)
+	req, err := s.client.NewRequest(ctx, "DELETE", url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// Jobs retrieves all jobs of a workflow run page by page.
+// See https://docs.github.com/en/rest/actions/workflow-jobs#list-jobs-for-a-workflow-run
+func (s *WorkflowService) Jobs(ctx context.Context, runID int, pageSize, pageNo int, filter JobsFilter) ([]Job, *Response, error) {
+	url := fmt.Sprintf("/repos/%s/%s/actions/runs/%d/jobs", s.owner, s.repo, runID)
+	req, err := s.client.NewPageRequest(ctx, "GET", url, pageSize, pageNo, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	q := req.URL.Query()
+	if filter.Filter != "" {
+		q.Add("filter", filter.Filter)
+	}
+	req.URL.RawQuery = q.Encode()
+
+	body := new(struct {
+		TotalCount int   `json:"total_count"`
+		Jobs       []Job `json:"jobs"`
+	})
+
+	resp, err := s.client.Do(req, body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return body.Jobs, resp, nil
+}
+
+// Job retrieves a workflow job by its id.
+// See https://docs.github.com/en/rest/actions/workflow-jobs#get-a-job-for-a-workflow-run
+func (s *WorkflowService) Job(ctx context.Context, jobID int) (*Job, *Response, error) {
+	url := fmt.Sprintf("/repos/%s/%s/actions/jobs/%d", s.owner, s.repo, jobID)
+	req, err := s.client.NewRequest(ctx, "GET", url, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	job := new(Job)
+
+	resp, err := s.client.Do(req, job)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return job, resp, nil
+}
+
+// RerunJob re-runs a workflow job and the jobs depending on it.
+// See https://docs.github.com/en/rest/actions/workflow-runs#re-run-a-job-from-a-workflow-run
+func (s *WorkflowService) RerunJob(ctx context.Context, jobID int, enableDebugLogging bool) (*Response, error) {
+	url := fmt.Sprintf("/repos/%s/%s/actions/jobs/%d/rerun", s.owner, s.repo, jobID)
+	body := struct {
+		EnableDebugLogging bool `json:"enable_debug_logging"`
+	}{
+		EnableDebugLogging: enableDebugLogging,
+	}
+
+	req, err := s.client.NewRequest(ctx, "POST", url, body)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// JobLogs downloads the logs of a workflow job as plain text.
+// The API redirects to a short-lived URL that the logs are downloaded from.
+// See https://docs.github.com/en/rest/actions/workflow-jobs#download-job-logs-for-a-workflow-run
+func (s *WorkflowService) JobLogs(ctx context.Context, jobID int, w io.Writer) (*Response, error) {
+	url := fmt.Sprintf("/repos/%s/%s/actions/jobs/%d/logs", s.owner, s.repo, jobID)
+	req, err := s.client.NewRequest(ctx, "GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, w)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,2592 @@
+package github
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	workflowBody = `{
+		"id": 161335,
+		"name": "CI",
+		"path": ".github/workflows/ci.yml",
+		"state": "active",
+		"url": "https://api.github.com/repos/octocat/Hello-World/actions/workflows/161335",
+		"html_url": "https://github.com/octocat/Hello-World/blob/main/.github/workflows/ci.yml",
+		"badge_url": "https://github.com/octocat/Hello-World/workflows/CI/badge.svg",
+		"created_at": "2020-01-08T23:48:37Z",
+		"updated_at": "2020-01-08T23:50:21Z"
+	}`
+
+	workflowsBody = `{
+		"total_count": 1,
+		"workflows": [
+			{
+				"id": 161335,
+				"name": "CI",
+				"path": ".github/workflows/ci.yml",
+				"state": "active",
+				"url": "https://api.github.com/repos/octocat/Hello-World/actions/workflows/161335",
+				"html_url": "https://github.com/octocat/Hello-World/blob/main/.github/workflows/ci.yml",
+				"badge_url": "https://github.com/octocat/Hello-World/workflows/CI/badge.svg",
+				"created_at": "2020-01-08T23:48:37Z",
+				"updated_at": "2020-01-08T23:50:21Z"
+			}
+		]
+	}`
+
+	workflowUsageBody = `{
+		"billable": {
+			"UBUNTU": {
+				"total_ms": 180000
+			},
+			"MACOS": {
+				"total_ms": 240000
+			}
+		}
+	}`
+
+	workflowRunUsageBody = `{
+		"billable": {
+			"UBUNTU": {
+				"total_ms": 180000,
+				"jobs": 1,
+				"job_runs": [
+					{
+						"job_id": 399444496,
+						"duration_ms": 180000
+					}
+				]
+			}
+		},
+		"run_duration_ms": 500000
+	}`
+
+	jobBody = `{
+		"id": 399444496,
+		"run_id": 30433642,
+		"run_attempt": 1,
+		"workflow_name": "CI",
+		"name": "build",
+		"head_branch": "main",
+		"head_sha": "acb5820ced9479c074f688cc328bf03f341a511d",
+		"status": "completed",
+		"conclusion": "failure",
+		"labels": ["ubuntu-latest"],
+		"runner_id": 1,
+		"runner_name": "GitHub Actions 1",
+		"runner_group_id": 2,
+		"runner_group_name": "GitHub Actions",
+		"steps": [
+			{
+				"number": 1,
+				"name": "Set up job",
+				"status": "completed",
+				"conclusion": "success",
+				"started_at": "2020-01-20T09:42:40Z",
+				"completed_at": "2020-01-20T09:42:41Z"
+			},
+			{
+				"number": 2,
+				"name": "Run tests",
+				"status": "completed",
+				"conclusion": "failure",
+				"started_at": "2020-01-20T09:42:41Z",
+				"completed_at": "2020-01-20T09:45:40Z"
+			}
+		],
+		"url": "https://api.github.com/repos/octocat/Hello-World/actions/jobs/399444496",
+		"html_url": "https://github.com/octocat/Hello-World/runs/399444496",
+		"run_url": "https://api.github.com/repos/octocat/Hello-World/actions/runs/30433642",
+		"check_run_url": "https://api.github.com/repos/octocat/Hello-World/check-runs/399444496",
+		"created_at": "2020-01-20T09:42:39Z",
+		"started_at": "2020-01-20T09:42:40Z",
+		"completed_at": "2020-01-20T09:45:40Z"
+	}`
+
+	workflowRunBodyFormat = `{
+		"id": 30433642,
+		"name": "Deploy",
+		"display_title": "%s",
+		"run_number": 562,
+		"run_attempt": 1,
+		"event": "workflow_dispatch",
+		"status": "queued",
+		"conclusion": null,
+		"workflow_id": 159038,
+		"head_branch": "main",
+		"head_sha": "acb5820ced9479c074f688cc328bf03f341a511d",
+		"actor": {
+			"login": "%s",
+			"id": 1,
+			"type": "User"
+		},
+		"triggering_actor": {
+			"login": "%s",
+			"id": 1,
+			"type": "User"
+		},
+		"url": "https://api.github.com/repos/octocat/Hello-World/actions/runs/30433642",
+		"html_url": "https://github.com/octocat/Hello-World/actions/runs/30433642",
+		"jobs_url": "https://api.github.com/repos/octocat/Hello-World/actions/runs/30433642/jobs",
+		"logs_url": "https://api.github.com/repos/octocat/Hello-World/actions/runs/30433642/logs",
+		"created_at": "%s",
+		"updated_at": "%s",
+		"run_started_at": null
+	}`
+)
+
+var (
+	workflowRunBody = fmt.Sprintf(workflowRunBodyFormat, "Deploy", "octocat", "octocat", "2020-10-20T19:00:00Z", "2020-10-20T19:00:00Z")
+
+	workflowRunsBody = `{
+		"total_count": 1,
+		"workflow_runs": [` + workflowRunBody + `]
+	}`
+
+	jobsBody = `{
+		"total_count": 1,
+		"jobs": [` + jobBody + `]
+	}`
+
+	workflow = Workflow{
+		ID:        161335,
+		Name:      "CI",
+		Path:      ".github/workflows/ci.yml",
+		State:     WorkflowActive,
+		URL:       "https://api.github.com/repos/octocat/Hello-World/actions/workflows/161335",
+		HTMLURL:   "https://github.com/octocat/Hello-World/blob/main/.github/workflows/ci.yml",
+		BadgeURL:  "https://github.com/octocat/Hello-World/workflows/CI/badge.svg",
+		CreatedAt: parseGitHubTime("2020-01-08T23:48:37Z"),
+		UpdatedAt: parseGitHubTime("2020-01-08T23:50:21Z"),
+	}
+
+	workflowUsage = WorkflowUsage{
+		Billable: map[string]RunnerUsage{
+			"UBUNTU": {TotalMS: 180000},
+			"MACOS":  {TotalMS: 240000},
+		},
+	}
+
+	workflowRunUsage = WorkflowRunUsage{
+		Billable: map[string]RunnerUsage{
+			"UBUNTU": {
+				TotalMS: 180000,
+				Jobs:    1,
+				JobRuns: []JobUsage{
+					{JobID: 399444496, DurationMS: 180000},
+				},
+			},
+		},
+		RunDurationMS: 500000,
+	}
+
+	job = Job{
+		ID:              399444496,
+		RunID:           30433642,
+		RunAttempt:      1,
+		WorkflowName:    "CI",
+		Name:            "build",
+		HeadBranch:      "main",
+		HeadSHA:         "acb5820ced9479c074f688cc328bf03f341a511d",
+		Status:          CheckStatusCompleted,
+		Conclusion:      ConclusionFailure,
+		Labels:          []string{"ubuntu-latest"},
+		RunnerID:        1,
+		RunnerName:      "GitHub Actions 1",
+		RunnerGroupID:   2,
+		RunnerGroupName: "GitHub Actions",
+		Steps: []JobStep{
+			{
+				Number:      1,
+				Name:        "Set up job",
+				Status:      CheckStatusCompleted,
+				Conclusion:  ConclusionSuccess,
+				StartedAt:   parseGitHubTimePtr("2020-01-20T09:42:40Z"),
+				CompletedAt: parseGitHubTimePtr("2020-01-20T09:42:41Z"),
+			},
+			{
+				Number:      2,
+				Name:        "Run tests",
+				Status:      CheckStatusCompleted,
+				Conclusion:  ConclusionFailure,
+				StartedAt:   parseGitHubTimePtr("2020-01-20T09:42:41Z"),
+				CompletedAt: parseGitHubTimePtr("2020-01-20T09:45:40Z"),
+			},
+		},
+		URL:         "https://api.github.com/repos/octocat/Hello-World/actions/jobs/399444496",
+		HTMLURL:     "https://github.com/octocat/Hello-World/runs/399444496",
+		RunURL:      "https://api.github.com/repos/octocat/Hello-World/actions/runs/30433642",
+		CheckRunURL: "https://api.github.com/repos/octocat/Hello-World/check-runs/399444496",
+		CreatedAt:   parseGitHubTime("2020-01-20T09:42:39Z"),
+		StartedAt:   parseGitHubTime("2020-01-20T09:42:40Z"),
+		CompletedAt: parseGitHubTimePtr("2020-01-20T09:45:40Z"),
+	}
+
+	workflowRun = WorkflowRun{
+		ID:              30433642,
+		Name:            "Deploy",
+		DisplayTitle:    "Deploy",
+		RunNumber:       562,
+		RunAttempt:      1,
+		Event:           "workflow_dispatch",
+		Status:          "queued",
+		WorkflowID:      159038,
+		HeadBranch:      "main",
+		HeadSHA:         "acb5820ced9479c074f688cc328bf03f341a511d",
+		Actor:           User{ID: 1, Login: "octocat", Type: "User"},
+		TriggeringActor: User{ID: 1, Login: "octocat", Type: "User"},
+		URL:             "https://api.github.com/repos/octocat/Hello-World/actions/runs/30433642",
+		HTMLURL:         "https://github.com/octocat/Hello-World/actions/runs/30433642",
+		JobsURL:         "https://api.github.com/repos/octocat/Hello-World/actions/runs/30433642/jobs",
+		LogsURL:         "https://api.github.com/repos/octocat/Hello-World/actions/runs/30433642/logs",
+		CreatedAt:       parseGitHubTime("2020-10-20T19:00:00Z"),
+		UpdatedAt:       parseGitHubTime("2020-10-20T19:00:00Z"),
+	}
+)
+
+func TestRepoService_Dispatch(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	tests := []struct {
+		name             string
+		mockResponses    []MockResponse
+		s                *RepoService
+		ctx              context.Context
+		eventType        string
+		clientPayload    interface{}
+		expectedResponse *Response
+		expectedError    string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           nil,
+			eventType:     "deploy",
+			clientPayload: map[string]string{"env": "production"},
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"POST", "/repos/octocat/Hello-World/dispatches", 401, http.Header{}, `{
+					"message": "Bad credentials"
+				}`},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			eventType:     "deploy",
+			clientPayload: map[string]string{"env": "production"},
+			expectedError: `POST /repos/octocat/Hello-World/dispatches: 401 Bad credentials`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"POST", "/repos/octocat/Hello-World/dispatches", 204, header, ``},
+			},
+			s: &RepoService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			eventType:     "deploy",
+			clientPayload: map[string]string{"env": "production"},
+			expectedResponse: &Response{
+				Rate: expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			defer ts.Close()
+
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			resp, err := tc.s.Dispatch(tc.ctx, tc.eventType, tc.clientPayload)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestWorkflowService_Dispatch(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	tests := []struct {
+		name             string
+		mockResponses    []MockResponse
+		s                *WorkflowService
+		ctx              context.Context
+		workflow         string
+		ref              string
+		inputs           map[string]interface{}
+		expectedResponse *Response
+		expectedError    string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &WorkflowService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           nil,
+			workflow:      "deploy.yml",
+			ref:           "main",
+			inputs:        map[string]interface{}{"env": "production"},
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"POST", "/repos/octocat/Hello-World/actions/workflows/deploy.yml/dispatches", 401, http.Header{}, `{
+					"message": "Bad credentials"
+				}`},
+			},
+			s: &WorkflowService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			workflow:      "deploy.yml",
+			ref:           "main",
+			inputs:        map[string]interface{}{"env": "production"},
+			expectedError: `POST /repos/octocat/Hello-World/actions/workflows/deploy.yml/dispatches: 401 Bad credentials`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"POST", "/repos/octocat/Hello-World/actions/workflows/deploy.yml/dispatches", 204, header, ``},
+			},
+			s: &WorkflowService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:      context.Background(),
+			workflow: "deploy.yml",
+			ref:      "main",
+			inputs:   map[string]interface{}{"env": "production"},
+			expectedResponse: &Response{
+				Rate: expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			defer ts.Close()
+
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			resp, err := tc.s.Dispatch(tc.ctx, tc.workflow, tc.ref, tc.inputs)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestWorkflowService_Runs(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	tests := []struct {
+		name             string
+		mockResponses    []MockResponse
+		s                *WorkflowService
+		ctx              context.Context
+		workflow         string
+		pageSize         int
+		pageNo           int
+		filter           WorkflowRunsFilter
+		expectedRuns     []WorkflowRun
+		expectedResponse *Response
+		expectedError    string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &WorkflowService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           nil,
+			workflow:      "deploy.yml",
+			pageSize:      10,
+			pageNo:        1,
+			filter:        WorkflowRunsFilter{Event: "workflow_dispatch"},
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/actions/workflows/deploy.yml/runs", 401, http.Header{}, `{
+					"message": "Bad credentials"
+				}`},
+			},
+			s: &WorkflowService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			workflow:      "deploy.yml",
+			pageSize:      10,
+			pageNo:        1,
+			filter:        WorkflowRunsFilter{Event: "workflow_dispatch"},
+			expectedError: `GET /repos/octocat/Hello-World/actions/workflows/deploy.yml/runs: 401 Bad credentials`,
+		},
+		{
+			name: "InvalidResponse",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/actions/workflows/deploy.yml/runs", 200, http.Header{}, `{`},
+			},
+			s: &WorkflowService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			workflow:      "deploy.yml",
+			pageSize:      10,
+			pageNo:        1,
+			filter:        WorkflowRunsFilter{Event: "workflow_dispatch"},
+			expectedError: `unexpected EOF`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/actions/workflows/deploy.yml/runs", 200, header, workflowRunsBody},
+			},
+			s: &WorkflowService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:          context.Background(),
+			workflow:     "deploy.yml",
+			pageSize:     10,
+			pageNo:       1,
+			filter:       WorkflowRunsFilter{Event: "workflow_dispatch"},
+			expectedRuns: []WorkflowRun{workflowRun},
+			expectedResponse: &Response{
+				Pages: expectedPages,
+				Rate:  expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			defer ts.Close()
+
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			runs, resp, err := tc.s.Runs(tc.ctx, tc.workflow, tc.pageSize, tc.pageNo, tc.filter)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, runs)
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedRuns, runs)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Pages, resp.Pages)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestValidateInputs(t *testing.T) {
+	definitions := map[string]WorkflowInput{
+		"env":      {Type: InputChoice, Required: true, Options: []string{"staging", "production"}},
+		"dry_run":  {Type: InputBoolean},
+		"replicas": {Type: InputNumber},
+		"version":  {Type: InputString},
+		"target":   {Type: InputEnvironment},
+	}
+
+	tooMany := map[string]interface{}{}
+	for i := 0; i < 26; i++ {
+		tooMany[fmt.Sprintf("input%d", i)] = "value"
+	}
+
+	tests := []struct {
+		name          string
+		definitions   map[string]WorkflowInput
+		inputs        map[string]interface{}
+		expectedError string
+	}{
+		{
+			name:        "Valid",
+			definitions: definitions,
+			inputs: map[string]interface{}{
+				"env":      "production",
+				"dry_run":  true,
+				"replicas": 3,
+				"version":  "v0.1.0",
+				"target":   "production",
+			},
+		},
+		{
+			name:        "ValidStrings",
+			definitions: definitions,
+			inputs: map[string]interface{}{
+				"env":      "staging",
+				"dry_run":  "false",
+				"replicas": "2.5",
+			},
+		},
+		{
+			name:          "MissingRequired",
+			definitions:   definitions,
+			inputs:        map[string]interface{}{},
+			expectedError: `invalid workflow inputs: env: required input is missing`,
+		},
+		{
+			name:        "UnexpectedInput",
+			definitions: definitions,
+			inputs: map[string]interface{}{
+				"env":    "staging",
+				"region": "us-east-1",
+			},
+			expectedError: `invalid workflow inputs: region: unexpected input`,
+		},
+		{
+			name:        "InvalidTypes",
+			definitions: definitions,
+			inputs: map[string]interface{}{
+				"env":      "development",
+				"dry_run":  "maybe",
+				"replicas": "many",
+				"version":  1,
+			},
+			expectedError: `invalid workflow inputs: dry_run: expected boolean, got maybe; env: expected one of staging, production, got development; replicas: expected number, got many; version: expected string, got 1`,
+		},
+		{
+			name:          "TooManyInputs",
+			definitions:   map[string]WorkflowInput{},
+			inputs:        tooMany,
+			expectedError: `too many inputs (26 > 25)`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := ValidateInputs(tc.definitions, tc.inputs)
+
+			if tc.expectedError == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorIs(t, err, ErrInvalidInputs)
+				assert.Contains(t, err.Error(), tc.expectedError)
+			}
+		})
+	}
+}
+
+func TestWorkflowService_DispatchAndFind(t *testing.T) {
+	initialInterval, maxInterval, timeout := pollInitialInterval, pollMaxInterval, dispatchTimeout
+	pollInitialInterval, pollMaxInterval, dispatchTimeout = time.Millisecond, time.Millisecond, 50*time.Millisecond
+	t.Cleanup(func() { pollInitialInterval, pollMaxInterval, dispatchTimeout = initialInterval, maxInterval, timeout })
+
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	// Runs are created after the dispatch, so they are timestamped in the future relative to the test start.
+	created := time.Now().Add(time.Minute).UTC().Format(time.RFC3339)
+	oldRunBody := fmt.Sprintf(workflowRunBodyFormat, "Deploy", "octocat", "octocat", "2020-10-20T19:00:00Z", "2020-10-20T19:00:00Z")
+	otherActorRunBody := fmt.Sprintf(workflowRunBodyFormat, "Deploy", "monalisa", "monalisa", created, created)
+	newRunBody := fmt.Sprintf(workflowRunBodyFormat, "Deploy", "octocat", "octocat", created, created)
+
+	timeoutCtx, cancelTimeout := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancelTimeout()
+
+	tests := []struct {
+		name          string
+		mockResponses []MockResponse
+		s             *WorkflowService
+		ctx           context.Context
+		workflow      string
+		ref           string
+		inputs        map[string]interface{}
+		opts          DispatchOptions
+		expectedRunID int
+		expectedError string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &WorkflowService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           nil,
+			workflow:      "deploy.yml",
+			ref:           "main",
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "UserError",
+			mockResponses: []MockResponse{
+				{"GET", "/user", 401, http.Header{}, `{
+					"message": "Bad credentials"
+				}`},
+			},
+			s: &WorkflowService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			workflow:      "deploy.yml",
+			ref:           "main",
+			expectedError: `GET /user: 401 Bad credentials`,
+		},
+		{
+			name: "DispatchError",
+			mockResponses: []MockResponse{
+				{"GET", "/user", 200, http.Header{}, userBody},
+				{"POST", "/repos/octocat/Hello-World/actions/workflows/deploy.yml/dispatches", 422, http.Header{}, `{
+					"message": "Unexpected inputs provided"
+				}`},
+			},
+			s: &WorkflowService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			workflow:      "deploy.yml",
+			ref:           "main",
+			expectedError: `POST /repos/octocat/Hello-World/actions/workflows/deploy.yml/dispatches: 422 Unexpected inputs provided`,
+		},
+		{
+			name: "RunsError",
+			mockResponses: []MockResponse{
+				{"GET", "/user", 200, http.Header{}, userBody},
+				{"POST", "/repos/octocat/Hello-World/actions/workflows/deploy.yml/dispatches", 204, http.Header{}, ``},
+				{"GET", "/repos/octocat/Hello-World/actions/workflows/deploy.yml/runs", 401, http.Header{}, `{
+					"message": "Bad credentials"
+				}`},
+			},
+			s: &WorkflowService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			workflow:      "deploy.yml",
+			ref:           "main",
+			expectedError: `GET /repos/octocat/Hello-World/actions/workflows/deploy.yml/runs: 401 Bad credentials`,
+		},
+		{
+			name: "MarkerNotFound",
+			mockResponses: []MockResponse{
+				{"GET", "/user", 200, http.Header{}, userBody},
+				{"POST", "/repos/octocat/Hello-World/actions/workflows/deploy.yml/dispatches", 204, http.Header{}, ``},
+				{"GET", "/repos/octocat/Hello-World/actions/workflows/deploy.yml/runs", 200, http.Header{}, `{
+					"total_count": 1,
+					"workflow_runs": [` + newRunBody + `]
+				}`},
+			},
+			s: &WorkflowService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:      timeoutCtx,
+			workflow: "deploy.yml",
+			ref:      "main",
+			inputs:   map[string]interface{}{"env": "production"},
+			opts: DispatchOptions{
+				MarkerInput: "marker",
+			},
+			expectedError: `context deadline exceeded`,
+		},
+		{
+			name: "DefaultTimeout",
+			mockResponses: []MockResponse{
+				{"GET", "/user", 200, http.Header{}, userBody},
+				{"POST", "/repos/octocat/Hello-World/actions/workflows/deploy.yml/dispatches", 204, http.Header{}, ``},
+				{"GET", "/repos/octocat/Hello-World/actions/workflows/deploy.yml/runs", 200, http.Header{}, `{
+					"total_count": 0,
+					"workflow_runs": []
+				}`},
+			},
+			s: &WorkflowService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			workflow:      "deploy.yml",
+			ref:           "main",
+			expectedError: `context deadline exceeded`,
+		},
+		{
+			name: "OptionsTimeout",
+			mockResponses: []MockResponse{
+				{"GET", "/user", 200, http.Header{}, userBody},
+				{"POST", "/repos/octocat/Hello-World/actions/workflows/deploy.yml/dispatches", 204, http.Header{}, ``},
+				{"GET", "/repos/octocat/Hello-World/actions/workflows/deploy.yml/runs", 200, http.Header{}, `{
+					"total_count": 0,
+					"workflow_runs": []
+				}`},
+			},
+			s: &WorkflowService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:      context.Background(),
+			workflow: "deploy.yml",
+			ref:      "main",
+			opts: DispatchOptions{
+				Timeout: 10 * time.Millisecond,
+			},
+			expectedError: `context deadline exceeded`,
+		},
+		{
+			name: "AmbiguousRun",
+			mockResponses: []MockResponse{
+				{"GET", "/user", 200, http.Header{}, userBody},
+				{"POST", "/repos/octocat/Hello-World/actions/workflows/deploy.yml/dispatches", 204, http.Header{}, ``},
+				{"GET", "/repos/octocat/Hello-World/actions/workflows/deploy.yml/runs", 200, http.Header{}, `{
+					"total_count": 2,
+					"workflow_runs": [` + newRunBody + `, ` + newRunBody + `]
+				}`},
+			},
+			s: &WorkflowService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			workflow:      "deploy.yml",
+			ref:           "main",
+			expectedError: `more than one workflow run matches the dispatch`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"GET", "/user", 200, http.Header{}, userBody},
+				{"POST", "/repos/octocat/Hello-World/actions/workflows/deploy.yml/dispatches", 204, http.Header{}, ``},
+				{"GET", "/repos/octocat/Hello-World/actions/workflows/deploy.yml/runs", 200, http.Header{}, `{
+					"total_count": 0,
+					"workflow_runs": []
+				}`},
+				{"GET", "/repos/octocat/Hello-World/actions/workflows/deploy.yml/runs", 200, http.Header{}, `{
+					"total_count": 3,
+					"workflow_runs": [` + oldRunBody + `, ` + otherActorRunBody + `, ` + newRunBody + `]
+				}`},
+			},
+			s: &WorkflowService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			workflow:      "deploy.yml",
+			ref:           "main",
+			inputs:        map[string]interface{}{"env": "production"},
+			expectedRunID: 30433642,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			defer ts.Close()
+
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			run, err := tc.s.DispatchAndFind(tc.ctx, tc.workflow, tc.ref, tc.inputs, tc.opts)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, run)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.NotNil(t, run)
+				assert.Equal(t, tc.expectedRunID, run.ID)
+				assert.Equal(t, "octocat", run.Actor.Login)
+			}
+		})
+	}
+}
+
+func TestWorkflowService_List(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	tests := []struct {
+		name              string
+		mockResponses     []MockResponse
+		s                 *WorkflowService
+		ctx               context.Context
+		pageSize          int
+		pageNo            int
+		expectedWorkflows []Workflow
+		expectedResponse  *Response
+		expectedError     string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &WorkflowService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           nil,
+			pageSize:      10,
+			pageNo:        1,
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/actions/workflows", 401, http.Header{}, `{
+					"message": "Bad credentials"
+				}`},
+			},
+			s: &WorkflowService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			pageSize:      10,
+			pageNo:        1,
+			expectedError: `GET /repos/octocat/Hello-World/actions/workflows: 401 Bad credentials`,
+		},
+		{
+			name: "InvalidResponse",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/actions/workflows", 200, http.Header{}, `{`},
+			},
+			s: &WorkflowService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			pageSize:      10,
+			pageNo:        1,
+			expectedError: `unexpected EOF`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/actions/workflows", 200, header, workflowsBody},
+			},
+			s: &WorkflowService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:               context.Background(),
+			pageSize:          10,
+			pageNo:            1,
+			expectedWorkflows: []Workflow{workflow},
+			expectedResponse: &Response{
+				Pages: expectedPages,
+				Rate:  expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			defer ts.Close()
+
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			workflows, resp, err := tc.s.List(tc.ctx, tc.pageSize, tc.pageNo)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, workflows)
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedWorkflows, workflows)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Pages, resp.Pages)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestWorkflowService_Get(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	tests := []struct {
+		name             string
+		mockResponses    []MockResponse
+		s                *WorkflowService
+		ctx              context.Context
+		workflow         string
+		expectedWorkflow *Workflow
+		expectedResponse *Response
+		expectedError    string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &WorkflowService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           nil,
+			workflow:      "deploy.yml",
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/actions/workflows/deploy.yml", 401, http.Header{}, `{
+					"message": "Bad credentials"
+				}`},
+			},
+			s: &WorkflowService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			workflow:      "deploy.yml",
+			expectedError: `GET /repos/octocat/Hello-World/actions/workflows/deploy.yml: 401 Bad credentials`,
+		},
+		{
+			name: "InvalidResponse",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/actions/workflows/deploy.yml", 200, http.Header{}, `{`},
+			},
+			s: &WorkflowService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			workflow:      "deploy.yml",
+			expectedError: `unexpected EOF`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/actions/workflows/deploy.yml", 200, header, workflowBody},
+			},
+			s: &WorkflowService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:              context.Background(),
+			workflow:         "deploy.yml",
+			expectedWorkflow: &workflow,
+			expectedResponse: &Response{
+				Rate: expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			defer ts.Close()
+
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			wf, resp, err := tc.s.Get(tc.ctx, tc.workflow)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, wf)
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedWorkflow, wf)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestWorkflowService_Enable(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	tests := []struct {
+		name             string
+		mockResponses    []MockResponse
+		s                *WorkflowService
+		ctx              context.Context
+		workflow         string
+		expectedResponse *Response
+		expectedError    string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &WorkflowService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           nil,
+			workflow:      "deploy.yml",
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"PUT", "/repos/octocat/Hello-World/actions/workflows/deploy.yml/enable", 401, http.Header{}, `{
+					"message": "Bad credentials"
+				}`},
+			},
+			s: &WorkflowService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			workflow:      "deploy.yml",
+			expectedError: `PUT /repos/octocat/Hello-World/actions/workflows/deploy.yml/enable: 401 Bad credentials`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"PUT", "/repos/octocat/Hello-World/actions/workflows/deploy.yml/enable", 204, header, ``},
+			},
+			s: &WorkflowService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:      context.Background(),
+			workflow: "deploy.yml",
+			expectedResponse: &Response{
+				Rate: expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			defer ts.Close()
+
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			resp, err := tc.s.Enable(tc.ctx, tc.workflow)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestWorkflowService_Disable(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	tests := []struct {
+		name             string
+		mockResponses    []MockResponse
+		s                *WorkflowService
+		ctx              context.Context
+		workflow         string
+		expectedResponse *Response
+		expectedError    string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &WorkflowService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           nil,
+			workflow:      "deploy.yml",
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"PUT", "/repos/octocat/Hello-World/actions/workflows/deploy.yml/disable", 401, http.Header{}, `{
+					"message": "Bad credentials"
+				}`},
+			},
+			s: &WorkflowService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			workflow:      "deploy.yml",
+			expectedError: `PUT /repos/octocat/Hello-World/actions/workflows/deploy.yml/disable: 401 Bad credentials`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"PUT", "/repos/octocat/Hello-World/actions/workflows/deploy.yml/disable", 204, header, ``},
+			},
+			s: &WorkflowService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:      context.Background(),
+			workflow: "deploy.yml",
+			expectedResponse: &Response{
+				Rate: expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			defer ts.Close()
+
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			resp, err := tc.s.Disable(tc.ctx, tc.workflow)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestWorkflowService_Usage(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	tests := []struct {
+		name             string
+		mockResponses    []MockResponse
+		s                *WorkflowService
+		ctx              context.Context
+		workflow         string
+		expectedUsage    *WorkflowUsage
+		expectedResponse *Response
+		expectedError    string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &WorkflowService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           nil,
+			workflow:      "deploy.yml",
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/actions/workflows/deploy.yml/timing", 401, http.Header{}, `{
+					"message": "Bad credentials"
+				}`},
+			},
+			s: &WorkflowService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			workflow:      "deploy.yml",
+			expectedError: `GET /repos/octocat/Hello-World/actions/workflows/deploy.yml/timing: 401 Bad credentials`,
+		},
+		{
+			name: "InvalidResponse",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/actions/workflows/deploy.yml/timing", 200, http.Header{}, `{`},
+			},
+			s: &WorkflowService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			workflow:      "deploy.yml",
+			expectedError: `unexpected EOF`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/actions/workflows/deploy.yml/timing", 200, header, workflowUsageBody},
+			},
+			s: &WorkflowService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			workflow:      "deploy.yml",
+			expectedUsage: &workflowUsage,
+			expectedResponse: &Response{
+				Rate: expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			defer ts.Close()
+
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			usage, resp, err := tc.s.Usage(tc.ctx, tc.workflow)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, usage)
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedUsage, usage)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestWorkflowService_AllRuns(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	tests := []struct {
+		name             string
+		mockResponses    []MockResponse
+		s                *WorkflowService
+		ctx              context.Context
+		pageSize         int
+		pageNo           int
+		filter           WorkflowRunsFilter
+		expectedRuns     []WorkflowRun
+		expectedResponse *Response
+		expectedError    string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &WorkflowService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           nil,
+			pageSize:      10,
+			pageNo:        1,
+			filter:        WorkflowRunsFilter{Branch: "main", Status: "failure", ExcludePullRequests: true},
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/actions/runs", 401, http.Header{}, `{
+					"message": "Bad credentials"
+				}`},
+			},
+			s: &WorkflowService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			pageSize:      10,
+			pageNo:        1,
+			filter:        WorkflowRunsFilter{Branch: "main", Status: "failure", ExcludePullRequests: true},
+			expectedError: `GET /repos/octocat/Hello-World/actions/runs: 401 Bad credentials`,
+		},
+		{
+			name: "InvalidResponse",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/actions/runs", 200, http.Header{}, `{`},
+			},
+			s: &WorkflowService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			pageSize:      10,
+			pageNo:        1,
+			filter:        WorkflowRunsFilter{Branch: "main", Status: "failure", ExcludePullRequests: true},
+			expectedError: `unexpected EOF`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/actions/runs", 200, header, workflowRunsBody},
+			},
+			s: &WorkflowService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:          context.Background(),
+			pageSize:     10,
+			pageNo:       1,
+			filter:       WorkflowRunsFilter{Branch: "main", Status: "failure", ExcludePullRequests: true},
+			expectedRuns: []WorkflowRun{workflowRun},
+			expectedResponse: &Response{
+				Pages: expectedPages,
+				Rate:  expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			defer ts.Close()
+
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			runs, resp, err := tc.s.AllRuns(tc.ctx, tc.pageSize, tc.pageNo, tc.filter)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, runs)
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedRuns, runs)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Pages, resp.Pages)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestWorkflowService_Run(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	tests := []struct {
+		name             string
+		mockResponses    []MockResponse
+		s                *WorkflowService
+		ctx              context.Context
+		runID            int
+		expectedRun      *WorkflowRun
+		expectedResponse *Response
+		expectedError    string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &WorkflowService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           nil,
+			runID:         30433642,
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/actions/runs/30433642", 401, http.Header{}, `{
+					"message": "Bad credentials"
+				}`},
+			},
+			s: &WorkflowService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			runID:         30433642,
+			expectedError: `GET /repos/octocat/Hello-World/actions/runs/30433642: 401 Bad credentials`,
+		},
+		{
+			name: "InvalidResponse",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/actions/runs/30433642", 200, http.Header{}, `{`},
+			},
+			s: &WorkflowService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			runID:         30433642,
+			expectedError: `unexpected EOF`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/actions/runs/30433642", 200, header, workflowRunBody},
+			},
+			s: &WorkflowService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:         context.Background(),
+			runID:       30433642,
+			expectedRun: &workflowRun,
+			expectedResponse: &Response{
+				Rate: expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			defer ts.Close()
+
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			run, resp, err := tc.s.Run(tc.ctx, tc.runID)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, run)
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedRun, run)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestWorkflowService_Rerun(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	tests := []struct {
+		name               string
+		mockResponses      []MockResponse
+		s                  *WorkflowService
+		ctx                context.Context
+		runID              int
+		enableDebugLogging bool
+		expectedResponse   *Response
+		expectedError      string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &WorkflowService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:                nil,
+			runID:              30433642,
+			enableDebugLogging: true,
+			expectedError:      `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"POST", "/repos/octocat/Hello-World/actions/runs/30433642/rerun", 401, http.Header{}, `{
+					"message": "Bad credentials"
+				}`},
+			},
+			s: &WorkflowService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:                context.Background(),
+			runID:              30433642,
+			enableDebugLogging: true,
+			expectedError:      `POST /repos/octocat/Hello-World/actions/runs/30433642/rerun: 401 Bad credentials`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"POST", "/repos/octocat/Hello-World/actions/runs/30433642/rerun", 201, header, `{}`},
+			},
+			s: &WorkflowService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:                context.Background(),
+			runID:              30433642,
+			enableDebugLogging: true,
+			expectedResponse: &Response{
+				Rate: expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			defer ts.Close()
+
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			resp, err := tc.s.Rerun(tc.ctx, tc.runID, tc.enableDebugLogging)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestWorkflowService_RerunFailedJobs(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	tests := []struct {
+		name               string
+		mockResponses      []MockResponse
+		s                  *WorkflowService
+		ctx                context.Context
+		runID              int
+		enableDebugLogging bool
+		expectedResponse   *Response
+		expectedError      string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &WorkflowService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:                nil,
+			runID:              30433642,
+			enableDebugLogging: false,
+			expectedError:      `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"POST", "/repos/octocat/Hello-World/actions/runs/30433642/rerun-failed-jobs", 401, http.Header{}, `{
+					"message": "Bad credentials"
+				}`},
+			},
+			s: &WorkflowService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:                context.Background(),
+			runID:              30433642,
+			enableDebugLogging: false,
+			expectedError:      `POST /repos/octocat/Hello-World/actions/runs/30433642/rerun-failed-jobs: 401 Bad credentials`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"POST", "/repos/octocat/Hello-World/actions/runs/30433642/rerun-failed-jobs", 201, header, `{}`},
+			},
+			s: &WorkflowService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:                context.Background(),
+			runID:              30433642,
+			enableDebugLogging: false,
+			expectedResponse: &Response{
+				Rate: expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			defer ts.Close()
+
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			resp, err := tc.s.RerunFailedJobs(tc.ctx, tc.runID, tc.enableDebugLogging)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestWorkflowService_Cancel(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	tests := []struct {
+		name             string
+		mockResponses    []MockResponse
+		s                *WorkflowService
+		ctx              context.Context
+		runID            int
+		expectedResponse *Response
+		expectedError    string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &WorkflowService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           nil,
+			runID:         30433642,
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"POST", "/repos/octocat/Hello-World/actions/runs/30433642/cancel", 409, http.Header{}, `{
+					"message": "Cannot cancel a workflow run that is completed."
+				}`},
+			},
+			s: &WorkflowService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			runID:         30433642,
+			expectedError: `POST /repos/octocat/Hello-World/actions/runs/30433642/cancel: 409 Cannot cancel a workflow run that is completed.`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"POST", "/repos/octocat/Hello-World/actions/runs/30433642/cancel", 202, header, `{}`},
+			},
+			s: &WorkflowService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:   context.Background(),
+			runID: 30433642,
+			expectedResponse: &Response{
+				Rate: expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			defer ts.Close()
+
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			resp, err := tc.s.Cancel(tc.ctx, tc.runID)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestWorkflowService_DeleteRun(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	tests := []struct {
+		name             string
+		mockResponses    []MockResponse
+		s                *WorkflowService
+		ctx              context.Context
+		runID            int
+		expectedResponse *Response
+		expectedError    string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &WorkflowService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           nil,
+			runID:         30433642,
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"DELETE", "/repos/octocat/Hello-World/actions/runs/30433642", 401, http.Header{}, `{
+					"message": "Bad credentials"
+				}`},
+			},
+			s: &WorkflowService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			runID:         30433642,
+			expectedError: `DELETE /repos/octocat/Hello-World/actions/runs/30433642: 401 Bad credentials`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"DELETE", "/repos/octocat/Hello-World/actions/runs/30433642", 204, header, ``},
+			},
+			s: &WorkflowService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:   context.Background(),
+			runID: 30433642,
+			expectedResponse: &Response{
+				Rate: expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			defer ts.Close()
+
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			resp, err := tc.s.DeleteRun(tc.ctx, tc.runID)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestWorkflowService_RunUsage(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	tests := []struct {
+		name             string
+		mockResponses    []MockResponse
+		s                *WorkflowService
+		ctx              context.Context
+		runID            int
+		expectedUsage    *WorkflowRunUsage
+		expectedResponse *Response
+		expectedError    string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &WorkflowService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           nil,
+			runID:         30433642,
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/actions/runs/30433642/timing", 401, http.Header{}, `{
+					"message": "Bad credentials"
+				}`},
+			},
+			s: &WorkflowService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			runID:         30433642,
+			expectedError: `GET /repos/octocat/Hello-World/actions/runs/30433642/timing: 401 Bad credentials`,
+		},
+		{
+			name: "InvalidResponse",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/actions/runs/30433642/timing", 200, http.Header{}, `{`},
+			},
+			s: &WorkflowService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			runID:         30433642,
+			expectedError: `unexpected EOF`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/actions/runs/30433642/timing", 200, header, workflowRunUsageBody},
+			},
+			s: &WorkflowService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			runID:         30433642,
+			expectedUsage: &workflowRunUsage,
+			expectedResponse: &Response{
+				Rate: expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			defer ts.Close()
+
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			usage, resp, err := tc.s.RunUsage(tc.ctx, tc.runID)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, usage)
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedUsage, usage)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestWorkflowService_RunLogs(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	tests := []struct {
+		name             string
+		mockResponses    []MockResponse
+		s                *WorkflowService
+		ctx              context.Context
+		runID            int
+		w                io.Writer
+		expectedResponse *Response
+		expectedError    string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &WorkflowService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           nil,
+			runID:         30433642,
+			w:             new(bytes.Buffer),
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/actions/runs/30433642/logs", 401, http.Header{}, `{
+					"message": "Bad credentials"
+				}`},
+			},
+			s: &WorkflowService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			runID:         30433642,
+			w:             new(bytes.Buffer),
+			expectedError: `GET /repos/octocat/Hello-World/actions/runs/30433642/logs: 401 Bad credentials`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/actions/runs/30433642/logs", 302, http.Header{"Location": {"/blob/logs.zip"}}, ``},
+				{"GET", "/blob/logs.zip", 200, header, `2020-10-20T19:00:00.0000000Z ##[group]Run actions/checkout@v4`},
+			},
+			s: &WorkflowService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:   context.Background(),
+			runID: 30433642,
+			w:     new(bytes.Buffer),
+			expectedResponse: &Response{
+				Rate: expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			defer ts.Close()
+
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			resp, err := tc.s.RunLogs(tc.ctx, tc.runID, tc.w)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+				assert.Equal(t, `2020-10-20T19:00:00.0000000Z ##[group]Run actions/checkout@v4`, tc.w.(*bytes.Buffer).String())
+			}
+		})
+	}
+}
+
+func TestWorkflowService_DeleteRunLogs(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	tests := []struct {
+		name             string
+		mockResponses    []MockResponse
+		s                *WorkflowService
+		ctx              context.Context
+		runID            int
+		expectedResponse *Response
+		expectedError    string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &WorkflowService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           nil,
+			runID:         30433642,
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"DELETE", "/repos/octocat/Hello-World/actions/runs/30433642/logs", 401, http.Header{}, `{
+					"message": "Bad credentials"
+				}`},
+			},
+			s: &WorkflowService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			runID:         30433642,
+			expectedError: `DELETE /repos/octocat/Hello-World/actions/runs/30433642/logs: 401 Bad credentials`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"DELETE", "/repos/octocat/Hello-World/actions/runs/30433642/logs", 204, header, ``},
+			},
+			s: &WorkflowService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:   context.Background(),
+			runID: 30433642,
+			expectedResponse: &Response{
+				Rate: expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			defer ts.Close()
+
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			resp, err := tc.s.DeleteRunLogs(tc.ctx, tc.runID)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestWorkflowService_Jobs(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	tests := []struct {
+		name             string
+		mockResponses    []MockResponse
+		s                *WorkflowService
+		ctx              context.Context
+		runID            int
+		pageSize         int
+		pageNo           int
+		filter           JobsFilter
+		expectedJobs     []Job
+		expectedResponse *Response
+		expectedError    string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &WorkflowService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           nil,
+			runID:         30433642,
+			pageSize:      10,
+			pageNo:        1,
+			filter:        JobsFilter{Filter: "all"},
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/actions/runs/30433642/jobs", 401, http.Header{}, `{
+					"message": "Bad credentials"
+				}`},
+			},
+			s: &WorkflowService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			runID:         30433642,
+			pageSize:      10,
+			pageNo:        1,
+			filter:        JobsFilter{Filter: "all"},
+			expectedError: `GET /repos/octocat/Hello-World/actions/runs/30433642/jobs: 401 Bad credentials`,
+		},
+		{
+			name: "InvalidResponse",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/actions/runs/30433642/jobs", 200, http.Header{}, `{`},
+			},
+			s: &WorkflowService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			runID:         30433642,
+			pageSize:      10,
+			pageNo:        1,
+			filter:        JobsFilter{Filter: "all"},
+			expectedError: `unexpected EOF`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/actions/runs/30433642/jobs", 200, header, jobsBody},
+			},
+			s: &WorkflowService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:          context.Background(),
+			runID:        30433642,
+			pageSize:     10,
+			pageNo:       1,
+			filter:       JobsFilter{Filter: "all"},
+			expectedJobs: []Job{job},
+			expectedResponse: &Response{
+				Pages: expectedPages,
+				Rate:  expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			defer ts.Close()
+
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			jobs, resp, err := tc.s.Jobs(tc.ctx, tc.runID, tc.pageSize, tc.pageNo, tc.filter)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, jobs)
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedJobs, jobs)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Pages, resp.Pages)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestWorkflowService_Job(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	tests := []struct {
+		name             string
+		mockResponses    []MockResponse
+		s                *WorkflowService
+		ctx              context.Context
+		jobID            int
+		expectedJob      *Job
+		expectedResponse *Response
+		expectedError    string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &WorkflowService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           nil,
+			jobID:         399444496,
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/actions/jobs/399444496", 401, http.Header{}, `{
+					"message": "Bad credentials"
+				}`},
+			},
+			s: &WorkflowService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			jobID:         399444496,
+			expectedError: `GET /repos/octocat/Hello-World/actions/jobs/399444496: 401 Bad credentials`,
+		},
+		{
+			name: "InvalidResponse",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/actions/jobs/399444496", 200, http.Header{}, `{`},
+			},
+			s: &WorkflowService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			jobID:         399444496,
+			expectedError: `unexpected EOF`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/actions/jobs/399444496", 200, header, jobBody},
+			},
+			s: &WorkflowService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:         context.Background(),
+			jobID:       399444496,
+			expectedJob: &job,
+			expectedResponse: &Response{
+				Rate: expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			defer ts.Close()
+
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			j, resp, err := tc.s.Job(tc.ctx, tc.jobID)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, j)
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedJob, j)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestWorkflowService_RerunJob(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	tests := []struct {
+		name               string
+		mockResponses      []MockResponse
+		s                  *WorkflowService
+		ctx                context.Context
+		jobID              int
+		enableDebugLogging bool
+		expectedResponse   *Response
+		expectedError      string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &WorkflowService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:                nil,
+			jobID:              399444496,
+			enableDebugLogging: false,
+			expectedError:      `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"POST", "/repos/octocat/Hello-World/actions/jobs/399444496/rerun", 401, http.Header{}, `{
+					"message": "Bad credentials"
+				}`},
+			},
+			s: &WorkflowService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:                context.Background(),
+			jobID:              399444496,
+			enableDebugLogging: false,
+			expectedError:      `POST /repos/octocat/Hello-World/actions/jobs/399444496/rerun: 401 Bad credentials`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"POST", "/repos/octocat/Hello-World/actions/jobs/399444496/rerun", 201, header, `{}`},
+			},
+			s: &WorkflowService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:                context.Background(),
+			jobID:              399444496,
+			enableDebugLogging: false,
+			expectedResponse: &Response{
+				Rate: expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			defer ts.Close()
+
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			resp, err := tc.s.RerunJob(tc.ctx, tc.jobID, tc.enableDebugLogging)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+			}
+		})
+	}
+}
+
+func TestWorkflowService_JobLogs(t *testing.T) {
+	c := &Client{
+		httpClient: &http.Client{},
+		rates:      map[rateGroup]Rate{},
+		apiURL:     publicAPIURL,
+	}
+
+	tests := []struct {
+		name             string
+		mockResponses    []MockResponse
+		s                *WorkflowService
+		ctx              context.Context
+		jobID            int
+		w                io.Writer
+		expectedResponse *Response
+		expectedError    string
+	}{
+		{
+			name:          "NilContext",
+			mockResponses: []MockResponse{},
+			s: &WorkflowService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           nil,
+			jobID:         399444496,
+			w:             new(bytes.Buffer),
+			expectedError: `net/http: nil Context`,
+		},
+		{
+			name: "InvalidStatusCode",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/actions/jobs/399444496/logs", 401, http.Header{}, `{
+					"message": "Bad credentials"
+				}`},
+			},
+			s: &WorkflowService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:           context.Background(),
+			jobID:         399444496,
+			w:             new(bytes.Buffer),
+			expectedError: `GET /repos/octocat/Hello-World/actions/jobs/399444496/logs: 401 Bad credentials`,
+		},
+		{
+			name: "Success",
+			mockResponses: []MockResponse{
+				{"GET", "/repos/octocat/Hello-World/actions/jobs/399444496/logs", 302, http.Header{"Location": {"/blob/job.log"}}, ``},
+				{"GET", "/blob/job.log", 200, header, `2020-10-20T19:00:00.0000000Z ##[group]Run actions/checkout@v4`},
+			},
+			s: &WorkflowService{
+				client: c,
+				owner:  "octocat",
+				repo:   "Hello-World",
+			},
+			ctx:   context.Background(),
+			jobID: 399444496,
+			w:     new(bytes.Buffer),
+			expectedResponse: &Response{
+				Rate: expectedRate,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := newHTTPTestServer(tc.mockResponses...)
+			defer ts.Close()
+
+			tc.s.client.apiURL, _ = url.Parse(ts.URL)
+
+			resp, err := tc.s.JobLogs(tc.ctx, tc.jobID, tc.w)
+
+			if tc.expectedError != "" {
+				assert.Nil(t, resp)
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.NotNil(t, resp)
+				assert.NotNil(t, resp.Response)
+				assert.Equal(t, tc.expectedResponse.Rate, resp.Rate)
+				assert.Equal(t, `2020-10-20T19:00:00.0000000Z ##[group]Run actions/checkout@v4`, tc.w.(*bytes.Buffer).String())
+			}
+		})
+	}
+}